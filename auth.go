@@ -0,0 +1,344 @@
+package edgecloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAuthURL = "https://api.edgecenter.ru/iam/"
+	jwtRefreshPath = "auth/jwt/refresh"
+
+	TokenTypeAPIKey = "APIKey"
+	TokenTypeBearer = "Bearer"
+
+	// defaultTokenExpiryLeeway is how long before its expiry a bearer token is refreshed,
+	// so that it does not expire while a request is in flight.
+	defaultTokenExpiryLeeway = 30 * time.Second
+)
+
+var (
+	ErrNoRefreshToken = errors.New("refresh token is not set")
+	ErrNoAccessToken  = errors.New("access token is not set")
+)
+
+// Token is the credential set in the Authorization header of the requests.
+type Token struct {
+	// Type is the authorization scheme, e.g. TokenTypeAPIKey or TokenTypeBearer.
+	Type string
+
+	// Value is the secret part of the token.
+	Value string
+
+	// Expiry is the time at which the token expires. A zero Expiry means the token does not expire.
+	Expiry time.Time
+}
+
+// expired reports whether the token expires within the given leeway.
+func (t *Token) expired(leeway time.Duration) bool {
+	return !t.Expiry.IsZero() && time.Now().Add(leeway).After(t.Expiry)
+}
+
+func (t *Token) authorization() string {
+	return t.Type + " " + t.Value
+}
+
+// TokenSource supplies the tokens used to authenticate the requests.
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	// Token returns a valid token.
+	Token(ctx context.Context) (*Token, error)
+}
+
+// RefreshableTokenSource is a TokenSource whose tokens can be renewed when the API rejects them.
+type RefreshableTokenSource interface {
+	TokenSource
+
+	// Refresh returns a new token to replace the rejected one. If the token was already replaced,
+	// e.g. by a concurrent request, the current token is returned without refreshing it again.
+	Refresh(ctx context.Context, rejected *Token) (*Token, error)
+}
+
+// staticTokenSource always returns the same token.
+type staticTokenSource struct {
+	token *Token
+}
+
+func (s *staticTokenSource) Token(_ context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// APIKeyTokenSource returns a TokenSource of a permanent API key. The key may be given with or without the APIKey prefix.
+func APIKeyTokenSource(apiKey string) TokenSource {
+	tokenPartsCount := 2
+	parts := strings.SplitN(apiKey, " ", tokenPartsCount)
+	if len(parts) == 2 && strings.EqualFold(parts[0], TokenTypeAPIKey) {
+		apiKey = parts[1]
+	}
+
+	return &staticTokenSource{token: &Token{Type: TokenTypeAPIKey, Value: apiKey}}
+}
+
+// TokenRefreshFunc exchanges a refresh token for a new access token and, optionally, a new refresh token.
+type TokenRefreshFunc func(ctx context.Context, refreshToken string) (accessToken, newRefreshToken string, err error)
+
+// BearerTokenSource is a RefreshableTokenSource of short-lived JWT access tokens. The access token is refreshed
+// with the refresh token shortly before it expires, according to its exp claim, and whenever the API rejects it.
+// Concurrent requests share a single refresh.
+type BearerTokenSource struct {
+	mu           sync.Mutex
+	token        *Token
+	refreshToken string
+	refresh      TokenRefreshFunc
+	leeway       time.Duration
+}
+
+var _ RefreshableTokenSource = &BearerTokenSource{}
+
+// NewBearerTokenSource creates a BearerTokenSource. The accessToken may be empty, in which case it is obtained
+// with the refreshToken on the first request. A nil refresh function uses the JWT refresh endpoint of the
+// EdgeCenter IAM API.
+func NewBearerTokenSource(accessToken, refreshToken string, refresh TokenRefreshFunc) *BearerTokenSource {
+	if refresh == nil {
+		refresh = JWTRefresher(nil, "")
+	}
+
+	s := &BearerTokenSource{
+		refreshToken: refreshToken,
+		refresh:      refresh,
+		leeway:       defaultTokenExpiryLeeway,
+	}
+	if accessToken != "" {
+		s.token = newBearerToken(accessToken)
+	}
+
+	return s
+}
+
+// Token returns the current access token, refreshing it first if it is missing or about to expire.
+func (s *BearerTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// without a refresh token, an expired token is still returned, so that the API reports it
+	if s.token != nil && (!s.token.expired(s.leeway) || s.refreshToken == "") {
+		return s.token, nil
+	}
+
+	return s.refreshLocked(ctx)
+}
+
+// Refresh refreshes the access token, unless the rejected token was already replaced.
+func (s *BearerTokenSource) Refresh(ctx context.Context, rejected *Token) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != nil && s.token != rejected && !s.token.expired(s.leeway) {
+		return s.token, nil
+	}
+
+	return s.refreshLocked(ctx)
+}
+
+func (s *BearerTokenSource) refreshLocked(ctx context.Context) (*Token, error) {
+	if s.refreshToken == "" {
+		return nil, ErrNoRefreshToken
+	}
+
+	accessToken, refreshToken, err := s.refresh(ctx, s.refreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh access token: %w", err)
+	}
+	if accessToken == "" {
+		return nil, ErrNoAccessToken
+	}
+
+	s.token = newBearerToken(accessToken)
+	if refreshToken != "" {
+		s.refreshToken = refreshToken
+	}
+
+	return s.token, nil
+}
+
+func newBearerToken(accessToken string) *Token {
+	return &Token{Type: TokenTypeBearer, Value: accessToken, Expiry: jwtExpiry(accessToken)}
+}
+
+// jwtExpiry returns the time of the exp claim of a JWT, without verifying the token.
+// It returns a zero time if the token is not a JWT or has no exp claim.
+func jwtExpiry(token string) time.Time {
+	jwtPartsCount := 3
+	parts := strings.Split(token, ".")
+	if len(parts) != jwtPartsCount {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(claims.Exp, 0)
+}
+
+type jwtRefreshRequest struct {
+	Refresh string `json:"refresh"`
+}
+
+type jwtRefreshResponse struct {
+	Access  string `json:"access"`
+	Refresh string `json:"refresh"`
+}
+
+// JWTRefresher returns a TokenRefreshFunc that uses the JWT refresh endpoint of the IAM API at authURL.
+// A nil httpClient uses http.DefaultClient and an empty authURL uses the EdgeCenter IAM API.
+func JWTRefresher(httpClient *http.Client, authURL string) TokenRefreshFunc {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if authURL == "" {
+		authURL = defaultAuthURL
+	}
+
+	return func(ctx context.Context, refreshToken string) (string, string, error) {
+		base, err := url.Parse(strings.TrimSuffix(authURL, "/") + "/")
+		if err != nil {
+			return "", "", err
+		}
+
+		body, err := json.Marshal(&jwtRefreshRequest{Refresh: refreshToken})
+		if err != nil {
+			return "", "", err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, base.JoinPath(jwtRefreshPath).String(), bytes.NewReader(body))
+		if err != nil {
+			return "", "", err
+		}
+		req.Header.Set("Content-Type", mediaType)
+		req.Header.Set("Accept", mediaType)
+		req.Header.Set("User-Agent", userAgent)
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return "", "", err
+		}
+		defer resp.Body.Close()
+
+		if err := CheckResponse(resp); err != nil {
+			return "", "", err
+		}
+
+		var tokens jwtRefreshResponse
+		if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil {
+			return "", "", err
+		}
+
+		return tokens.Access, tokens.Refresh, nil
+	}
+}
+
+// SetTokenSource is a client option for authenticating the requests with tokens of the given TokenSource.
+// It takes precedence over SetAPIKey. If the API rejects a token with 401 and the source is a
+// RefreshableTokenSource, the token is refreshed and the request is retried once.
+func SetTokenSource(ts TokenSource) ClientOpt {
+	return func(c *Client) error {
+		c.tokenSource = ts
+		return nil
+	}
+}
+
+// SetBearerToken is a client option for authenticating with a JWT access token, which is refreshed with
+// the refresh token through the EdgeCenter IAM API.
+func SetBearerToken(accessToken, refreshToken string) ClientOpt {
+	return SetTokenSource(NewBearerTokenSource(accessToken, refreshToken, nil))
+}
+
+// TokenSource returns the TokenSource of the credentials in the config: the API token if it is set,
+// the access and refresh tokens otherwise. It returns nil if the config has no credentials.
+func (cfg CloudConfig) TokenSource(httpClient *http.Client) TokenSource {
+	switch {
+	case cfg.APIToken != "":
+		return APIKeyTokenSource(cfg.APIToken)
+	case cfg.AccessToken != "" || cfg.RefreshToken != "":
+		return NewBearerTokenSource(cfg.AccessToken, cfg.RefreshToken, JWTRefresher(httpClient, cfg.AuthURL))
+	default:
+		return nil
+	}
+}
+
+// authorize sets the Authorization header of the request to a token of the client TokenSource, if any.
+func (c *Client) authorize(ctx context.Context, req *http.Request) (*Token, error) {
+	if c.tokenSource == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", token.authorization())
+
+	return token, nil
+}
+
+// send sends the request authenticated with the client TokenSource. If the token is rejected
+// and can be refreshed, the request is sent once more with a refreshed token.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	token, err := c.authorize(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.roundTrip(ctx, req)
+	if err != nil || token == nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	refresher, ok := c.tokenSource.(RefreshableTokenSource)
+	if !ok || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, nil
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if token, err = refresher.Refresh(ctx, token); err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", token.authorization())
+	if req.GetBody != nil {
+		if req.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.roundTrip(ctx, req)
+}
+
+// roundTrip sends the request with the HTTP client, within the client-side rate limits.
+func (c *Client) roundTrip(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if err := c.rateLimiter.wait(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return DoRequestWithClient(ctx, c.HTTPClient, req)
+}
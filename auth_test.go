@@ -0,0 +1,212 @@
+package edgecloud
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testJWT returns an unsigned JWT with the given expiry, which is enough for the client as it does not verify tokens.
+func testJWT(name string, expiry time.Time) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	claims, _ := json.Marshal(map[string]interface{}{"exp": expiry.Unix(), "name": name})
+
+	return encode([]byte(`{"alg":"none"}`)) + "." + encode(claims) + "." + encode([]byte("signature"))
+}
+
+func countingRefresher(calls *int32, accessToken string) TokenRefreshFunc {
+	return func(_ context.Context, refreshToken string) (string, string, error) {
+		atomic.AddInt32(calls, 1)
+		if refreshToken != "refresh" {
+			return "", "", fmt.Errorf("unexpected refresh token %q", refreshToken)
+		}
+
+		return accessToken, "", nil
+	}
+}
+
+func TestAPIKeyTokenSource(t *testing.T) {
+	for _, apiKey := range []string{"4010$252a09", "APIKey 4010$252a09", "apikey 4010$252a09"} {
+		token, err := APIKeyTokenSource(apiKey).Token(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "APIKey 4010$252a09", token.authorization())
+		assert.False(t, token.expired(time.Hour))
+	}
+}
+
+func TestBearerTokenSource_RefreshBeforeExpiry(t *testing.T) {
+	expired := testJWT("old", time.Now().Add(10*time.Second))
+	fresh := testJWT("new", time.Now().Add(time.Hour))
+
+	var calls int32
+	ts := NewBearerTokenSource(expired, "refresh", countingRefresher(&calls, fresh))
+
+	token, err := ts.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, fresh, token.Value)
+	assert.Equal(t, TokenTypeBearer, token.Type)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), token.Expiry, 2*time.Second)
+
+	_, err = ts.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestBearerTokenSource_Concurrent(t *testing.T) {
+	fresh := testJWT("new", time.Now().Add(time.Hour))
+
+	var calls int32
+	ts := NewBearerTokenSource("", "refresh", countingRefresher(&calls, fresh))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := ts.Token(ctx)
+			if assert.NoError(t, err) {
+				assert.Equal(t, fresh, token.Value)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestBearerTokenSource_WithoutRefreshToken(t *testing.T) {
+	_, err := NewBearerTokenSource("", "", nil).Token(ctx)
+	assert.ErrorIs(t, err, ErrNoRefreshToken)
+
+	expired := testJWT("old", time.Now().Add(-time.Minute))
+	ts := NewBearerTokenSource(expired, "", nil)
+	token, err := ts.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expired, token.Value)
+
+	_, err = ts.Refresh(ctx, token)
+	assert.ErrorIs(t, err, ErrNoRefreshToken)
+}
+
+func TestSetTokenSource_RetryOnUnauthorized(t *testing.T) {
+	setup()
+	defer teardown()
+
+	stale := testJWT("stale", time.Now().Add(time.Hour))
+	fresh := testJWT("fresh", time.Now().Add(time.Hour))
+
+	var requests int
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name":"foo"}`, string(body))
+		if r.Header.Get("Authorization") != "Bearer "+fresh {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"id":"1"}`)
+	})
+
+	var calls int32
+	require.NoError(t, SetTokenSource(NewBearerTokenSource(stale, "refresh", countingRefresher(&calls, fresh)))(client))
+
+	req, err := client.NewRequest(ctx, http.MethodPost, "/foo", map[string]string{"name": "foo"})
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestSetTokenSource_UnauthorizedAfterRefresh(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	var calls int32
+	ts := NewBearerTokenSource(testJWT("stale", time.Now().Add(time.Hour)), "refresh",
+		countingRefresher(&calls, testJWT("fresh", time.Now().Add(time.Hour))))
+	require.NoError(t, SetTokenSource(ts)(client))
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestSetTokenSource_APIKeyNotRetried(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "APIKey key", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	require.NoError(t, SetTokenSource(APIKeyTokenSource("key"))(client))
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.Equal(t, 1, requests)
+}
+
+func TestJWTRefresher(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/iam/"+jwtRefreshPath, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		reqBody := new(jwtRefreshRequest)
+		if err := json.NewDecoder(r.Body).Decode(reqBody); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		if reqBody.Refresh != "refresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprint(w, `{"message": "invalid refresh token"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"access": "access", "refresh": "new-refresh"}`)
+	})
+
+	refresh := JWTRefresher(server.Client(), server.URL+"/iam")
+
+	accessToken, refreshToken, err := refresh(ctx, "refresh")
+	require.NoError(t, err)
+	assert.Equal(t, "access", accessToken)
+	assert.Equal(t, "new-refresh", refreshToken)
+
+	_, _, err = refresh(ctx, "invalid")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestCloudConfig_TokenSource(t *testing.T) {
+	assert.Nil(t, CloudConfig{}.TokenSource(nil))
+	assert.IsType(t, &staticTokenSource{}, CloudConfig{APIToken: "key", AccessToken: "access"}.TokenSource(nil))
+	assert.IsType(t, &BearerTokenSource{}, CloudConfig{RefreshToken: "refresh"}.TokenSource(nil))
+}
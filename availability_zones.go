@@ -30,11 +30,13 @@ type AvailabilityZonesList struct {
 
 // List get availability zones in a region.
 func (s *AvailabilityZonesServiceOp) List(ctx context.Context) (*AvailabilityZonesList, *Response, error) {
-	if resp, err := s.client.ValidateRegion(); err != nil {
+	ctx = withOperation(ctx, "AvailabilityZones.List")
+
+	if resp, err := s.client.ValidateRegionContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addRegionPath(AvailabilityZoneBasePath)
+	path := s.client.addRegionPath(ctx, AvailabilityZoneBasePath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-
-	"github.com/go-playground/validator/v10"
 )
 
 const (
@@ -54,7 +52,7 @@ type BareMetalInterfaceOpts struct {
 	NetworkID  string               `json:"network_id,omitempty" validate:"rfe=Type:subnet,omitempty,uuid4"`
 	SubnetID   string               `json:"subnet_id,omitempty" validate:"rfe=Type:subnet,omitempty,uuid4"`
 	PortID     string               `json:"port_id,omitempty" validate:"rfe=Type:reserved_fixed_ip,allowed_without_all=NetworkID SubnetID,omitempty,uuid4"`
-	FloatingIP *InterfaceFloatingIP `json:"floating_ip,omitempty" validate:"omitempty,dive"`
+	FloatingIP *InterfaceFloatingIP `json:"floating_ip,omitempty" validate:"omitempty"`
 }
 
 // BareMetalServerCreateRequest represents a request to create an bare metal server.
@@ -112,13 +110,15 @@ type BareMetalQuotaCheckRequest struct {
 }
 
 func (s *InstancesServiceOp) BareMetalCheckQuotasForInstanceCreation(ctx context.Context, reqBody *BareMetalQuotaCheckRequest) (Quota, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Instances.BareMetalCheckQuotasForInstanceCreation")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
-	path := s.client.addProjectRegionPath(bmInstancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, bmInstancesBasePathV1)
 	path = fmt.Sprintf("%s/%s", path, bmCheckLimitsSupPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -136,11 +136,13 @@ func (s *InstancesServiceOp) BareMetalCheckQuotasForInstanceCreation(ctx context
 }
 
 func (s *InstancesServiceOp) BareMetalGetCountAvailableNodes(ctx context.Context) (*BareMetalCapacity, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Instances.BareMetalGetCountAvailableNodes")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(bmCapacityBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, bmCapacityBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -157,14 +159,16 @@ func (s *InstancesServiceOp) BareMetalGetCountAvailableNodes(ctx context.Context
 }
 
 func (s *InstancesServiceOp) BareMetalListFlavors(ctx context.Context, opts *BareMetalFlavorsOpts, reqBody *BareMetalFlavorsRequest) ([]BareMetalFlavor, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Instances.BareMetalListFlavors")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 	var err error
-	path := s.client.addProjectRegionPath(bmInstancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, bmInstancesBasePathV1)
 	path = fmt.Sprintf("%s/%s", path, bmAvailableFlavorsSubPath)
 	if opts != nil {
 		path, err = addOptions(path, opts)
@@ -188,6 +192,8 @@ func (s *InstancesServiceOp) BareMetalListFlavors(ctx context.Context, opts *Bar
 }
 
 func (s *InstancesServiceOp) BareMetalRebuildInstance(ctx context.Context, instanceID string, reqBody *BareMetalRebuildRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.BareMetalRebuildInstance")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
@@ -195,11 +201,11 @@ func (s *InstancesServiceOp) BareMetalRebuildInstance(ctx context.Context, insta
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(bmInstancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, bmInstancesBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, instanceID, bmRebuildSubPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -217,15 +223,21 @@ func (s *InstancesServiceOp) BareMetalRebuildInstance(ctx context.Context, insta
 }
 
 func (s *InstancesServiceOp) BareMetalCreateInstance(ctx context.Context, reqBody *BareMetalServerCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.BareMetalCreateInstance")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(bmInstancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, bmInstancesBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -242,17 +254,18 @@ func (s *InstancesServiceOp) BareMetalCreateInstance(ctx context.Context, reqBod
 }
 
 func (s *InstancesServiceOp) BareMetalListInstances(ctx context.Context, opts *BareMetalInstancesListOpts) ([]Instance, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Instances.BareMetalListInstances")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	validate := validator.New()
-	err := validate.Struct(opts)
+	err := validateRequest(opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	path := s.client.addProjectRegionPath(bmInstancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, bmInstancesBasePathV1)
 	if opts != nil {
 		path, err = addOptions(path, opts)
 		if err != nil {
@@ -271,5 +284,7 @@ func (s *InstancesServiceOp) BareMetalListInstances(ctx context.Context, opts *B
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Instances, resp, err
 }
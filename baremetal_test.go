@@ -51,7 +51,11 @@ func TestBareMetalServiceOp_CreateInstance(t *testing.T) {
 		_, _ = fmt.Fprintf(w, `%s`, string(resp))
 	})
 
-	bmInstanceCreateRequest := BareMetalServerCreateRequest{}
+	bmInstanceCreateRequest := BareMetalServerCreateRequest{
+		Flavor:     "bm1-infrastructure-small",
+		Names:      []string{"test-bm-instance"},
+		Interfaces: []BareMetalInterfaceOpts{{Type: InterfaceTypeExternal}},
+	}
 
 	respActual, resp, err := client.Instances.BareMetalCreateInstance(ctx, &bmInstanceCreateRequest)
 	require.NoError(t, err)
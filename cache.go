@@ -0,0 +1,404 @@
+package edgecloud
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// defaultCacheTTL is the time the responses are cached for when CacheConfig.DefaultTTL is not set.
+	defaultCacheTTL = 5 * time.Minute
+	// defaultCacheFetchTimeout bounds the requests shared by identical callers when CacheConfig.FetchTimeout is not set.
+	defaultCacheFetchTimeout = time.Minute
+	// cacheTaskTimeout is the time after which a task changing a cached resource is forgotten if its end is not seen.
+	cacheTaskTimeout = time.Hour
+)
+
+// CacheResource identifies a slow-changing catalog of the API whose GET responses may be cached.
+type CacheResource string
+
+const (
+	// CacheResourceFlavors contains the instance, baremetal and loadbalancer flavors.
+	CacheResourceFlavors CacheResource = "flavors"
+	// CacheResourceImages contains the instance and baremetal images.
+	CacheResourceImages CacheResource = "images"
+	// CacheResourceProjectImages contains the images of the project. They change with the uploads
+	// of the project, so they are cached only if their TTL is set in CacheConfig.TTLs.
+	CacheResourceProjectImages CacheResource = "project_images"
+	// CacheResourceRegions contains the regions.
+	CacheResourceRegions CacheResource = "regions"
+	// CacheResourceAvailabilityZones contains the availability zones of the regions.
+	CacheResourceAvailabilityZones CacheResource = "availability_zones"
+	// CacheResourceMKaaSVersions contains the Kubernetes versions supported by MKaaS.
+	CacheResourceMKaaSVersions CacheResource = "mkaas_versions"
+	// CacheResourceDBaaSDBMS contains the database management systems supported by DBaaS.
+	CacheResourceDBaaSDBMS CacheResource = "dbaas_dbms"
+)
+
+// cacheResourcePaths are the base paths of the cached resources.
+var cacheResourcePaths = map[CacheResource][]string{
+	CacheResourceFlavors:           {flavorsBasePathV1, bmflavorsBasePathV1, lbflavorsBasePathV1},
+	CacheResourceImages:            {imagesBasePathV1, bmimagesBasePathV1},
+	CacheResourceProjectImages:     {projectimagesBasePathV1},
+	CacheResourceRegions:           {regionsBasePath},
+	CacheResourceAvailabilityZones: {AvailabilityZoneBasePath},
+	CacheResourceMKaaSVersions:     {MKaaSRegionsBasePathV2},
+	CacheResourceDBaaSDBMS:         {DBaaSDbmsBasePathV3},
+}
+
+// cacheChangePaths are the base paths of the requests that change cached resources besides their own, e.g.
+// an image downloaded to the project is listed with both the images and the project images.
+var cacheChangePaths = map[string][]CacheResource{
+	imagesBasePathV1:        {CacheResourceProjectImages},
+	projectimagesBasePathV1: {CacheResourceImages},
+	downloadimageBasePathV1: {CacheResourceImages, CacheResourceProjectImages},
+}
+
+// CacheConfig configures the caching of the GET responses of the slow-changing catalogs.
+type CacheConfig struct {
+	// DefaultTTL is the time the responses of the resources not set in TTLs are cached for, 5 minutes by default.
+	DefaultTTL time.Duration
+
+	// TTLs sets the time the responses of a resource are cached for. A negative TTL disables the caching
+	// of the resource.
+	TTLs map[CacheResource]time.Duration
+
+	// FetchTimeout bounds a request shared by identical concurrent requests, 1 minute by default. The shared request
+	// is not canceled with the context of any of them, each of which stops waiting when its context is done.
+	FetchTimeout time.Duration
+
+	// OnLookup is an optional metrics hook called for every cacheable request. hit is false when the request
+	// was sent to the API, and true when it was served from the cache or shared with an identical request in flight.
+	OnLookup func(ctx context.Context, resource CacheResource, hit bool)
+}
+
+// cachedResponse is a successful response stored in the cache. Its body is decoded anew for every request,
+// so the callers never share the decoded values.
+type cachedResponse struct {
+	resource   CacheResource
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+	expires    time.Time
+}
+
+// cache holds the cached responses, shared by all scoped copies of the client.
+type cache struct {
+	config CacheConfig
+	now    func() time.Time
+	group  singleflight.Group
+
+	mu        sync.Mutex
+	responses map[string]*cachedResponse
+	// generation is incremented by every invalidation, so that the responses fetched before it are not cached.
+	generation uint64
+	// tasks are the tasks in flight changing cached resources, which are not cached until the tasks end.
+	tasks map[string]cacheTask
+}
+
+// cacheTask is a task in flight changing cached resources.
+type cacheTask struct {
+	resources []CacheResource
+	expires   time.Time
+}
+
+func newCache(config CacheConfig) *cache {
+	if config.DefaultTTL <= 0 {
+		config.DefaultTTL = defaultCacheTTL
+	}
+	if config.FetchTimeout <= 0 {
+		config.FetchTimeout = defaultCacheFetchTimeout
+	}
+
+	return &cache{
+		config:    config,
+		now:       time.Now,
+		responses: make(map[string]*cachedResponse),
+		tasks:     make(map[string]cacheTask),
+	}
+}
+
+// WithCache is a client option for caching the GET responses of the slow-changing catalogs, e.g. flavors and images,
+// in memory for the TTL of their CacheResource. Concurrent identical requests are sent to the API only once.
+//
+// A successful request changing a cached resource, e.g. an image upload, invalidates its cached responses.
+// When the change is made by tasks, the resource is not cached until they end, which is seen when they are
+// got with Tasks.Get, e.g. by the task waiting of the util package. The responses may also be invalidated
+// explicitly with Client.InvalidateCache.
+func WithCache(config CacheConfig) ClientOpt {
+	return func(c *Client) error {
+		c.cache = newCache(config)
+		return nil
+	}
+}
+
+// InvalidateCache drops the cached responses of the resources, or of all the resources if none is given.
+// It does nothing if the client has no cache.
+func (c *Client) InvalidateCache(resources ...CacheResource) {
+	c.cache.invalidate(resources...)
+}
+
+func (c *cache) invalidate(resources ...CacheResource) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidateLocked(resources...)
+}
+
+func (c *cache) invalidateLocked(resources ...CacheResource) {
+	c.generation++
+	if len(resources) == 0 {
+		clear(c.responses)
+		return
+	}
+
+	for key, cached := range c.responses {
+		if slices.Contains(resources, cached.resource) {
+			delete(c.responses, key)
+		}
+	}
+}
+
+// resource returns the cached resource the request is made to and whether its responses are cached.
+func (c *cache) resource(req *http.Request) (CacheResource, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	resource := resourceOfPath(req.URL.Path)
+	if resource == "" {
+		return "", false
+	}
+
+	return resource, c.ttl(resource) > 0
+}
+
+func (c *cache) ttl(resource CacheResource) time.Duration {
+	if ttl, ok := c.config.TTLs[resource]; ok {
+		return ttl
+	}
+	if resource == CacheResourceProjectImages {
+		return 0
+	}
+
+	return c.config.DefaultTTL
+}
+
+// changingLocked reports whether a task in flight changes the resource, forgetting the expired tasks.
+func (c *cache) changingLocked(resource CacheResource) bool {
+	changing := false
+	now := c.now()
+	for id, task := range c.tasks {
+		if now.After(task.expires) {
+			delete(c.tasks, id)
+			continue
+		}
+		changing = changing || slices.Contains(task.resources, resource)
+	}
+
+	return changing
+}
+
+// resourceOfPath returns the cached resource whose base path is a part of the path, if any.
+func resourceOfPath(path string) CacheResource {
+	if strings.Contains(path, MKaaSRegionsBasePathV2) && !strings.HasSuffix(path, "/versions") {
+		return ""
+	}
+
+	for resource, basePaths := range cacheResourcePaths {
+		for _, basePath := range basePaths {
+			if hasBasePath(path, basePath) {
+				return resource
+			}
+		}
+	}
+
+	return ""
+}
+
+// changedResources returns the cached resources changed by a request to the path.
+func changedResources(path string) []CacheResource {
+	var resources []CacheResource
+	if resource := resourceOfPath(path); resource != "" {
+		resources = append(resources, resource)
+	}
+	for basePath, changed := range cacheChangePaths {
+		if hasBasePath(path, basePath) {
+			resources = append(resources, changed...)
+		}
+	}
+
+	return resources
+}
+
+// hasBasePath reports whether the base path is a part of the path.
+func hasBasePath(path, basePath string) bool {
+	i := strings.Index(path, basePath)
+	if i < 0 {
+		return false
+	}
+	rest := path[i+len(basePath):]
+
+	return rest == "" || rest[0] == '/'
+}
+
+// get returns the cached response to the request, or sends the request with fetch and caches its response.
+// The request is shared by identical concurrent requests, each of which waits for it until its ctx is done.
+func (c *cache) get(
+	ctx context.Context, resource CacheResource, req *http.Request,
+	fetch func(ctx context.Context) (*cachedResponse, *Response, error),
+) (*cachedResponse, *Response, error) {
+	key := req.URL.String()
+
+	c.mu.Lock()
+	cached, ok := c.responses[key]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && c.now().Before(cached.expires) {
+		c.lookup(ctx, resource, true)
+		return cached, nil, nil
+	}
+
+	type fetched struct {
+		cached   *cachedResponse
+		response *Response
+	}
+
+	sent := false
+	results := c.group.DoChan(key, func() (interface{}, error) {
+		sent = true
+		// the request is shared, so it is not canceled with the context of the caller that sends it
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.config.FetchTimeout)
+		defer cancel()
+
+		cached, response, err := fetch(fetchCtx)
+		if err != nil {
+			return fetched{response: response}, err
+		}
+
+		cached.resource = resource
+		cached.expires = c.now().Add(c.ttl(resource))
+		c.mu.Lock()
+		if c.generation == generation && !c.changingLocked(resource) {
+			c.responses[key] = cached
+		}
+		c.mu.Unlock()
+
+		return fetched{cached: cached, response: response}, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, internalErrorResponse(), ctx.Err()
+	case result := <-results:
+		c.lookup(ctx, resource, !sent)
+		v := result.Val.(fetched)
+
+		return v.cached, v.response, result.Err
+	}
+}
+
+func (c *cache) lookup(ctx context.Context, resource CacheResource, hit bool) {
+	if c.config.OnLookup != nil {
+		c.config.OnLookup(ctx, resource, hit)
+	}
+}
+
+// doCached serves the GET request from the cache, sending it to the API on a miss.
+func (c *Client) doCached(ctx context.Context, resource CacheResource, req *http.Request, v interface{}) (*Response, error) {
+	cached, response, err := c.cache.get(ctx, resource, req, func(ctx context.Context) (*cachedResponse, *Response, error) {
+		resp, err := c.send(ctx, req)
+		if err != nil {
+			return nil, internalErrorResponse(), err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if c.onRequestCompleted != nil {
+			c.onRequestCompleted(req, resp)
+		}
+
+		response := newResponse(resp)
+		if err := CheckResponse(resp); err != nil {
+			return nil, response, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, internalErrorResponse(), err
+		}
+
+		return &cachedResponse{
+			status:     resp.Status,
+			statusCode: resp.StatusCode,
+			header:     resp.Header.Clone(),
+			body:       body,
+		}, response, nil
+	})
+	if err != nil {
+		return response, err
+	}
+
+	resp := &http.Response{
+		Status:     cached.status,
+		StatusCode: cached.statusCode,
+		Header:     cached.header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(cached.body)),
+		Request:    req,
+	}
+
+	return decodeResponse(resp, v)
+}
+
+// onResponse invalidates the cached responses of the resources changed by a successful request. The changes
+// made by tasks are complete only when the tasks end, so the resources are not cached until Tasks.Get reports
+// their end, and are invalidated again then.
+func (c *cache) onResponse(req *http.Request, v interface{}) {
+	if c == nil {
+		return
+	}
+
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		if task, ok := v.(*Task); ok && (task.State == TaskStateFinished || task.State == TaskStateError) {
+			c.endTask(task.ID)
+		}
+		return
+	}
+
+	resources := changedResources(req.URL.Path)
+	if len(resources) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidateLocked(resources...)
+	if tasks, ok := v.(*TaskResponse); ok && tasks != nil {
+		for _, taskID := range tasks.Tasks {
+			c.tasks[taskID] = cacheTask{resources: resources, expires: c.now().Add(cacheTaskTimeout)}
+		}
+	}
+}
+
+// endTask invalidates the cached responses of the resources changed by the ended task.
+func (c *cache) endTask(taskID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if task, ok := c.tasks[taskID]; ok {
+		delete(c.tasks, taskID)
+		c.invalidateLocked(task.resources...)
+	}
+}
@@ -0,0 +1,397 @@
+package edgecloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int64
+	URL := path.Join(flavorsBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests.Add(1)
+		_, _ = fmt.Fprintf(w, `{"results":[{"flavor_id":"%s"}]}`, testResourceID)
+	})
+
+	var hits, misses int
+	err := WithCache(CacheConfig{
+		OnLookup: func(_ context.Context, resource CacheResource, hit bool) {
+			assert.Equal(t, CacheResourceFlavors, resource)
+			if hit {
+				hits++
+			} else {
+				misses++
+			}
+		},
+	})(client)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		flavors, resp, err := client.Flavors.List(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []Flavor{{FlavorID: testResourceID}}, flavors)
+	}
+	assert.Equal(t, int64(1), requests.Load())
+	assert.Equal(t, 2, hits)
+	assert.Equal(t, 1, misses)
+
+	// Another query is cached apart.
+	_, _, err = client.Flavors.List(ctx, &FlavorListOptions{IncludePrices: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), requests.Load())
+
+	// The scoped copies share the cache.
+	_, _, err = client.WithScope(projectID, regionID).Flavors.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), requests.Load())
+
+	client.InvalidateCache(CacheResourceImages)
+	_, _, err = client.Flavors.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), requests.Load())
+
+	client.InvalidateCache(CacheResourceFlavors)
+	_, _, err = client.Flavors.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), requests.Load())
+}
+
+func TestWithCache_TTL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int64
+	mux.HandleFunc(regionsBasePath, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = fmt.Fprint(w, `{"results":[]}`)
+	})
+	imagesURL := path.Join(imagesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(imagesURL, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = fmt.Fprint(w, `{"results":[]}`)
+	})
+
+	require.NoError(t, WithCache(CacheConfig{
+		TTLs: map[CacheResource]time.Duration{
+			CacheResourceRegions: time.Minute,
+			CacheResourceImages:  -1,
+		},
+	})(client))
+	now := time.Now()
+	client.cache.now = func() time.Time { return now }
+
+	_, _, err := client.Regions.List(ctx, nil)
+	require.NoError(t, err)
+	now = now.Add(59 * time.Second)
+	_, _, err = client.Regions.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), requests.Load())
+
+	now = now.Add(time.Second)
+	_, _, err = client.Regions.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), requests.Load())
+
+	for i := 0; i < 2; i++ {
+		_, _, err = client.Images.List(ctx, nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(4), requests.Load())
+}
+
+func TestWithCache_Concurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int64
+	release := make(chan struct{})
+	URL := path.Join(lbflavorsBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		_, _ = fmt.Fprintf(w, `{"results":[{"flavor_id":"%s"}]}`, testResourceID)
+	})
+
+	var mu sync.Mutex
+	hits := 0
+	require.NoError(t, WithCache(CacheConfig{
+		OnLookup: func(_ context.Context, _ CacheResource, hit bool) {
+			mu.Lock()
+			defer mu.Unlock()
+			if hit {
+				hits++
+			}
+		},
+	})(client))
+
+	const callers = 5
+	var wg sync.WaitGroup
+	results := make([][]Flavor, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			flavors, _, err := client.Loadbalancers.FlavorList(ctx, nil)
+			assert.NoError(t, err)
+			results[i] = flavors
+		}(i)
+	}
+
+	assert.Eventually(t, func() bool { return requests.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), requests.Load())
+	assert.Equal(t, callers-1, hits)
+	for _, flavors := range results {
+		assert.Equal(t, []Flavor{{FlavorID: testResourceID}}, flavors)
+	}
+
+	// The callers get their own copies of the cached values.
+	results[0][0].FlavorID = "changed"
+	assert.Equal(t, testResourceID, results[1][0].FlavorID)
+}
+
+func TestWithCache_InvalidatedOnChange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int64
+	URL := path.Join(imagesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = fmt.Fprint(w, `{"results":[]}`)
+	})
+	mux.HandleFunc(path.Join(URL, testResourceID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		_, _ = fmt.Fprint(w, `{"tasks":["`+taskID+`"]}`)
+	})
+
+	require.NoError(t, WithCache(CacheConfig{})(client))
+
+	_, _, err := client.Images.List(ctx, nil)
+	require.NoError(t, err)
+	_, _, err = client.Images.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), requests.Load())
+
+	_, _, err = client.Images.Delete(ctx, testResourceID)
+	require.NoError(t, err)
+	_, _, err = client.Images.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), requests.Load())
+}
+
+func TestWithCache_InvalidatedOnTaskEnd(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int64
+	URL := path.Join(imagesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = fmt.Fprint(w, `{"results":[]}`)
+	})
+	mux.HandleFunc(path.Join(downloadimageBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID)), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		_, _ = fmt.Fprint(w, `{"tasks":["`+taskID+`"]}`)
+	})
+	state := TaskStateRunning
+	mux.HandleFunc(path.Join(tasksBasePathV1, taskID), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"id":"%s","state":"%s"}`, taskID, state)
+	})
+
+	require.NoError(t, WithCache(CacheConfig{})(client))
+	list := func(expected int64) {
+		t.Helper()
+		_, _, err := client.Images.List(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, requests.Load())
+	}
+
+	list(1)
+	list(1)
+	_, _, err := client.Images.Upload(ctx, &ImageUploadRequest{Name: "test-image", URL: "http://example.com/image.qcow2"})
+	require.NoError(t, err)
+
+	// The images are not cached while the upload is in progress.
+	list(2)
+	list(3)
+	_, _, err = client.Tasks.Get(ctx, taskID)
+	require.NoError(t, err)
+	list(4)
+
+	state = TaskStateFinished
+	_, _, err = client.Tasks.Get(ctx, taskID)
+	require.NoError(t, err)
+	list(5)
+	list(5)
+}
+
+func TestWithCache_TaskForgotten(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int64
+	URL := path.Join(imagesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = fmt.Fprint(w, `{"results":[]}`)
+	})
+	mux.HandleFunc(path.Join(URL, testResourceID), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"tasks":["`+taskID+`"]}`)
+	})
+
+	require.NoError(t, WithCache(CacheConfig{})(client))
+	now := time.Now()
+	client.cache.now = func() time.Time { return now }
+
+	_, _, err := client.Images.Delete(ctx, testResourceID)
+	require.NoError(t, err)
+	now = now.Add(cacheTaskTimeout + time.Second)
+	for i := 0; i < 2; i++ {
+		_, _, err = client.Images.List(ctx, nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(1), requests.Load())
+}
+
+func TestWithCache_ProjectImages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int64
+	URL := path.Join(projectimagesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = fmt.Fprint(w, `{"results":[]}`)
+	})
+
+	require.NoError(t, WithCache(CacheConfig{})(client))
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Images.ImagesProjectList(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(2), requests.Load(), "the project images are not cached by default")
+
+	require.NoError(t, WithCache(CacheConfig{
+		TTLs: map[CacheResource]time.Duration{CacheResourceProjectImages: time.Minute},
+	})(client))
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Images.ImagesProjectList(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(3), requests.Load())
+}
+
+func TestWithCache_CanceledCaller(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int64
+	release := make(chan struct{})
+	mux.HandleFunc(regionsBasePath, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		_, _ = fmt.Fprint(w, `{"results":[{"id":1}]}`)
+	})
+
+	require.NoError(t, WithCache(CacheConfig{})(client))
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	canceled := make(chan error)
+	go func() {
+		_, _, err := client.Regions.List(canceledCtx, nil)
+		canceled <- err
+	}()
+	require.Eventually(t, func() bool { return requests.Load() == 1 }, time.Second, time.Millisecond)
+
+	waiting := make(chan error)
+	go func() {
+		regions, _, err := client.Regions.List(ctx, nil)
+		assert.Len(t, regions, 1)
+		waiting <- err
+	}()
+
+	cancel()
+	require.ErrorIs(t, <-canceled, context.Canceled)
+	close(release)
+	require.NoError(t, <-waiting)
+	assert.Equal(t, int64(1), requests.Load())
+}
+
+func TestWithCache_ErrorNotCached(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests atomic.Int64
+	mux.HandleFunc(regionsBasePath, func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"results":[]}`)
+	})
+
+	require.NoError(t, WithCache(CacheConfig{})(client))
+
+	_, resp, err := client.Regions.List(ctx, nil)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	_, _, err = client.Regions.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), requests.Load())
+}
+
+func TestResourceOfPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected CacheResource
+	}{
+		{path: "/v1/flavors/1/2", expected: CacheResourceFlavors},
+		{path: "/v1/bmflavors/1/2", expected: CacheResourceFlavors},
+		{path: "/v1/lbflavors/1/2", expected: CacheResourceFlavors},
+		{path: "/v1/images/1/2/" + testResourceID, expected: CacheResourceImages},
+		{path: "/v1/projectimages/1/2", expected: CacheResourceProjectImages},
+		{path: "/v1/regions", expected: CacheResourceRegions},
+		{path: "/v1/regions/2", expected: CacheResourceRegions},
+		{path: "/v1/availability_zones/2", expected: CacheResourceAvailabilityZones},
+		{path: "/mkaas/v2/regions/2/versions", expected: CacheResourceMKaaSVersions},
+		{path: "/dbaas/v3/dbms", expected: CacheResourceDBaaSDBMS},
+		{path: "/cloud/v1/flavors/1/2", expected: CacheResourceFlavors},
+		{path: "/v1/instances/1/2", expected: ""},
+		{path: "/v1/images_extra/1/2", expected: ""},
+		{path: "/mkaas/v2/clusters/1/2", expected: ""},
+		{path: "/v1/downloadimage/1/2", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, resourceOfPath(tt.path))
+		})
+	}
+}
+
+func TestChangedResources(t *testing.T) {
+	assert.Equal(t, []CacheResource{CacheResourceFlavors}, changedResources("/v1/flavors/1/2"))
+	assert.ElementsMatch(t, []CacheResource{CacheResourceImages, CacheResourceProjectImages}, changedResources("/v1/images/1/2/"+testResourceID))
+	assert.ElementsMatch(t, []CacheResource{CacheResourceImages, CacheResourceProjectImages}, changedResources("/v1/downloadimage/1/2"))
+	assert.Empty(t, changedResources("/v1/instances/1/2"))
+}
@@ -0,0 +1,157 @@
+package edgecloud
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ilyakaznacheev/cleanenv"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvConfigFile is the environment variable with the path of the profiles file.
+	EnvConfigFile = "EDGECENTER_CLOUD_CONFIG_FILE"
+	// EnvProfile is the environment variable with the name of the active profile.
+	EnvProfile = "EDGECENTER_CLOUD_PROFILE"
+
+	// DefaultProfile is the profile used when no profile is chosen either explicitly, with EnvProfile or in the file.
+	DefaultProfile = "default"
+
+	// defaultConfigFile is the path of the profiles file relative to the home directory of the user.
+	defaultConfigFile = ".edgecenter/cloud.yaml"
+)
+
+var ErrProfileNotFound = errors.New("profile not found")
+
+// ConfigFile is the content of a profiles file, e.g.
+//
+//	profile: production
+//	profiles:
+//	  production:
+//	    apiURL: https://api.edgecenter.ru/cloud
+//	    apiToken: <api-key>
+//	    projectID: 12345
+//	    regionID: 10
+//	    retryMax: 3
+//	  staging:
+//	    accessToken: <access-token>
+//	    refreshToken: <refresh-token>
+//	    projectID: 54321
+//	    regionID: 8
+type ConfigFile struct {
+	// Profile is the name of the profile that is active unless another one is chosen.
+	Profile  string                 `yaml:"profile"`
+	Profiles map[string]CloudConfig `yaml:"profiles"`
+}
+
+// ReadConfigFile reads a profiles file.
+func ReadConfigFile(path string) (*ConfigFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file ConfigFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	return &file, nil
+}
+
+// LoadConfig loads the configuration of the active profile, overridden with the EDGECENTER_CLOUD_* environment
+// variables listed in CloudConfig.
+//
+// The profiles are read from the file at path or, if path is empty, at EnvConfigFile or ~/.edgecenter/cloud.yaml.
+// A missing default file is not an error, so the configuration may come from the environment only.
+// The active profile is the given one or, if profile is empty, the one named by EnvProfile, by the profile
+// field of the file or DefaultProfile, in this order.
+func LoadConfig(path, profile string) (*CloudConfig, error) {
+	explicitPath := path != ""
+	if !explicitPath {
+		path, explicitPath = os.LookupEnv(EnvConfigFile)
+	}
+	if path == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, defaultConfigFile)
+		}
+	}
+
+	file := &ConfigFile{}
+	if path != "" {
+		read, err := ReadConfigFile(path)
+		switch {
+		case err == nil:
+			file = read
+		case explicitPath || !errors.Is(err, os.ErrNotExist):
+			return nil, err
+		}
+	}
+
+	if profile == "" {
+		profile = os.Getenv(EnvProfile)
+	}
+	if profile == "" {
+		profile = file.Profile
+	}
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	cfg, ok := file.Profiles[profile]
+	if !ok && (len(file.Profiles) > 0 || profile != DefaultProfile) {
+		return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, profile)
+	}
+
+	if err := cleanenv.ReadEnv(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to read config from environment: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+// Options returns the client options that apply the configuration.
+func (cfg CloudConfig) Options() ([]ClientOpt, error) {
+	ts := cfg.TokenSource(nil)
+	if ts == nil {
+		return nil, NewArgError("CloudConfig", "has no credentials: apiToken, accessToken or refreshToken must be set")
+	}
+
+	opts := []ClientOpt{SetTokenSource(ts), SetProject(cfg.ProjectID), SetRegion(cfg.RegionID)}
+	if cfg.APIUrl != "" {
+		opts = append(opts, SetBaseURL(cfg.APIUrl))
+	}
+	if cfg.RetryMax > 0 {
+		retryConfig := RetryConfig{RetryMax: cfg.RetryMax}
+		if cfg.RetryWaitMin > 0 {
+			retryConfig.RetryWaitMin = PtrTo(cfg.RetryWaitMin)
+		}
+		if cfg.RetryWaitMax > 0 {
+			retryConfig.RetryWaitMax = PtrTo(cfg.RetryWaitMax)
+		}
+		opts = append(opts, WithRetryAndBackoffs(retryConfig))
+	}
+
+	return opts, nil
+}
+
+// NewFromConfig returns a new EdgecenterCloud API client configured with cfg. The extra options are applied
+// after the configuration, so they may override it. A nil cfg is loaded with LoadConfig from the default
+// locations and the environment.
+func NewFromConfig(cfg *CloudConfig, opts ...ClientOpt) (*Client, error) {
+	if cfg == nil {
+		var err error
+		if cfg, err = LoadConfig("", ""); err != nil {
+			return nil, err
+		}
+	}
+
+	cfgOpts, err := cfg.Options()
+	if err != nil {
+		return nil, err
+	}
+
+	return New(nil, append(cfgOpts, opts...)...)
+}
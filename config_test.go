@@ -0,0 +1,138 @@
+package edgecloud
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigFile = `
+profile: production
+profiles:
+  production:
+    apiURL: https://api.edgecenter.ru/cloud
+    apiToken: production-key
+    projectID: 12345
+    regionID: 10
+    retryMax: 3
+    retryWaitMin: 0.5
+  staging:
+    accessToken: access
+    refreshToken: refresh
+    projectID: 54321
+    regionID: 8
+`
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "cloud.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+// clearConfigEnv isolates the test from the configuration of the machine it runs on.
+func clearConfigEnv(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("HOME", t.TempDir())
+	for _, env := range []string{
+		EnvConfigFile, EnvProfile,
+		"EDGECENTER_CLOUD_API_URL", "EDGECENTER_CLOUD_API_TOKEN", "EDGECENTER_CLOUD_ACCESS_TOKEN",
+		"EDGECENTER_CLOUD_REFRESH_TOKEN", "EDGECENTER_CLOUD_AUTH_URL", "EDGECENTER_CLOUD_PROJECT_ID",
+		"EDGECENTER_CLOUD_REGION_ID", "EDGECENTER_CLOUD_RETRY_MAX", "EDGECENTER_CLOUD_RETRY_WAIT_MIN",
+		"EDGECENTER_CLOUD_RETRY_WAIT_MAX",
+	} {
+		t.Setenv(env, "")
+		require.NoError(t, os.Unsetenv(env))
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeConfigFile(t, testConfigFile)
+
+	cfg, err := LoadConfig(path, "")
+	require.NoError(t, err)
+	assert.Equal(t, CloudConfig{
+		APIUrl:       "https://api.edgecenter.ru/cloud",
+		APIToken:     "production-key",
+		ProjectID:    12345,
+		RegionID:     10,
+		RetryMax:     3,
+		RetryWaitMin: 0.5,
+	}, *cfg)
+
+	cfg, err = LoadConfig(path, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, CloudConfig{AccessToken: "access", RefreshToken: "refresh", ProjectID: 54321, RegionID: 8}, *cfg)
+
+	_, err = LoadConfig(path, "unknown")
+	assert.ErrorIs(t, err, ErrProfileNotFound)
+
+	_, err = LoadConfig(filepath.Join(t.TempDir(), "missing.yaml"), "")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadConfig_Environment(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv(EnvConfigFile, writeConfigFile(t, testConfigFile))
+	t.Setenv(EnvProfile, "staging")
+	t.Setenv("EDGECENTER_CLOUD_REGION_ID", "22")
+
+	cfg, err := LoadConfig("", "")
+	require.NoError(t, err)
+	assert.Equal(t, "access", cfg.AccessToken)
+	assert.Equal(t, 54321, cfg.ProjectID)
+	assert.Equal(t, 22, cfg.RegionID)
+
+	cfg, err = LoadConfig("", "production")
+	require.NoError(t, err)
+	assert.Equal(t, "production-key", cfg.APIToken)
+	assert.Equal(t, 22, cfg.RegionID)
+}
+
+func TestLoadConfig_EnvironmentOnly(t *testing.T) {
+	clearConfigEnv(t)
+	t.Setenv("EDGECENTER_CLOUD_API_TOKEN", "env-key")
+	t.Setenv("EDGECENTER_CLOUD_PROJECT_ID", "1")
+	t.Setenv("EDGECENTER_CLOUD_REGION_ID", "2")
+
+	cfg, err := LoadConfig("", "")
+	require.NoError(t, err)
+	assert.Equal(t, CloudConfig{APIToken: "env-key", ProjectID: 1, RegionID: 2}, *cfg)
+
+	_, err = LoadConfig("", "staging")
+	assert.ErrorIs(t, err, ErrProfileNotFound)
+}
+
+func TestNewFromConfig(t *testing.T) {
+	clearConfigEnv(t)
+
+	cfg, err := LoadConfig(writeConfigFile(t, testConfigFile), "")
+	require.NoError(t, err)
+
+	c, err := NewFromConfig(cfg, SetRegion(20))
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.edgecenter.ru/cloud", c.BaseURL.String())
+	assert.Equal(t, 12345, c.Project)
+	assert.Equal(t, 20, c.Region)
+	assert.Equal(t, 3, c.RetryConfig.RetryMax)
+	assert.Equal(t, PtrTo(0.5), c.RetryConfig.RetryWaitMin)
+	assert.Nil(t, c.RetryConfig.RetryWaitMax)
+
+	req, err := c.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+	token, err := c.authorize(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "APIKey production-key", token.authorization())
+	assert.Equal(t, "APIKey production-key", req.Header.Get("Authorization"))
+
+	_, err = NewFromConfig(&CloudConfig{ProjectID: 1, RegionID: 2})
+	assert.Error(t, err)
+}
@@ -65,16 +65,42 @@ type DBaaSServiceOp struct {
 
 var _ DBaaSService = &DBaaSServiceOp{}
 
+// DBaaSClusterStatus is the status of a DBaaSCluster.
+type DBaaSClusterStatus string
+
+const (
+	DBaaSClusterStatusProvisioning DBaaSClusterStatus = "PROVISIONING"
+	DBaaSClusterStatusUpdating     DBaaSClusterStatus = "UPDATING"
+	DBaaSClusterStatusDeleting     DBaaSClusterStatus = "DELETING"
+	DBaaSClusterStatusHealthy      DBaaSClusterStatus = "HEALTHY"
+	DBaaSClusterStatusError        DBaaSClusterStatus = "ERROR"
+)
+
+// IsError reports whether the cluster is in error.
+func (s DBaaSClusterStatus) IsError() bool {
+	return s == DBaaSClusterStatusError
+}
+
+// IsTransitional reports whether the cluster is changing, e.g. being provisioned or deleted.
+func (s DBaaSClusterStatus) IsTransitional() bool {
+	return s == DBaaSClusterStatusProvisioning || s == DBaaSClusterStatusUpdating || s == DBaaSClusterStatusDeleting
+}
+
+// IsTerminal reports whether the cluster keeps the status until it is acted on, including in error.
+func (s DBaaSClusterStatus) IsTerminal() bool {
+	return s == DBaaSClusterStatusHealthy || s == DBaaSClusterStatusError
+}
+
 type DBaaSCluster struct {
-	ID          string         `json:"id"`
-	ProjectID   int            `json:"project_id"`
-	RegionID    int            `json:"region_id"`
-	Name        string         `json:"name"`
-	Description string         `json:"description"`
-	Status      string         `json:"status"`
-	DBMS        *DBaaSDbmsType `json:"dbms"`
-	CreatedAt   string         `json:"created_at"`
-	UpdatedAt   string         `json:"updated_at"`
+	ID          string             `json:"id"`
+	ProjectID   int                `json:"project_id"`
+	RegionID    int                `json:"region_id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Status      DBaaSClusterStatus `json:"status"`
+	DBMS        *DBaaSDbmsType     `json:"dbms"`
+	CreatedAt   Timestamp          `json:"created_at"`
+	UpdatedAt   Timestamp          `json:"updated_at"`
 
 	// Get-specific (отсутствуют в List)
 	TaskID           string                 `json:"task_id,omitempty"`
@@ -226,9 +252,9 @@ type DBaaSBackup struct {
 	IsService     bool           `json:"is_service"`
 	HasChild      bool           `json:"has_child,omitempty"`
 	DBMS          *DBaaSDbmsType `json:"dbms"`
-	CreatedAt     string         `json:"created_at"`
-	UpdatedAt     string         `json:"updated_at"`
-	FinishedAt    string         `json:"finished_at"`
+	CreatedAt     Timestamp      `json:"created_at"`
+	UpdatedAt     Timestamp      `json:"updated_at"`
+	FinishedAt    Timestamp      `json:"finished_at"`
 	TaskID        string         `json:"task_id"`
 	CreatorTaskID string         `json:"creator_task_id"`
 }
@@ -264,11 +290,13 @@ type DBaaSBackupsPage struct {
 }
 
 func (s *DBaaSServiceOp) ClusterCreate(ctx context.Context, reqBody DBaaSClusterCreateRequest) (*TaskResponse, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.ClusterCreate")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(DBaaSClustersBasePathV3)
+	path := s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -285,11 +313,13 @@ func (s *DBaaSServiceOp) ClusterCreate(ctx context.Context, reqBody DBaaSCluster
 }
 
 func (s *DBaaSServiceOp) ClustersList(ctx context.Context, opts *DBaaSClusterListOptions) ([]DBaaSCluster, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.ClustersList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(DBaaSClustersBasePathV3)
+	path := s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -306,15 +336,19 @@ func (s *DBaaSServiceOp) ClustersList(ctx context.Context, opts *DBaaSClusterLis
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Clusters, resp, err
 }
 
 func (s *DBaaSServiceOp) ClusterGet(ctx context.Context, clusterID string) (*DBaaSCluster, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.ClusterGet")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -331,11 +365,13 @@ func (s *DBaaSServiceOp) ClusterGet(ctx context.Context, clusterID string) (*DBa
 }
 
 func (s *DBaaSServiceOp) ClusterDelete(ctx context.Context, clusterID string) (*TaskResponse, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.ClusterDelete")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -352,11 +388,13 @@ func (s *DBaaSServiceOp) ClusterDelete(ctx context.Context, clusterID string) (*
 }
 
 func (s *DBaaSServiceOp) ClusterUpdate(ctx context.Context, clusterID string, reqBody DBaaSClusterUpdateRequest) (*TaskResponse, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.ClusterUpdate")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -373,11 +411,13 @@ func (s *DBaaSServiceOp) ClusterUpdate(ctx context.Context, clusterID string, re
 }
 
 func (s *DBaaSServiceOp) ClusterUpdateAccessControl(ctx context.Context, clusterID string, reqBody DBaaSClusterAccessControlUpdateRequest) (*TaskResponse, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.ClusterUpdateAccessControl")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID, dbaasAccessControlPath)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID, dbaasAccessControlPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -394,11 +434,13 @@ func (s *DBaaSServiceOp) ClusterUpdateAccessControl(ctx context.Context, cluster
 }
 
 func (s *DBaaSServiceOp) UsersList(ctx context.Context, clusterID string, opts *DBaaSUserListOptions) ([]DBaaSUser, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.UsersList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/users", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID)
+	path := fmt.Sprintf("%s/%s/users", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -415,15 +457,19 @@ func (s *DBaaSServiceOp) UsersList(ctx context.Context, clusterID string, opts *
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Users, resp, err
 }
 
 func (s *DBaaSServiceOp) UserCreate(ctx context.Context, clusterID string, reqBody DBaaSUserCreateRequest) (*DBaaSUser, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.UserCreate")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/users", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID)
+	path := fmt.Sprintf("%s/%s/users", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -440,11 +486,13 @@ func (s *DBaaSServiceOp) UserCreate(ctx context.Context, clusterID string, reqBo
 }
 
 func (s *DBaaSServiceOp) UserGet(ctx context.Context, clusterID, username string) (*DBaaSUser, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.UserGet")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/users/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID, username)
+	path := fmt.Sprintf("%s/%s/users/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID, username)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -461,11 +509,13 @@ func (s *DBaaSServiceOp) UserGet(ctx context.Context, clusterID, username string
 }
 
 func (s *DBaaSServiceOp) UserUpdate(ctx context.Context, clusterID, username string, reqBody DBaaSUserUpdateRequest) (*Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.UserUpdate")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/users/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID, username)
+	path := fmt.Sprintf("%s/%s/users/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID, username)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -481,11 +531,13 @@ func (s *DBaaSServiceOp) UserUpdate(ctx context.Context, clusterID, username str
 }
 
 func (s *DBaaSServiceOp) UserDelete(ctx context.Context, clusterID, username string) (*Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.UserDelete")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/users/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID, username)
+	path := fmt.Sprintf("%s/%s/users/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID, username)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -501,11 +553,13 @@ func (s *DBaaSServiceOp) UserDelete(ctx context.Context, clusterID, username str
 }
 
 func (s *DBaaSServiceOp) UserGrantAccess(ctx context.Context, clusterID, username, database string) (*Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.UserGrantAccess")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/users/%s/databases/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID, username, database)
+	path := fmt.Sprintf("%s/%s/users/%s/databases/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID, username, database)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, nil)
 	if err != nil {
@@ -521,11 +575,13 @@ func (s *DBaaSServiceOp) UserGrantAccess(ctx context.Context, clusterID, usernam
 }
 
 func (s *DBaaSServiceOp) UserRevokeAccess(ctx context.Context, clusterID, username, database string) (*Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.UserRevokeAccess")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/users/%s/databases/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID, username, database)
+	path := fmt.Sprintf("%s/%s/users/%s/databases/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID, username, database)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -541,11 +597,13 @@ func (s *DBaaSServiceOp) UserRevokeAccess(ctx context.Context, clusterID, userna
 }
 
 func (s *DBaaSServiceOp) DatabasesList(ctx context.Context, clusterID string, opts *DBaaSDatabaseListOptions) ([]DBaaSDatabase, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.DatabasesList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/databases", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID)
+	path := fmt.Sprintf("%s/%s/databases", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -562,15 +620,19 @@ func (s *DBaaSServiceOp) DatabasesList(ctx context.Context, clusterID string, op
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Databases, resp, err
 }
 
 func (s *DBaaSServiceOp) DatabaseCreate(ctx context.Context, clusterID string, reqBody DBaaSDatabaseCreateRequest) (*DBaaSDatabase, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.DatabaseCreate")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/databases", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID)
+	path := fmt.Sprintf("%s/%s/databases", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -587,11 +649,13 @@ func (s *DBaaSServiceOp) DatabaseCreate(ctx context.Context, clusterID string, r
 }
 
 func (s *DBaaSServiceOp) DatabaseDelete(ctx context.Context, clusterID, databaseName string) (*DBaaSDatabase, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.DatabaseDelete")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/databases/%s", s.client.addProjectRegionPath(DBaaSClustersBasePathV3), clusterID, databaseName)
+	path := fmt.Sprintf("%s/%s/databases/%s", s.client.addProjectRegionPath(ctx, DBaaSClustersBasePathV3), clusterID, databaseName)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -608,11 +672,13 @@ func (s *DBaaSServiceOp) DatabaseDelete(ctx context.Context, clusterID, database
 }
 
 func (s *DBaaSServiceOp) DbmsList(ctx context.Context, opts *DBaaSDbmsListOptions) ([]DBaaSDbms, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.DbmsList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(DBaaSDbmsBasePathV3)
+	path := s.client.addProjectRegionPath(ctx, DBaaSDbmsBasePathV3)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -629,15 +695,19 @@ func (s *DBaaSServiceOp) DbmsList(ctx context.Context, opts *DBaaSDbmsListOption
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Dbms, resp, err
 }
 
 func (s *DBaaSServiceOp) BackupCreate(ctx context.Context, reqBody DBaaSBackupCreateRequest) (*TaskResponse, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.BackupCreate")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(DBaaSBackupsBasePathV3)
+	path := s.client.addProjectRegionPath(ctx, DBaaSBackupsBasePathV3)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -654,6 +724,8 @@ func (s *DBaaSServiceOp) BackupCreate(ctx context.Context, reqBody DBaaSBackupCr
 }
 
 func (s *DBaaSServiceOp) BackupsList(ctx context.Context, opts *DBaaSBackupListOptions) ([]DBaaSBackup, *Response, error) {
+	ctx = withOperation(ctx, "DBaaS.BackupsList")
+
 	page, resp, err := s.BackupsListPage(ctx, opts)
 	if err != nil {
 		return nil, resp, err
@@ -663,11 +735,13 @@ func (s *DBaaSServiceOp) BackupsList(ctx context.Context, opts *DBaaSBackupListO
 }
 
 func (s *DBaaSServiceOp) BackupsListPage(ctx context.Context, opts *DBaaSBackupListOptions) (*DBaaSBackupsPage, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.BackupsListPage")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(DBaaSBackupsBasePathV3)
+	path := s.client.addProjectRegionPath(ctx, DBaaSBackupsBasePathV3)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -684,15 +758,19 @@ func (s *DBaaSServiceOp) BackupsListPage(ctx context.Context, opts *DBaaSBackupL
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: page.Count}
+
 	return page, resp, nil
 }
 
 func (s *DBaaSServiceOp) BackupGet(ctx context.Context, backupID string, includePrices bool) (*DBaaSBackup, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.BackupGet")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(DBaaSBackupsBasePathV3), backupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, DBaaSBackupsBasePathV3), backupID)
 	if includePrices {
 		path += "?include_prices=true"
 	}
@@ -712,11 +790,13 @@ func (s *DBaaSServiceOp) BackupGet(ctx context.Context, backupID string, include
 }
 
 func (s *DBaaSServiceOp) BackupUpdate(ctx context.Context, backupID string, reqBody DBaaSBackupUpdateRequest) (*DBaaSBackup, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.BackupUpdate")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(DBaaSBackupsBasePathV3), backupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, DBaaSBackupsBasePathV3), backupID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -733,11 +813,13 @@ func (s *DBaaSServiceOp) BackupUpdate(ctx context.Context, backupID string, reqB
 }
 
 func (s *DBaaSServiceOp) BackupDelete(ctx context.Context, backupID string) (*TaskResponse, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "DBaaS.BackupDelete")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(DBaaSBackupsBasePathV3), backupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, DBaaSBackupsBasePathV3), backupID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -671,3 +671,13 @@ func TestDBaaSServiceOp_BackupDelete(t *testing.T) {
 	require.Equal(t, resp.StatusCode, 200)
 	require.Equal(t, respActual, expectedResp)
 }
+
+func TestDBaaSClusterStatus(t *testing.T) {
+	assert.True(t, DBaaSClusterStatusProvisioning.IsTransitional())
+	assert.False(t, DBaaSClusterStatusProvisioning.IsTerminal())
+	assert.True(t, DBaaSClusterStatusHealthy.IsTerminal())
+	assert.True(t, DBaaSClusterStatusError.IsError())
+	assert.True(t, DBaaSClusterStatusError.IsTerminal())
+	assert.False(t, DBaaSClusterStatusError.IsTransitional())
+	assert.False(t, DBaaSClusterStatus("unexpected").IsTerminal())
+}
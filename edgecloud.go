@@ -25,6 +25,7 @@ const (
 	mediaType      = "application/json"
 
 	internalHeaderRetryAttempts = "X-Edgecloud-Retry-Attempts"
+	headerRequestID             = "X-Request-Id"
 
 	defaultRetryMax     = 3
 	defaultRetryWaitMax = 30
@@ -85,78 +86,117 @@ type Client struct {
 	// Optional function called after every successful request made to the DO APIs
 	onRequestCompleted RequestCompletionCallback
 
+	// Optional middlewares called around every request made with Do
+	middlewares []Middleware
+
 	// Optional extra HTTP headers to set on every request to the API.
 	headers map[string]string
 
 	// Optional retry values. Setting the RetryConfig.RetryMax value enables automatically retrying requests
 	// that fail with 429 or 500-level response codes
 	RetryConfig RetryConfig
+
+	// Optional client-side rate limits, shared by all scoped copies of the client
+	rateLimiter *rateLimiter
+
+	// Optional OpenTelemetry instrumentation of the API calls
+	telemetry *telemetry
+
+	// Optional cache of the slow-changing catalogs, shared by all scoped copies of the client
+	cache *cache
+
+	// Optional source of the tokens set in the Authorization header, taking precedence over APIKey
+	tokenSource TokenSource
 }
 
 // RetryConfig sets the values used for enabling retries and backoffs for
 // requests that fail with 429 or 500-level response codes using the go-retryablehttp client.
 // RetryConfig.RetryMax must be configured to enable this behavior. RetryConfig.RetryWaitMin and
 // RetryConfig.RetryWaitMax are optional, with the default values being 1.0 and 30.0, respectively.
+// If the API sends the Retry-After header or reports an exhausted rate limit, the retry waits as requested instead.
 //
-// Note: Opting to use the go-retryablehttp client will overwrite any custom HTTP client passed into New().
+// Note: Opting to use the go-retryablehttp client will overwrite any custom HTTP client passed into New(),
+// keeping only its timeout and transport.
 type RetryConfig struct {
 	RetryMax     int
 	RetryWaitMin *float64    // Minimum time to wait
 	RetryWaitMax *float64    // Maximum time to wait
-	Logger       interface{} // Customer logger instance. Must implement either go-retryablehttp.Logger or go-retryablehttp.LeveledLogger
+	Logger       interface{} // Customer logger instance. Must implement either go-retryablehttp.Logger or go-retryablehttp.LeveledLogger, e.g. *slog.Logger
 }
 
-// CloudConfig used only for import.
+// CloudConfig is a profile of the client configuration, loaded with LoadConfig from a profiles file
+// and the environment variables named in the env tags.
 type CloudConfig struct {
-	APIUrl       string `yaml:"apiURL"`
-	APIToken     string `yaml:"apiToken"`
-	AccessToken  string `yaml:"accessToken"`
-	RefreshToken string `yaml:"refreshToken"`
-	ProjectID    int    `yaml:"projectID"`
-	RegionID     int    `yaml:"regionID"`
+	APIUrl       string  `yaml:"apiURL" env:"EDGECENTER_CLOUD_API_URL"`
+	APIToken     string  `yaml:"apiToken" env:"EDGECENTER_CLOUD_API_TOKEN"`
+	AccessToken  string  `yaml:"accessToken" env:"EDGECENTER_CLOUD_ACCESS_TOKEN"`
+	RefreshToken string  `yaml:"refreshToken" env:"EDGECENTER_CLOUD_REFRESH_TOKEN"`
+	AuthURL      string  `yaml:"authURL" env:"EDGECENTER_CLOUD_AUTH_URL"`
+	ProjectID    int     `yaml:"projectID" env:"EDGECENTER_CLOUD_PROJECT_ID"`
+	RegionID     int     `yaml:"regionID" env:"EDGECENTER_CLOUD_REGION_ID"`
+	RetryMax     int     `yaml:"retryMax" env:"EDGECENTER_CLOUD_RETRY_MAX"`
+	RetryWaitMin float64 `yaml:"retryWaitMin" env:"EDGECENTER_CLOUD_RETRY_WAIT_MIN"` // seconds
+	RetryWaitMax float64 `yaml:"retryWaitMax" env:"EDGECENTER_CLOUD_RETRY_WAIT_MAX"` // seconds
 }
 
 // RequestCompletionCallback defines the type of the request callback function.
 type RequestCompletionCallback func(*http.Request, *http.Response)
 
-func (c *Client) addProjectRegionPath(s string) string {
-	projectStr := strconv.Itoa(c.Project)
-	regionStr := strconv.Itoa(c.Region)
+func (c *Client) addProjectRegionPath(ctx context.Context, s string) string {
+	project, region := c.scope(ctx)
+	projectStr := strconv.Itoa(project)
+	regionStr := strconv.Itoa(region)
 
 	return path.Join(s, projectStr, regionStr)
 }
 
-func (c *Client) addRegionPath(s string) string {
-	regionStr := strconv.Itoa(c.Region)
+func (c *Client) addRegionPath(ctx context.Context, s string) string {
+	_, region := c.scope(ctx)
+	regionStr := strconv.Itoa(region)
 
 	return path.Join(s, regionStr)
 }
 
+// Validate checks that the project and region of the client are set.
 func (c *Client) Validate() (*Response, error) {
+	return c.ValidateContext(context.Background())
+}
+
+// ValidateContext checks that the project and region of the request are set, either on the client
+// or in the context with ContextWithScope.
+func (c *Client) ValidateContext(ctx context.Context) (*Response, error) {
 	badResponse := &Response{
 		Response: &http.Response{
 			Status:     http.StatusText(http.StatusBadRequest),
 			StatusCode: http.StatusBadRequest,
 		},
 	}
-	if c.Project == 0 {
+	project, region := c.scope(ctx)
+	if project == 0 {
 		return badResponse, NewArgError("Client.Project", "is not set")
 	}
-	if c.Region == 0 {
+	if region == 0 {
 		return badResponse, NewArgError("Client.Region", "is not set")
 	}
 
 	return nil, nil //nolint:all
 }
 
+// ValidateRegion checks that the region of the client is set.
 func (c *Client) ValidateRegion() (*Response, error) {
+	return c.ValidateRegionContext(context.Background())
+}
+
+// ValidateRegionContext checks that the region of the request is set, either on the client
+// or in the context with ContextWithScope.
+func (c *Client) ValidateRegionContext(ctx context.Context) (*Response, error) {
 	badResponse := &Response{
 		Response: &http.Response{
 			Status:     http.StatusText(http.StatusBadRequest),
 			StatusCode: http.StatusBadRequest,
 		},
 	}
-	if c.Region == 0 {
+	if _, region := c.scope(ctx); region == 0 {
 		return badResponse, NewArgError("Client.Region", "is not set")
 	}
 
@@ -166,6 +206,19 @@ func (c *Client) ValidateRegion() (*Response, error) {
 // Response is a EdgecenterCloud response. This wraps the standard http.Response returned from EdgecenterCloud.
 type Response struct {
 	*http.Response
+
+	// Meta describes the paged list the response belongs to. It is set only by List methods that support
+	// limit and offset.
+	Meta *Meta
+
+	// Rate is the rate limit state reported by the API, if any.
+	Rate Rate
+}
+
+// Meta describes generic information about a paged list response.
+type Meta struct {
+	// Count is the total number of resources matching the request, regardless of its limit and offset.
+	Count int
 }
 
 // An ResponseError reports the error caused by an API request.
@@ -173,9 +226,21 @@ type ResponseError struct {
 	// HTTP response that caused this error
 	Response *http.Response
 
+	// HTTP status code of the response
+	StatusCode int
+
 	// Error message
 	Message string `json:"message"`
 
+	// Code is the platform error code, e.g. the exception class of the error
+	Code string `json:"code"`
+
+	// RequestID is the platform request ID, taken from the response body or from its headers
+	RequestID string `json:"request_id"`
+
+	// FieldErrors are the field-level validation errors of the request, if any
+	FieldErrors []FieldError `json:"errors"`
+
 	// Attempts is the number of times the request was attempted when retries are enabled.
 	Attempts int
 }
@@ -231,7 +296,15 @@ func NewClient(httpClient *http.Client) *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	c := &Client{HTTPClient: httpClient, BaseURL: baseURL, UserAgent: userAgent}
+	c.initServices()
+
+	c.headers = make(map[string]string)
 
+	return c
+}
+
+// initServices binds every service of the client to it.
+func (c *Client) initServices() {
 	c.Flavors = &FlavorsServiceOp{client: c}
 	c.Floatingips = &FloatingipsServiceOp{client: c}
 	c.Images = &ImagesServiceOp{client: c}
@@ -262,10 +335,6 @@ func NewClient(httpClient *http.Client) *Client {
 	c.AvailabilityZones = &AvailabilityZonesServiceOp{client: c}
 	c.MkaaS = &MKaaSServiceOp{client: c}
 	c.DBaaS = &DBaaSServiceOp{client: c}
-
-	c.headers = make(map[string]string)
-
-	return c
 }
 
 // ClientOpt are options for New.
@@ -295,9 +364,22 @@ func New(httpClient *http.Client, opts ...ClientOpt) (*Client, error) {
 		// By default, this is nil and does not log.
 		retryableClient.Logger = c.RetryConfig.Logger
 
+		// Honour the Retry-After and rate limit headers, and keep retries within the client-side rate limits.
+		retryableClient.Backoff = retryAfterBackoff
+		limiter := c.rateLimiter
+		retryableClient.PrepareRetry = func(req *http.Request) error {
+			countRetry(req.Context())
+			return limiter.wait(req.Context(), req)
+		}
+
 		// if timeout is set, it is maintained before overwriting client with StandardClient()
 		retryableClient.HTTPClient.Timeout = c.HTTPClient.Timeout
 
+		// a custom transport is maintained too, so that it still serves every attempt
+		if c.HTTPClient.Transport != nil {
+			retryableClient.HTTPClient.Transport = c.HTTPClient.Transport
+		}
+
 		// This custom ErrorHandler is required to provide errors that are consistent
 		// with a *edgecloud.ErrorResponse and a non-nil *edgecloud.Response while providing
 		// insight into retries using an internal header.
@@ -364,6 +446,15 @@ func SetProject(project int) ClientOpt {
 	}
 }
 
+// SetRequestCompletionCallback is a client option for setting the function called
+// after every request that got a response from the API.
+func SetRequestCompletionCallback(rc RequestCompletionCallback) ClientOpt {
+	return func(c *Client) error {
+		c.onRequestCompleted = rc
+		return nil
+	}
+}
+
 // SetUserAgent is a client option for setting the user agent.
 func SetUserAgent(ua string) ClientOpt {
 	return func(c *Client) error {
@@ -446,6 +537,7 @@ func (c *Client) NewRequest(_ context.Context, method, urlStr string, body inter
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := Response{Response: r}
+	response.populateRate()
 
 	return &response
 }
@@ -454,14 +546,44 @@ func newResponse(r *http.Response) *Response {
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	resp, err := DoRequestWithClient(ctx, c.HTTPClient, req)
+	ctx, finish := c.telemetry.start(ctx, c, req)
+
+	response, err := c.doWithMiddlewares(ctx, req, v)
+	finish(response, v, err)
+
+	return response, err
+}
+
+// doWithMiddlewares sends an API request, calling the middlewares of the client around it.
+func (c *Client) doWithMiddlewares(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	ctx, middlewares, err := c.beforeRequest(ctx, req)
+	if err != nil {
+		onError(ctx, middlewares, req, nil, err)
+
+		return nil, err
+	}
+
+	response, err := c.do(ctx, req, v)
 	if err != nil {
-		return &Response{
-			Response: &http.Response{
-				Status:     http.StatusText(http.StatusInternalServerError),
-				StatusCode: http.StatusInternalServerError,
-			},
-		}, err
+		onError(ctx, middlewares, req, response, err)
+
+		return response, err
+	}
+
+	afterResponse(ctx, middlewares, req, response, v)
+
+	return response, nil
+}
+
+// do sends an API request, without calling the middlewares of the client.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if resource, ok := c.cache.resource(req); ok && req.Method == http.MethodGet {
+		return c.doCached(ctx, resource, req, v)
+	}
+
+	resp, err := c.send(ctx, req)
+	if err != nil {
+		return internalErrorResponse(), err
 	}
 	if c.onRequestCompleted != nil {
 		c.onRequestCompleted(req, resp)
@@ -484,13 +606,20 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		}
 	}()
 
-	response := newResponse(resp)
-
 	err = CheckResponse(resp)
 	if err != nil {
-		return response, err
+		return newResponse(resp), err
 	}
 
+	response, err := decodeResponse(resp, v)
+	c.cache.onResponse(req, v)
+
+	return response, err
+}
+
+// decodeResponse decodes the body of the successful response into v.
+func decodeResponse(resp *http.Response, v interface{}) (*Response, error) {
+	var err error
 	if resp.StatusCode != http.StatusNoContent && v != nil {
 		if w, ok := v.(io.Writer); ok {
 			_, err = io.Copy(w, resp.Body)
@@ -498,16 +627,21 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 			err = json.NewDecoder(resp.Body).Decode(v)
 		}
 		if err != nil {
-			return &Response{
-				Response: &http.Response{
-					Status:     http.StatusText(http.StatusInternalServerError),
-					StatusCode: http.StatusInternalServerError,
-				},
-			}, err
+			return internalErrorResponse(), err
 		}
 	}
 
-	return response, err
+	return newResponse(resp), nil
+}
+
+// internalErrorResponse returns the Response of a request that failed on the client side.
+func internalErrorResponse() *Response {
+	return &Response{
+		Response: &http.Response{
+			Status:     http.StatusText(http.StatusInternalServerError),
+			StatusCode: http.StatusInternalServerError,
+		},
+	}
 }
 
 // DoRequestWithClient submits an HTTP request using the specified client.
@@ -523,8 +657,22 @@ func (r *ResponseError) Error() string {
 		attempted = fmt.Sprintf("; giving up after %d attempt(s)", r.Attempts)
 	}
 
-	return fmt.Sprintf("%v %v: %d %v%s",
-		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Message, attempted)
+	var fieldErrors string
+	if len(r.FieldErrors) > 0 {
+		details := make([]string, 0, len(r.FieldErrors))
+		for _, fieldErr := range r.FieldErrors {
+			details = append(details, fieldErr.String())
+		}
+		fieldErrors = fmt.Sprintf(" (%s)", strings.Join(details, "; "))
+	}
+
+	var requestID string
+	if r.RequestID != "" {
+		requestID = fmt.Sprintf("; request_id: %s", r.RequestID)
+	}
+
+	return fmt.Sprintf("%v %v: %d %v%s%s%s",
+		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Message, fieldErrors, requestID, attempted)
 }
 
 // CheckResponse checks the API response for errors, and returns them if present. A response is considered an
@@ -536,7 +684,7 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
-	errorResponse := &ResponseError{Response: r}
+	errorResponse := &ResponseError{Response: r, StatusCode: r.StatusCode}
 	data, err := io.ReadAll(r.Body)
 	if err == nil && len(data) > 0 {
 		err := json.Unmarshal(data, errorResponse)
@@ -545,6 +693,10 @@ func CheckResponse(r *http.Response) error {
 		}
 	}
 
+	if errorResponse.RequestID == "" {
+		errorResponse.RequestID = r.Header.Get(headerRequestID)
+	}
+
 	attempts, strconvErr := strconv.Atoi(r.Header.Get(internalHeaderRetryAttempts))
 	if strconvErr == nil {
 		errorResponse.Attempts = attempts
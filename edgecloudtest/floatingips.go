@@ -0,0 +1,108 @@
+package edgecloudtest
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	floatingIPStatusActive = "ACTIVE"
+	floatingIPStatusDown   = "DOWN"
+)
+
+func (s *Server) registerFloatingIPs(mux *http.ServeMux) {
+	const basePath = "/v1/floatingips/{project}/{region}"
+
+	s.handle(mux, "GET "+basePath, func(r *request) (interface{}, error) {
+		return listResponse(r, s.floatingIPs.list(r.scope, nil))
+	})
+
+	s.handle(mux, "GET "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		return s.floatingIP(r.scope, r.PathValue("id"))
+	})
+
+	s.handle(mux, "POST "+basePath, func(r *request) (interface{}, error) {
+		var body edgecloud.FloatingIPCreateRequest
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+
+		fip, err := s.createFloatingIP(r)
+		if err != nil {
+			return nil, err
+		}
+		fip.Metadata = metadataDetailed(body.Metadata)
+		if body.PortID != "" {
+			associateFloatingIP(fip, body.PortID, body.FixedIPAddress)
+		}
+
+		resp := s.newTask(r, "create_floatingip", map[string][]string{"floatingips": {fip.ID}}, nil, func() {
+			s.floatingIPs.remove(fip.ID)
+		})
+		fip.TaskID = resp.Tasks[0]
+		fip.CreatorTaskID = resp.Tasks[0]
+
+		return resp, nil
+	})
+
+	s.handle(mux, "DELETE "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		fip, err := s.floatingIP(r.scope, r.PathValue("id"))
+		if err != nil {
+			return nil, err
+		}
+
+		return s.newTask(r, "delete_floatingip", nil, func() {
+			s.floatingIPs.remove(fip.ID)
+		}, nil), nil
+	})
+}
+
+func (s *Server) floatingIP(sc scope, id string) (*edgecloud.FloatingIP, error) {
+	fip, ok := s.floatingIPs.get(sc, id)
+	if !ok {
+		return nil, notFound("floating IP", id)
+	}
+
+	return fip, nil
+}
+
+// createFloatingIP adds a floating IP that is not associated with any port.
+func (s *Server) createFloatingIP(r *request) (*edgecloud.FloatingIP, error) {
+	ip, err := s.allocateIP(floatingIPNetwork)
+	if err != nil {
+		return nil, err
+	}
+
+	fip := &edgecloud.FloatingIP{
+		ID:                uuid.NewString(),
+		CreatedAt:         now(),
+		Status:            floatingIPStatusDown,
+		FloatingIPAddress: ip.String(),
+		ProjectID:         r.scope.project,
+		RegionID:          r.scope.region,
+		Region:            RegionName,
+	}
+	s.floatingIPs.add(r.scope, fip.ID, fip)
+
+	return fip, nil
+}
+
+func associateFloatingIP(fip *edgecloud.FloatingIP, portID string, fixedIP net.IP) {
+	fip.Status = floatingIPStatusActive
+	fip.PortID = portID
+	fip.FixedIPAddress = fixedIP
+	fip.UpdatedAt = now()
+}
+
+func disassociateFloatingIP(fip *edgecloud.FloatingIP) {
+	fip.Status = floatingIPStatusDown
+	fip.PortID = ""
+	fip.FixedIPAddress = nil
+	fip.Instance = edgecloud.Instance{}
+	fip.Loadbalancer = edgecloud.Loadbalancer{}
+	fip.UpdatedAt = now()
+}
@@ -0,0 +1,352 @@
+package edgecloudtest
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	// externalNetworkName is the key of the addresses of the external interfaces.
+	externalNetworkName = "external"
+)
+
+func (s *Server) registerInstances(mux *http.ServeMux) {
+	s.handle(mux, "GET /v1/instances/{project}/{region}", func(r *request) (interface{}, error) {
+		name := r.URL.Query().Get("name")
+		return listResponse(r, s.instances.list(r.scope, func(instance *edgecloud.Instance) bool {
+			return name == "" || strings.Contains(instance.Name, name)
+		}))
+	})
+
+	s.handle(mux, "GET /v1/instances/{project}/{region}/{id}", func(r *request) (interface{}, error) {
+		return s.instance(r.scope, r.PathValue("id"))
+	})
+
+	s.handle(mux, "POST /v2/instances/{project}/{region}", s.createInstances)
+	s.handle(mux, "DELETE /v1/instances/{project}/{region}/{id}", s.deleteInstance)
+}
+
+func (s *Server) instance(sc scope, id string) (*edgecloud.Instance, error) {
+	instance, ok := s.instances.get(sc, id)
+	if !ok {
+		return nil, notFound("instance", id)
+	}
+
+	return instance, nil
+}
+
+func (s *Server) createInstances(r *request) (interface{}, error) {
+	var body edgecloud.InstanceCreateRequest
+	if err := r.decode(&body); err != nil {
+		return nil, err
+	}
+
+	names := body.Names
+	if len(names) == 0 {
+		names = body.NameTemplates
+	}
+	if len(names) == 0 {
+		return nil, badRequest("names or name_templates are required")
+	}
+	if body.Flavor == "" {
+		return nil, badRequest("flavor is required")
+	}
+	if len(body.Interfaces) == 0 || len(body.Volumes) == 0 {
+		return nil, badRequest("interfaces and volumes are required")
+	}
+
+	securityGroups, err := s.instanceSecurityGroups(r.scope, body.SecurityGroups)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkInstanceRequest(r.scope, &body, len(names)); err != nil {
+		return nil, err
+	}
+
+	resources := make(map[string][]string)
+	var instances []*edgecloud.Instance
+	var volumes []*edgecloud.Volume
+	var fips []*edgecloud.FloatingIP
+	for _, name := range names {
+		instance := &edgecloud.Instance{
+			ID:               uuid.NewString(),
+			Name:             name,
+			Addresses:        make(map[string][]edgecloud.InstanceAddress),
+			CreatedAt:        now(),
+			Flavor:           &edgecloud.Flavor{FlavorID: body.Flavor, FlavorName: body.Flavor},
+			KeypairName:      body.KeypairName,
+			Metadata:         body.Metadata,
+			MetadataDetailed: metadataDetailed(body.Metadata),
+			ProjectID:        r.scope.project,
+			Region:           RegionName,
+			RegionID:         r.scope.region,
+			SecurityGroups:   securityGroups,
+			Status:           edgecloud.InstanceStatusBuild,
+			VMState:          edgecloud.InstanceVMStateBuilding,
+			AvailabilityZone: body.AvailabilityZone,
+			Volumes:          []edgecloud.InstanceVolume{},
+		}
+		if instance.AvailabilityZone == "" {
+			instance.AvailabilityZone = "nova"
+		}
+
+		for _, iface := range body.Interfaces {
+			fip, err := s.addInterface(r, instance, iface)
+			if err != nil {
+				return nil, err
+			}
+			if fip != nil {
+				fips = append(fips, fip)
+				resources["floatingips"] = append(resources["floatingips"], fip.ID)
+			}
+		}
+
+		for _, v := range body.Volumes {
+			volume, err := s.instanceVolume(r, instance, v)
+			if err != nil {
+				return nil, err
+			}
+			attachVolume(volume, instance)
+			if v.Source != edgecloud.VolumeSourceExistingVolume {
+				volumes = append(volumes, volume)
+				resources["volumes"] = append(resources["volumes"], volume.ID)
+			}
+		}
+
+		s.instances.add(r.scope, instance.ID, instance)
+		instances = append(instances, instance)
+		resources["instances"] = append(resources["instances"], instance.ID)
+	}
+
+	resp := s.newTask(r, "create_vm", resources, func() {
+		for _, instance := range instances {
+			instance.Status = edgecloud.InstanceStatusActive
+			instance.VMState = edgecloud.InstanceVMStateActive
+		}
+	}, func() {
+		for _, instance := range instances {
+			instance.Status = edgecloud.InstanceStatusError
+			instance.VMState = edgecloud.InstanceVMStateError
+		}
+		for _, volume := range volumes {
+			volume.Status = edgecloud.VolumeStatusError
+		}
+		for _, fip := range fips {
+			s.floatingIPs.remove(fip.ID)
+		}
+	})
+	for _, instance := range instances {
+		instance.TaskID = resp.Tasks[0]
+		instance.CreatorTaskID = resp.Tasks[0]
+	}
+	for _, volume := range volumes {
+		volume.CreatorTaskID = resp.Tasks[0]
+	}
+
+	return resp, nil
+}
+
+// checkInstanceRequest checks the interfaces and volumes of the request before anything is created.
+func (s *Server) checkInstanceRequest(sc scope, body *edgecloud.InstanceCreateRequest, count int) error {
+	for _, iface := range body.Interfaces {
+		switch iface.Type {
+		case edgecloud.InterfaceTypeExternal:
+		case edgecloud.InterfaceTypeSubnet:
+			subnet, err := s.subnet(sc, iface.SubnetID)
+			if err != nil {
+				return badRequest("subnet %s not found", iface.SubnetID)
+			}
+			if iface.NetworkID != "" && subnet.NetworkID != iface.NetworkID {
+				return badRequest("subnet %s does not belong to network %s", subnet.ID, iface.NetworkID)
+			}
+		case edgecloud.InterfaceTypeAnySubnet:
+			network, err := s.network(sc, iface.NetworkID)
+			if err != nil {
+				return badRequest("network %s not found", iface.NetworkID)
+			}
+			if len(network.Subnets) == 0 {
+				return badRequest("network %s has no subnets", network.ID)
+			}
+		default:
+			return badRequest("interface type %q is not supported by the fake API", iface.Type)
+		}
+
+		if iface.FloatingIP != nil && iface.FloatingIP.Source == edgecloud.ExistingFloatingIP {
+			if _, err := s.floatingIP(sc, iface.FloatingIP.ExistingFloatingID); err != nil {
+				return badRequest("floating IP %s not found", iface.FloatingIP.ExistingFloatingID)
+			}
+		}
+	}
+
+	for _, v := range body.Volumes {
+		switch v.Source {
+		case edgecloud.VolumeSourceNewVolume, edgecloud.VolumeSourceImage:
+			if v.Size <= 0 {
+				return badRequest("size of the %s volume must be positive", v.Source)
+			}
+		case edgecloud.VolumeSourceExistingVolume:
+			volume, err := s.volume(sc, v.VolumeID)
+			if err != nil {
+				return badRequest("volume %s not found", v.VolumeID)
+			}
+			if volume.Status != edgecloud.VolumeStatusAvailable || count > 1 {
+				return conflict("volume %s is not available", volume.ID)
+			}
+		default:
+			return badRequest("volume source %q is not supported by the fake API", v.Source)
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) instanceSecurityGroups(sc scope, ids []edgecloud.ID) ([]edgecloud.Name, error) {
+	names := make([]edgecloud.Name, 0, len(ids))
+	for _, id := range ids {
+		sg, err := s.securityGroup(sc, id.ID)
+		if err != nil {
+			return nil, badRequest("security group %s not found", id.ID)
+		}
+		names = append(names, edgecloud.Name{Name: sg.Name})
+	}
+
+	return names, nil
+}
+
+// addInterface adds the addresses of the interface to the instance. It returns the new floating IP
+// of the interface, if any.
+func (s *Server) addInterface(r *request, instance *edgecloud.Instance, iface edgecloud.InstanceInterface) (*edgecloud.FloatingIP, error) {
+	address := edgecloud.InstanceAddress{Type: string(edgecloud.AddressTypeFixed)}
+	networkName := externalNetworkName
+
+	if iface.Type == edgecloud.InterfaceTypeExternal {
+		ip, err := s.allocateIP(externalNetwork)
+		if err != nil {
+			return nil, err
+		}
+		address.Address = ip
+	} else {
+		subnetID := iface.SubnetID
+		if iface.Type == edgecloud.InterfaceTypeAnySubnet {
+			network, _ := s.network(r.scope, iface.NetworkID)
+			subnetID = network.Subnets[0]
+		}
+		subnet, _ := s.subnet(r.scope, subnetID)
+		network, _ := s.network(r.scope, subnet.NetworkID)
+
+		ip, err := s.allocateSubnetIP(subnet)
+		if err != nil {
+			return nil, err
+		}
+		address.Address = ip
+		address.SubnetID = subnet.ID
+		address.SubnetName = subnet.Name
+		networkName = network.Name
+	}
+	instance.Addresses[networkName] = append(instance.Addresses[networkName], address)
+
+	if iface.FloatingIP == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	var fip *edgecloud.FloatingIP
+	var created bool
+	if iface.FloatingIP.Source == edgecloud.ExistingFloatingIP {
+		fip, _ = s.floatingIP(r.scope, iface.FloatingIP.ExistingFloatingID)
+	} else {
+		var err error
+		if fip, err = s.createFloatingIP(r); err != nil {
+			return nil, err
+		}
+		created = true
+	}
+	associateFloatingIP(fip, uuid.NewString(), address.Address)
+	fip.Instance = edgecloud.Instance{ID: instance.ID, Name: instance.Name}
+
+	instance.Addresses[networkName] = append(instance.Addresses[networkName], edgecloud.InstanceAddress{
+		Type:       string(edgecloud.AddressTypeFloating),
+		SubnetName: address.SubnetName,
+		SubnetID:   address.SubnetID,
+		Address:    net.ParseIP(fip.FloatingIPAddress),
+	})
+
+	if !created {
+		return nil, nil //nolint:nilnil
+	}
+
+	return fip, nil
+}
+
+// instanceVolume returns the volume of the instance described in the request, creating it if needed.
+func (s *Server) instanceVolume(r *request, instance *edgecloud.Instance, v edgecloud.InstanceVolumeCreate) (*edgecloud.Volume, error) {
+	if v.Source == edgecloud.VolumeSourceExistingVolume {
+		return s.volume(r.scope, v.VolumeID)
+	}
+
+	name := v.Name
+	if name == "" {
+		name = instance.Name + "-volume-" + uuid.NewString()[:8]
+	}
+
+	return s.createVolume(r, name, v.TypeName, v.Size, v.Source == edgecloud.VolumeSourceImage)
+}
+
+func (s *Server) deleteInstance(r *request) (interface{}, error) {
+	instance, err := s.instance(r.scope, r.PathValue("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.URL.Query()
+	deleteVolumes := splitIDs(query.Get("volumes"))
+	deleteFloatings := query.Get("delete_floatings") == "true"
+	deleteFIPs := splitIDs(query.Get("floatings"))
+
+	status, vmState := instance.Status, instance.VMState
+	instance.Status = edgecloud.InstanceStatusDeleting
+
+	return s.newTask(r, "delete_vm", nil, func() {
+		for _, v := range instance.Volumes {
+			volume, ok := s.volumes.get(r.scope, v.ID)
+			if !ok {
+				continue
+			}
+			if _, ok := deleteVolumes[v.ID]; ok {
+				s.volumes.remove(v.ID)
+			} else {
+				detachVolume(volume)
+			}
+		}
+
+		for _, fip := range s.floatingIPs.list(r.scope, func(fip *edgecloud.FloatingIP) bool {
+			return fip.Instance.ID == instance.ID
+		}) {
+			if _, ok := deleteFIPs[fip.ID]; ok || deleteFloatings {
+				s.floatingIPs.remove(fip.ID)
+			} else {
+				disassociateFloatingIP(fip)
+			}
+		}
+
+		s.instances.remove(instance.ID)
+	}, func() {
+		instance.Status, instance.VMState = status, vmState
+	}), nil
+}
+
+// splitIDs returns the set of the IDs of a comma-separated query parameter.
+func splitIDs(value string) map[string]struct{} {
+	ids := make(map[string]struct{})
+	for _, id := range strings.Split(value, ",") {
+		if id != "" {
+			ids[id] = struct{}{}
+		}
+	}
+
+	return ids
+}
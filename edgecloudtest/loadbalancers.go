@@ -0,0 +1,222 @@
+package edgecloudtest
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const defaultLoadbalancerFlavor = "lb1-1-2"
+
+func (s *Server) registerLoadbalancers(mux *http.ServeMux) {
+	const basePath = "/v1/loadbalancers/{project}/{region}"
+
+	s.handle(mux, "GET "+basePath, func(r *request) (interface{}, error) {
+		return listResponse(r, s.loadbalancers.list(r.scope, nil))
+	})
+
+	s.handle(mux, "GET "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		return s.loadbalancer(r.scope, r.PathValue("id"))
+	})
+
+	s.handle(mux, "POST "+basePath, s.createLoadbalancer)
+
+	s.handle(mux, "DELETE "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		lb, err := s.loadbalancer(r.scope, r.PathValue("id"))
+		if err != nil {
+			return nil, err
+		}
+
+		status := lb.ProvisioningStatus
+		lb.ProvisioningStatus = edgecloud.ProvisioningStatusPendingDelete
+
+		return s.newTask(r, "delete_loadbalancer", nil, func() {
+			for _, fip := range s.floatingIPs.list(r.scope, func(fip *edgecloud.FloatingIP) bool {
+				return fip.Loadbalancer.ID == lb.ID
+			}) {
+				disassociateFloatingIP(fip)
+			}
+			s.loadbalancers.remove(lb.ID)
+		}, func() {
+			lb.ProvisioningStatus = status
+		}), nil
+	})
+
+	s.handle(mux, "GET /v1/lblisteners/{project}/{region}", func(r *request) (interface{}, error) {
+		lbID := r.URL.Query().Get("loadbalancer_id")
+		var listeners []*edgecloud.Listener
+		for _, lb := range s.loadbalancers.list(r.scope, nil) {
+			if lbID == "" || lb.ID == lbID {
+				for i := range lb.Listeners {
+					listeners = append(listeners, &lb.Listeners[i])
+				}
+			}
+		}
+
+		return listResponse(r, listeners)
+	})
+
+	s.handle(mux, "GET /v1/lblisteners/{project}/{region}/{id}", func(r *request) (interface{}, error) {
+		id := r.PathValue("id")
+		for _, lb := range s.loadbalancers.list(r.scope, nil) {
+			for _, listener := range lb.Listeners {
+				if listener.ID == id {
+					return listener, nil
+				}
+			}
+		}
+
+		return nil, notFound("listener", id)
+	})
+}
+
+func (s *Server) loadbalancer(sc scope, id string) (*edgecloud.Loadbalancer, error) {
+	lb, ok := s.loadbalancers.get(sc, id)
+	if !ok {
+		return nil, notFound("loadbalancer", id)
+	}
+
+	return lb, nil
+}
+
+func (s *Server) createLoadbalancer(r *request) (interface{}, error) {
+	var body edgecloud.LoadbalancerCreateRequest
+	if err := r.decode(&body); err != nil {
+		return nil, err
+	}
+	if body.Name == "" {
+		return nil, badRequest("name is required")
+	}
+
+	vipAddress, vipNetworkID, err := s.allocateVIP(r.scope, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	lb := &edgecloud.Loadbalancer{
+		ID:                 uuid.NewString(),
+		Name:               body.Name,
+		Flavor:             edgecloud.Flavor{FlavorID: body.Flavor, FlavorName: body.Flavor},
+		VipAddress:         vipAddress,
+		VipPortID:          body.VipPortID,
+		VipNetworkID:       vipNetworkID,
+		ProvisioningStatus: edgecloud.ProvisioningStatusPendingCreate,
+		OperatingStatus:    edgecloud.OperatingStatusOffline,
+		CreatedAt:          now(),
+		MetadataDetailed:   metadataDetailed(body.Metadata),
+		Listeners:          []edgecloud.Listener{},
+		FloatingIPs:        []edgecloud.FloatingIP{},
+		VrrpIPs:            []edgecloud.VrrpIP{},
+		ProjectID:          r.scope.project,
+		RegionID:           r.scope.region,
+		Region:             RegionName,
+	}
+	if lb.Flavor.FlavorID == "" {
+		lb.Flavor = edgecloud.Flavor{FlavorID: defaultLoadbalancerFlavor, FlavorName: defaultLoadbalancerFlavor}
+	}
+	if lb.VipPortID == "" {
+		lb.VipPortID = uuid.NewString()
+	}
+
+	resources := map[string][]string{"loadbalancers": {lb.ID}}
+	for _, l := range body.Listeners {
+		listener := edgecloud.Listener{
+			ID:                   uuid.NewString(),
+			LoadbalancerID:       lb.ID,
+			Name:                 l.Name,
+			Protocol:             l.Protocol,
+			ProtocolPort:         l.ProtocolPort,
+			PoolCount:            len(l.Pools),
+			OperatingStatus:      edgecloud.OperatingStatusOffline,
+			ProvisioningStatus:   edgecloud.ProvisioningStatusPendingCreate,
+			AllowedCIDRs:         l.AllowedCIDRs,
+			SNISecretID:          l.SNISecretID,
+			SecretID:             l.SecretID,
+			TimeoutClientData:    l.TimeoutClientData,
+			TimeoutMemberData:    l.TimeoutMemberData,
+			TimeoutMemberConnect: l.TimeoutMemberConnect,
+		}
+		lb.Listeners = append(lb.Listeners, listener)
+		resources["listeners"] = append(resources["listeners"], listener.ID)
+	}
+
+	var fip *edgecloud.FloatingIP
+	if body.FloatingIP != nil {
+		if body.FloatingIP.Source == edgecloud.ExistingFloatingIP {
+			if fip, err = s.floatingIP(r.scope, body.FloatingIP.ExistingFloatingID); err != nil {
+				return nil, badRequest("floating IP %s not found", body.FloatingIP.ExistingFloatingID)
+			}
+		} else {
+			if fip, err = s.createFloatingIP(r); err != nil {
+				return nil, err
+			}
+			resources["floatingips"] = []string{fip.ID}
+		}
+		associateFloatingIP(fip, lb.VipPortID, vipAddress)
+		fip.Loadbalancer = edgecloud.Loadbalancer{ID: lb.ID, Name: lb.Name}
+	}
+	s.loadbalancers.add(r.scope, lb.ID, lb)
+
+	resp := s.newTask(r, "create_loadbalancer", resources, func() {
+		lb.ProvisioningStatus = edgecloud.ProvisioningStatusActive
+		lb.OperatingStatus = edgecloud.OperatingStatusOnline
+		for i := range lb.Listeners {
+			lb.Listeners[i].ProvisioningStatus = edgecloud.ProvisioningStatusActive
+			lb.Listeners[i].OperatingStatus = edgecloud.OperatingStatusOnline
+		}
+		if fip != nil {
+			lb.FloatingIPs = append(lb.FloatingIPs, *fip)
+		}
+	}, func() {
+		lb.ProvisioningStatus = edgecloud.ProvisioningStatusError
+		lb.OperatingStatus = edgecloud.OperatingStatusError
+		if fip == nil {
+			return
+		}
+		if _, created := resources["floatingips"]; created {
+			s.floatingIPs.remove(fip.ID)
+		} else {
+			disassociateFloatingIP(fip)
+		}
+	})
+	lb.TaskID = resp.Tasks[0]
+	lb.CreatorTaskID = resp.Tasks[0]
+	for i := range lb.Listeners {
+		lb.Listeners[i].TaskID = resp.Tasks[0]
+		lb.Listeners[i].CreatorTaskID = resp.Tasks[0]
+	}
+
+	return resp, nil
+}
+
+// allocateVIP returns the virtual IP address of the load balancer and the ID of its network. The address is
+// allocated in the requested subnet, in the first subnet of the requested network or in the external network.
+func (s *Server) allocateVIP(sc scope, body *edgecloud.LoadbalancerCreateRequest) (net.IP, string, error) {
+	subnetID := body.VipSubnetID
+	if subnetID == "" && body.VipNetworkID != "" {
+		network, err := s.network(sc, body.VipNetworkID)
+		if err != nil {
+			return nil, "", badRequest("network %s not found", body.VipNetworkID)
+		}
+		if len(network.Subnets) == 0 {
+			return nil, "", badRequest("network %s has no subnets", network.ID)
+		}
+		subnetID = network.Subnets[0]
+	}
+
+	if subnetID == "" {
+		ip, err := s.allocateIP(externalNetwork)
+		return ip, "", err
+	}
+
+	subnet, err := s.subnet(sc, subnetID)
+	if err != nil {
+		return nil, "", badRequest("subnet %s not found", subnetID)
+	}
+	ip, err := s.allocateSubnetIP(subnet)
+
+	return ip, subnet.NetworkID, err
+}
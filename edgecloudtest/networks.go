@@ -0,0 +1,340 @@
+package edgecloudtest
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+
+	"github.com/google/uuid"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	defaultNetworkType = "vxlan"
+	defaultMTU         = 1450
+)
+
+var (
+	// externalNetwork is the network of the addresses of the external interfaces.
+	externalNetwork = mustParseCIDR("192.0.2.0/24")
+	// floatingIPNetwork is the network of the floating IPs.
+	floatingIPNetwork = mustParseCIDR("203.0.113.0/24")
+)
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+
+	return network
+}
+
+// hostIP returns the n-th address of the network.
+func hostIP(network *net.IPNet, n int) (net.IP, bool) {
+	ip := make(net.IP, len(network.IP))
+	copy(ip, network.IP)
+
+	// the offset is added to the last 4 bytes, which is enough for the networks of the tests
+	last := len(ip) - net.IPv4len
+	binary.BigEndian.PutUint32(ip[last:], binary.BigEndian.Uint32(ip[last:])+uint32(n))
+
+	return ip, network.Contains(ip)
+}
+
+// allocateIP returns the next free address of the network. The first address is the network address and
+// the second one is reserved for the gateway.
+func (s *Server) allocateIP(network *net.IPNet) (net.IP, error) {
+	key := network.String()
+	s.ips[key]++
+
+	ip, ok := hostIP(network, s.ips[key]+1)
+	if !ok {
+		return nil, conflict("no free IP addresses in %s", key)
+	}
+
+	return ip, nil
+}
+
+// totalIPs returns the number of the host addresses of the network.
+func totalIPs(network *net.IPNet) int {
+	ones, bits := network.Mask.Size()
+	if bits-ones >= 31 {
+		return math.MaxInt32
+	}
+
+	return max(1<<(bits-ones)-2, 0)
+}
+
+func (s *Server) registerNetworks(mux *http.ServeMux) {
+	const basePath = "/v1/networks/{project}/{region}"
+
+	s.handle(mux, "GET "+basePath, func(r *request) (interface{}, error) {
+		return listResponse(r, s.networks.list(r.scope, nil))
+	})
+
+	s.handle(mux, "GET "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		return s.network(r.scope, r.PathValue("id"))
+	})
+
+	s.handle(mux, "POST "+basePath, func(r *request) (interface{}, error) {
+		var body edgecloud.NetworkCreateRequest
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+		if body.Name == "" {
+			return nil, badRequest("name is required")
+		}
+
+		network := &edgecloud.Network{
+			ID:        uuid.NewString(),
+			Name:      body.Name,
+			CreatedAt: now(),
+			MTU:       defaultMTU,
+			Metadata:  metadataDetailed(body.Metadata),
+			ProjectID: r.scope.project,
+			Region:    RegionName,
+			RegionID:  r.scope.region,
+			Subnets:   []string{},
+			Type:      string(body.Type),
+		}
+		if network.Type == "" {
+			network.Type = defaultNetworkType
+		}
+		s.networks.add(r.scope, network.ID, network)
+
+		resp := s.newTask(r, "create_network", map[string][]string{"networks": {network.ID}}, nil, func() {
+			s.networks.remove(network.ID)
+		})
+		network.TaskID = resp.Tasks[0]
+		network.CreatorTaskID = resp.Tasks[0]
+
+		return resp, nil
+	})
+
+	s.handle(mux, "PATCH "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		network, err := s.network(r.scope, r.PathValue("id"))
+		if err != nil {
+			return nil, err
+		}
+
+		var body edgecloud.Name
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+		network.Name = body.Name
+		network.UpdatedAt = now()
+
+		return network, nil
+	})
+
+	s.handle(mux, "DELETE "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		network, err := s.network(r.scope, r.PathValue("id"))
+		if err != nil {
+			return nil, err
+		}
+		for _, subnetID := range network.Subnets {
+			if s.subnetInUse(subnetID) {
+				return nil, conflict("network %s has ports in use", network.ID)
+			}
+		}
+
+		return s.newTask(r, "delete_network", nil, func() {
+			for _, subnetID := range network.Subnets {
+				s.subnets.remove(subnetID)
+			}
+			s.networks.remove(network.ID)
+		}, nil), nil
+	})
+}
+
+func (s *Server) network(sc scope, id string) (*edgecloud.Network, error) {
+	network, ok := s.networks.get(sc, id)
+	if !ok {
+		return nil, notFound("network", id)
+	}
+
+	return network, nil
+}
+
+func (s *Server) registerSubnets(mux *http.ServeMux) {
+	const basePath = "/v1/subnets/{project}/{region}"
+
+	s.handle(mux, "GET "+basePath, func(r *request) (interface{}, error) {
+		networkID := r.URL.Query().Get("network_id")
+		return listResponse(r, s.subnets.list(r.scope, func(subnet *edgecloud.Subnetwork) bool {
+			return networkID == "" || subnet.NetworkID == networkID
+		}))
+	})
+
+	s.handle(mux, "GET "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		return s.subnet(r.scope, r.PathValue("id"))
+	})
+
+	s.handle(mux, "POST "+basePath, func(r *request) (interface{}, error) {
+		var body edgecloud.SubnetworkCreateRequest
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+		if body.Name == "" {
+			return nil, badRequest("name is required")
+		}
+		network, err := s.network(r.scope, body.NetworkID)
+		if err != nil {
+			return nil, badRequest("network %s not found", body.NetworkID)
+		}
+		ip, cidr, err := net.ParseCIDR(body.CIDR)
+		if err != nil {
+			return nil, badRequest("invalid cidr %s", body.CIDR)
+		}
+
+		subnet := &edgecloud.Subnetwork{
+			ID:                     uuid.NewString(),
+			Name:                   body.Name,
+			NetworkID:              network.ID,
+			IPVersion:              4,
+			EnableDHCP:             body.EnableDHCP,
+			ConnectToNetworkRouter: body.ConnectToNetworkRouter,
+			CIDR:                   cidr.String(),
+			CreatedAt:              now(),
+			TotalIps:               totalIPs(cidr),
+			AvailableIps:           totalIPs(cidr) - 1,
+			HasRouter:              body.ConnectToNetworkRouter,
+			DNSNameservers:         body.DNSNameservers,
+			HostRoutes:             body.HostRoutes,
+			Metadata:               metadataDetailed(body.Metadata),
+			Region:                 RegionName,
+			ProjectID:              r.scope.project,
+			RegionID:               r.scope.region,
+			AllocationPools:        body.AllocationPools,
+		}
+		if ip.To4() == nil {
+			subnet.IPVersion = 6
+		}
+		if body.GatewayIP != nil {
+			subnet.GatewayIP = *body.GatewayIP
+		} else {
+			subnet.GatewayIP, _ = hostIP(cidr, 1)
+		}
+		s.subnets.add(r.scope, subnet.ID, subnet)
+		network.Subnets = append(network.Subnets, subnet.ID)
+
+		resp := s.newTask(r, "create_subnet", map[string][]string{"subnets": {subnet.ID}}, nil, func() {
+			s.removeSubnet(subnet)
+		})
+		subnet.TaskID = resp.Tasks[0]
+		subnet.CreatorTaskID = resp.Tasks[0]
+
+		return resp, nil
+	})
+
+	s.handle(mux, "PATCH "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		subnet, err := s.subnet(r.scope, r.PathValue("id"))
+		if err != nil {
+			return nil, err
+		}
+
+		var body edgecloud.SubnetworkUpdateRequest
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+		if body.Name != "" {
+			subnet.Name = body.Name
+		}
+		subnet.DNSNameservers = body.DNSNameservers
+		subnet.EnableDHCP = body.EnableDHCP
+		subnet.HostRoutes = body.HostRoutes
+		if body.GatewayIP != nil {
+			subnet.GatewayIP = *body.GatewayIP
+		}
+		if body.AllocationPools != nil {
+			subnet.AllocationPools = body.AllocationPools
+		}
+		subnet.UpdatedAt = now()
+
+		return subnet, nil
+	})
+
+	s.handle(mux, "DELETE "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		subnet, err := s.subnet(r.scope, r.PathValue("id"))
+		if err != nil {
+			return nil, err
+		}
+		if s.subnetInUse(subnet.ID) {
+			return nil, conflict("subnet %s has ports in use", subnet.ID)
+		}
+
+		return s.newTask(r, "delete_subnet", nil, func() {
+			s.removeSubnet(subnet)
+		}, nil), nil
+	})
+}
+
+func (s *Server) subnet(sc scope, id string) (*edgecloud.Subnetwork, error) {
+	subnet, ok := s.subnets.get(sc, id)
+	if !ok {
+		return nil, notFound("subnet", id)
+	}
+
+	return subnet, nil
+}
+
+func (s *Server) removeSubnet(subnet *edgecloud.Subnetwork) {
+	s.subnets.remove(subnet.ID)
+	if network, ok := s.networks.get(scope{}, subnet.NetworkID); ok {
+		for i, id := range network.Subnets {
+			if id == subnet.ID {
+				network.Subnets = append(network.Subnets[:i:i], network.Subnets[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// subnetInUse reports whether an instance has an address in the subnet.
+func (s *Server) subnetInUse(subnetID string) bool {
+	for _, instance := range s.instances.list(scope{}, nil) {
+		for _, addresses := range instance.Addresses {
+			for _, address := range addresses {
+				if address.SubnetID == subnetID {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// allocateSubnetIP returns the next free address of the subnet.
+func (s *Server) allocateSubnetIP(subnet *edgecloud.Subnetwork) (net.IP, error) {
+	_, cidr, err := net.ParseCIDR(subnet.CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cidr of subnet %s: %w", subnet.ID, err)
+	}
+
+	ip, err := s.allocateIP(cidr)
+	if err != nil {
+		return nil, err
+	}
+	subnet.AvailableIps--
+
+	return ip, nil
+}
+
+// metadataDetailed returns the detailed metadata of the resources with the metadata of the request, sorted by key.
+func metadataDetailed(metadata edgecloud.Metadata) []edgecloud.MetadataDetailed {
+	detailed := make([]edgecloud.MetadataDetailed, 0, len(metadata))
+	for key, value := range metadata {
+		detailed = append(detailed, edgecloud.MetadataDetailed{Key: key, Value: value})
+	}
+	sort.Slice(detailed, func(i, j int) bool {
+		return detailed[i].Key < detailed[j].Key
+	})
+
+	return detailed
+}
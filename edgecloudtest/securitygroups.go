@@ -0,0 +1,183 @@
+package edgecloudtest
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func (s *Server) registerSecurityGroups(mux *http.ServeMux) {
+	const basePath = "/v1/securitygroups/{project}/{region}"
+
+	s.handle(mux, "GET "+basePath, func(r *request) (interface{}, error) {
+		return listResponse(r, s.securityGroups.list(r.scope, nil))
+	})
+
+	s.handle(mux, "GET "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		return s.securityGroup(r.scope, r.PathValue("id"))
+	})
+
+	s.handle(mux, "POST "+basePath, func(r *request) (interface{}, error) {
+		var body edgecloud.SecurityGroupCreateRequest
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+		if body.SecurityGroup.Name == "" {
+			return nil, badRequest("name is required")
+		}
+
+		instances := make([]*edgecloud.Instance, 0, len(body.Instances))
+		for _, id := range body.Instances {
+			instance, err := s.instance(r.scope, id.ID)
+			if err != nil {
+				return nil, badRequest("instance %s not found", id.ID)
+			}
+			instances = append(instances, instance)
+		}
+
+		sg := &edgecloud.SecurityGroup{
+			ID:        uuid.NewString(),
+			CreatedAt: now(),
+			Name:      body.SecurityGroup.Name,
+			Metadata:  metadataDetailed(body.SecurityGroup.Metadata),
+			ProjectID: r.scope.project,
+			RegionID:  r.scope.region,
+			Region:    RegionName,
+			Tags:      body.SecurityGroup.Tags,
+		}
+		if body.SecurityGroup.Description != nil {
+			sg.Description = *body.SecurityGroup.Description
+		}
+
+		// like the API, every new security group allows all the egress traffic
+		for _, etherType := range []edgecloud.EtherType{edgecloud.EtherTypeIPv4, edgecloud.EtherTypeIPv6} {
+			addRule(sg, edgecloud.RuleCreateRequest{Direction: edgecloud.SGRuleDirectionEgress, EtherType: etherType})
+		}
+		for _, rule := range body.SecurityGroup.SecurityGroupRules {
+			if err := rule.Direction.IsValid(); err != nil {
+				return nil, badRequest("%v", err)
+			}
+			addRule(sg, rule)
+		}
+
+		s.securityGroups.add(r.scope, sg.ID, sg)
+		for _, instance := range instances {
+			instance.SecurityGroups = append(instance.SecurityGroups, edgecloud.Name{Name: sg.Name})
+		}
+
+		return sg, nil
+	})
+
+	s.handle(mux, "PATCH "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		sg, err := s.securityGroup(r.scope, r.PathValue("id"))
+		if err != nil {
+			return nil, err
+		}
+
+		var body edgecloud.SecurityGroupUpdateRequest
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+		if body.Name != "" {
+			sg.Name = body.Name
+		}
+
+		for _, change := range body.ChangedRules {
+			switch change.Action {
+			case edgecloud.ChangedRuleCreate:
+				rule := edgecloud.RuleCreateRequest{
+					Description: edgecloud.PtrTo(change.Description),
+					Protocol:    change.Protocol,
+					EtherType:   change.EtherType,
+					Direction:   change.Direction,
+				}
+				if change.RemoteIPPrefix != "" {
+					rule.RemoteIPPrefix = edgecloud.PtrTo(change.RemoteIPPrefix)
+				}
+				if change.RemoteGroupID != "" {
+					rule.RemoteGroupID = edgecloud.PtrTo(change.RemoteGroupID)
+				}
+				if change.PortRangeMin != 0 {
+					rule.PortRangeMin = edgecloud.PtrTo(change.PortRangeMin)
+				}
+				if change.PortRangeMax != 0 {
+					rule.PortRangeMax = edgecloud.PtrTo(change.PortRangeMax)
+				}
+				addRule(sg, rule)
+			case edgecloud.ChangedRuleDelete:
+				if !removeRule(sg, change.SecurityGroupRuleID) {
+					return nil, notFound("security group rule", change.SecurityGroupRuleID)
+				}
+			default:
+				return nil, badRequest("invalid action %q of changed rule", change.Action)
+			}
+		}
+		sg.RevisionNumber++
+		sg.UpdatedAt = now()
+
+		return sg, nil
+	})
+
+	s.handle(mux, "DELETE "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		sg, err := s.securityGroup(r.scope, r.PathValue("id"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, instance := range s.instances.list(r.scope, nil) {
+			for _, name := range instance.SecurityGroups {
+				if name.Name == sg.Name {
+					return nil, conflict("security group %s is in use by instance %s", sg.ID, instance.ID)
+				}
+			}
+		}
+		s.securityGroups.remove(sg.ID)
+
+		return nil, nil
+	})
+}
+
+func (s *Server) securityGroup(sc scope, id string) (*edgecloud.SecurityGroup, error) {
+	sg, ok := s.securityGroups.get(sc, id)
+	if !ok {
+		return nil, notFound("security group", id)
+	}
+
+	return sg, nil
+}
+
+func addRule(sg *edgecloud.SecurityGroup, req edgecloud.RuleCreateRequest) {
+	rule := edgecloud.SecurityGroupRule{
+		ID:              uuid.NewString(),
+		SecurityGroupID: sg.ID,
+		Direction:       req.Direction,
+		PortRangeMax:    req.PortRangeMax,
+		PortRangeMin:    req.PortRangeMin,
+		Description:     req.Description,
+		RemoteIPPrefix:  req.RemoteIPPrefix,
+		CreatedAt:       now(),
+	}
+	if req.EtherType != "" {
+		rule.EtherType = edgecloud.PtrTo(req.EtherType)
+	}
+	if req.Protocol != "" {
+		rule.Protocol = edgecloud.PtrTo(req.Protocol)
+	}
+	if req.RemoteGroupID != nil {
+		rule.RemoteGroupID = *req.RemoteGroupID
+	}
+	sg.SecurityGroupRules = append(sg.SecurityGroupRules, rule)
+}
+
+func removeRule(sg *edgecloud.SecurityGroup, id string) bool {
+	for i, rule := range sg.SecurityGroupRules {
+		if rule.ID == id {
+			sg.SecurityGroupRules = append(sg.SecurityGroupRules[:i:i], sg.SecurityGroupRules[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,458 @@
+// Package edgecloudtest provides an in-memory fake of the EdgeCenter Cloud API for testing the code
+// built on the client without network.
+//
+// The fake Server emulates networks, subnets, instances, volumes, floating IPs, security groups,
+// load balancers and tasks. The resources are kept in memory and the asynchronous operations return
+// tasks that go through the NEW, RUNNING and FINISHED states as they are polled, as the API does:
+//
+//	server := edgecloudtest.NewServer()
+//	defer server.Close()
+//
+//	client, err := server.Client()
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//
+//	result, err := util.ExecuteAndExtractTaskResult(ctx, client.Volumes.Create, &edgecloud.VolumeCreateRequest{...}, client)
+//
+// Failures and latency are injected with Server.InjectFault.
+package edgecloudtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	// ProjectID is the project of the clients returned by Server.Client.
+	ProjectID = 1
+	// RegionID is the region of the clients returned by Server.Client.
+	RegionID = 1
+	// RegionName is the name of every region of the fake API.
+	RegionName = "Test"
+	// APIKey is the API key of the clients returned by Server.Client. The fake API accepts any credentials,
+	// but rejects the requests without them.
+	APIKey = "edgecloudtest"
+
+	// defaultTaskPolls is the number of polls for which a task is reported RUNNING by default.
+	defaultTaskPolls = 1
+
+	timeFormat = "2006-01-02T15:04:05+0000"
+)
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithTaskPolls sets the number of polls for which a task is reported RUNNING before it finishes,
+// 1 by default. With 0, the tasks finish when they are polled for the first time.
+func WithTaskPolls(n int) Option {
+	return func(s *Server) {
+		s.taskPolls = n
+	}
+}
+
+// WithLatency delays every response of the server.
+func WithLatency(latency time.Duration) Option {
+	return func(s *Server) {
+		s.latency = latency
+	}
+}
+
+// WithMaxLimit caps the number of items of the responses of the list endpoints, like the API caps their limit,
+// so that the clients have to page through the lists.
+func WithMaxLimit(n int) Option {
+	return func(s *Server) {
+		s.maxLimit = n
+	}
+}
+
+// Fault is a failure injected into the requests that match it.
+type Fault struct {
+	// Method is the HTTP method of the matching requests. An empty Method matches every method.
+	Method string
+
+	// Path is a path.Match pattern of the paths of the matching requests, e.g. /v1/volumes/*/*/*.
+	// An empty Path matches every path.
+	Path string
+
+	// StatusCode is the status of the error returned to the matching requests instead of handling them.
+	StatusCode int
+
+	// Message is the message of the error response. It defaults to the text of the StatusCode.
+	Message string
+
+	// TaskError makes the tasks of the matching requests end in the ERROR state with this error.
+	// It is ignored if StatusCode is set.
+	TaskError string
+
+	// Latency delays the responses to the matching requests.
+	Latency time.Duration
+
+	// Times is the number of requests the fault applies to. Zero means every matching request.
+	Times int
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	if f.Path == "" {
+		return true
+	}
+	matched, err := path.Match(f.Path, r.URL.Path)
+
+	return err == nil && matched
+}
+
+// Server is a fake EdgeCenter Cloud API served by an httptest.Server.
+type Server struct {
+	// URL is the base URL of the fake API.
+	URL string
+
+	server    *httptest.Server
+	taskPolls int
+	latency   time.Duration
+	maxLimit  int
+
+	mu             sync.Mutex
+	faults         []*Fault
+	tasks          collection[task]
+	networks       collection[edgecloud.Network]
+	subnets        collection[edgecloud.Subnetwork]
+	instances      collection[edgecloud.Instance]
+	volumes        collection[edgecloud.Volume]
+	floatingIPs    collection[edgecloud.FloatingIP]
+	securityGroups collection[edgecloud.SecurityGroup]
+	loadbalancers  collection[edgecloud.Loadbalancer]
+	ips            map[string]int
+}
+
+// NewServer starts a fake API server. It must be closed with Close.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		taskPolls: defaultTaskPolls,
+		ips:       make(map[string]int),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	s.registerTasks(mux)
+	s.registerNetworks(mux)
+	s.registerSubnets(mux)
+	s.registerInstances(mux)
+	s.registerVolumes(mux)
+	s.registerFloatingIPs(mux)
+	s.registerSecurityGroups(mux)
+	s.registerLoadbalancers(mux)
+
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Client returns a client of the fake API in the ProjectID project and RegionID region.
+// The options are applied after the ones of the fake API, so they may override them.
+func (s *Server) Client(opts ...edgecloud.ClientOpt) (*edgecloud.Client, error) {
+	opts = append([]edgecloud.ClientOpt{
+		edgecloud.SetBaseURL(s.URL),
+		edgecloud.SetAPIKey(APIKey),
+		edgecloud.SetProject(ProjectID),
+		edgecloud.SetRegion(RegionID),
+	}, opts...)
+
+	return edgecloud.New(s.server.Client(), opts...)
+}
+
+// InjectFault adds a fault to the requests that match it. The faults are checked in the order they were injected.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all the injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// fault returns the first fault matching the request, if any, and counts its use.
+func (s *Server) fault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		if f.Times > 0 {
+			if f.Times--; f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+
+		return f
+	}
+
+	return nil
+}
+
+// scope is the project and region of a request.
+type scope struct {
+	project int
+	region  int
+}
+
+// request is a request to a handler of the fake API.
+type request struct {
+	*http.Request
+	id    string
+	scope scope
+
+	// taskError is the error of the tasks created by the request, if a fault makes them fail.
+	taskError string
+
+	// maxLimit caps the number of items of a list response, if positive.
+	maxLimit int
+}
+
+// decode decodes the JSON body of the request.
+func (r *request) decode(v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return badRequest("invalid request body: %v", err)
+	}
+
+	return nil
+}
+
+// apiError is an error response of the fake API.
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &apiError{status: http.StatusBadRequest, message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(kind, id string) error {
+	return &apiError{status: http.StatusNotFound, message: fmt.Sprintf("%s %s not found", kind, id)}
+}
+
+func conflict(format string, args ...interface{}) error {
+	return &apiError{status: http.StatusConflict, message: fmt.Sprintf(format, args...)}
+}
+
+// handlerFunc handles a request of the fake API. It is called with the server locked.
+// A nil response is written as 204 No Content.
+type handlerFunc func(r *request) (interface{}, error)
+
+// handle registers a handler for the pattern. Patterns with the {project} and {region} wildcards
+// are handled in the scope of the project and region.
+func (s *Server) handle(mux *http.ServeMux, pattern string, handler handlerFunc) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		requestID := uuid.NewString()
+		w.Header().Set("X-Request-Id", requestID)
+
+		if s.latency > 0 {
+			time.Sleep(s.latency)
+		}
+
+		req := &request{Request: r, id: requestID, maxLimit: s.maxLimit}
+		if fault := s.fault(r); fault != nil {
+			if fault.Latency > 0 {
+				time.Sleep(fault.Latency)
+			}
+			if fault.StatusCode != 0 {
+				message := fault.Message
+				if message == "" {
+					message = http.StatusText(fault.StatusCode)
+				}
+				writeError(w, requestID, &apiError{status: fault.StatusCode, message: message})
+
+				return
+			}
+			req.taskError = fault.TaskError
+		}
+
+		if r.Header.Get("Authorization") == "" {
+			writeError(w, requestID, &apiError{status: http.StatusUnauthorized, message: "authentication credentials were not provided"})
+			return
+		}
+
+		var err error
+		if req.scope, err = parseScope(r); err != nil {
+			writeError(w, requestID, err)
+			return
+		}
+
+		s.mu.Lock()
+		resp, err := handler(req)
+		s.mu.Unlock()
+		if err != nil {
+			writeError(w, requestID, err)
+			return
+		}
+
+		if resp == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
+
+func parseScope(r *http.Request) (scope, error) {
+	var sc scope
+	for _, v := range []struct {
+		name  string
+		value *int
+	}{{"project", &sc.project}, {"region", &sc.region}} {
+		raw := r.PathValue(v.name)
+		if raw == "" {
+			continue
+		}
+		id, err := strconv.Atoi(raw)
+		if err != nil {
+			return sc, badRequest("invalid %s id %s", v.name, raw)
+		}
+		*v.value = id
+	}
+
+	return sc, nil
+}
+
+func writeError(w http.ResponseWriter, requestID string, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = &apiError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.status)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"message":    apiErr.message,
+		"request_id": requestID,
+	})
+}
+
+// listResponse is the response of the list endpoints. The limit and offset query parameters
+// of the request are applied to the items, the limit being capped by WithMaxLimit.
+func listResponse[T any](r *request, items []*T) (interface{}, error) {
+	count := len(items)
+
+	query := r.URL.Query()
+	if raw := query.Get("offset"); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return nil, badRequest("invalid offset %s", raw)
+		}
+		items = items[min(offset, len(items)):]
+	}
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 0 {
+			return nil, badRequest("invalid limit %s", raw)
+		}
+		items = items[:min(limit, len(items))]
+	}
+	if r.maxLimit > 0 {
+		items = items[:min(r.maxLimit, len(items))]
+	}
+
+	return struct {
+		Count   int  `json:"count"`
+		Results []*T `json:"results"`
+	}{Count: count, Results: items}, nil
+}
+
+// collection is a set of resources in the order they were created, each in its scope.
+type collection[T any] struct {
+	ids    []string
+	items  map[string]*T
+	scopes map[string]scope
+}
+
+func (c *collection[T]) add(sc scope, id string, item *T) {
+	if c.items == nil {
+		c.items = make(map[string]*T)
+		c.scopes = make(map[string]scope)
+	}
+	c.ids = append(c.ids, id)
+	c.items[id] = item
+	c.scopes[id] = sc
+}
+
+// get returns the resource with the id in the scope. A zero scope matches every scope.
+func (c *collection[T]) get(sc scope, id string) (*T, bool) {
+	item, ok := c.items[id]
+	if !ok || (sc != (scope{}) && c.scopes[id] != sc) {
+		return nil, false
+	}
+
+	return item, true
+}
+
+func (c *collection[T]) remove(id string) {
+	if _, ok := c.items[id]; !ok {
+		return
+	}
+	delete(c.items, id)
+	delete(c.scopes, id)
+	for i, v := range c.ids {
+		if v == id {
+			c.ids = append(c.ids[:i:i], c.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+// list returns the resources in the scope that match the filter, if any. A zero scope matches every scope.
+func (c *collection[T]) list(sc scope, filter func(*T) bool) []*T {
+	items := make([]*T, 0, len(c.ids))
+	for _, id := range c.ids {
+		if sc != (scope{}) && c.scopes[id] != sc {
+			continue
+		}
+		if item := c.items[id]; filter == nil || filter(item) {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// now returns the current time in the format of the API.
+func now() edgecloud.Timestamp {
+	t, _ := edgecloud.ParseTimestamp(time.Now().UTC().Format(timeFormat))
+
+	return t
+}
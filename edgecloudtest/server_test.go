@@ -0,0 +1,316 @@
+package edgecloudtest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+func newTestServer(t *testing.T, opts ...Option) (*Server, *edgecloud.Client) {
+	t.Helper()
+
+	server := NewServer(append([]Option{WithTaskPolls(0)}, opts...)...)
+	t.Cleanup(server.Close)
+
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	return server, client
+}
+
+func createVolume(ctx context.Context, t *testing.T, client *edgecloud.Client, name string) string {
+	t.Helper()
+
+	result, err := util.ExecuteAndExtractTaskResult(ctx, client.Volumes.Create, &edgecloud.VolumeCreateRequest{
+		Name:     name,
+		Size:     10,
+		Source:   edgecloud.VolumeSourceNewVolume,
+		TypeName: edgecloud.VolumeTypeSsdHiIops,
+	}, client)
+	require.NoError(t, err)
+	require.Len(t, result.Volumes, 1)
+
+	return result.Volumes[0]
+}
+
+func TestServer_TaskStates(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestServer(t, WithTaskPolls(1))
+
+	resp, _, err := client.Volumes.Create(ctx, &edgecloud.VolumeCreateRequest{
+		Name:     "test-volume",
+		Size:     10,
+		Source:   edgecloud.VolumeSourceNewVolume,
+		TypeName: edgecloud.VolumeTypeStandard,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 1)
+
+	volumes, _, err := client.Volumes.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+	assert.Equal(t, edgecloud.VolumeStatusCreating, volumes[0].Status)
+	assert.Equal(t, resp.Tasks[0], volumes[0].CreatorTaskID)
+
+	var states []edgecloud.TaskState
+	for i := 0; i < 3; i++ {
+		task, _, err := client.Tasks.Get(ctx, resp.Tasks[0])
+		require.NoError(t, err)
+		states = append(states, task.State)
+	}
+	assert.Equal(t, []edgecloud.TaskState{
+		edgecloud.TaskStateRunning, edgecloud.TaskStateFinished, edgecloud.TaskStateFinished,
+	}, states)
+
+	task, _, err := client.Tasks.Get(ctx, resp.Tasks[0])
+	require.NoError(t, err)
+	result, err := util.ExtractTaskResultFromTask(task)
+	require.NoError(t, err)
+	assert.Equal(t, []string{volumes[0].ID}, result.Volumes)
+
+	volume, _, err := client.Volumes.Get(ctx, volumes[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.VolumeStatusAvailable, volume.Status)
+}
+
+func TestServer_CompleteTasks(t *testing.T) {
+	ctx := context.Background()
+	server, client := newTestServer(t, WithTaskPolls(100))
+
+	resp, _, err := client.Networks.Create(ctx, &edgecloud.NetworkCreateRequest{Name: "test-network"})
+	require.NoError(t, err)
+
+	tasks, _, err := client.Tasks.ListActive(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, resp.Tasks[0], tasks[0].ID)
+
+	server.CompleteTasks()
+
+	task, _, err := client.Tasks.Get(ctx, resp.Tasks[0])
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.TaskStateFinished, task.State)
+	tasks, _, err = client.Tasks.ListActive(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+}
+
+func TestServer_Instance(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestServer(t)
+
+	result, err := util.ExecuteAndExtractTaskResult(ctx, client.Networks.Create, &edgecloud.NetworkCreateRequest{
+		Name: "test-network",
+	}, client)
+	require.NoError(t, err)
+	require.Len(t, result.Networks, 1)
+	networkID := result.Networks[0]
+
+	result, err = util.ExecuteAndExtractTaskResult(ctx, client.Subnetworks.Create, &edgecloud.SubnetworkCreateRequest{
+		Name:      "test-subnet",
+		NetworkID: networkID,
+		CIDR:      "10.0.0.0/24",
+	}, client)
+	require.NoError(t, err)
+	require.Len(t, result.Subnets, 1)
+	subnetID := result.Subnets[0]
+
+	sg, _, err := client.SecurityGroups.Create(ctx, &edgecloud.SecurityGroupCreateRequest{
+		SecurityGroup: edgecloud.SecurityGroupCreateRequestInner{Name: "test-sg"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, sg.SecurityGroupRules, 2)
+
+	volumeID := createVolume(ctx, t, client, "test-volume")
+
+	result, err = util.ExecuteAndExtractTaskResult(ctx, client.Instances.Create, &edgecloud.InstanceCreateRequest{
+		Names:  []string{"test-instance"},
+		Flavor: "g1-standard-1-2",
+		Interfaces: []edgecloud.InstanceInterface{{
+			Type:       edgecloud.InterfaceTypeSubnet,
+			NetworkID:  networkID,
+			SubnetID:   subnetID,
+			FloatingIP: &edgecloud.InterfaceFloatingIP{Source: edgecloud.NewFloatingIP},
+		}},
+		Volumes: []edgecloud.InstanceVolumeCreate{
+			{Source: edgecloud.VolumeSourceImage, ImageID: "f0d19cec-5c3f-4853-886e-304915960ff6", Size: 5, BootIndex: edgecloud.PtrTo(0)},
+			{Source: edgecloud.VolumeSourceExistingVolume, VolumeID: volumeID, BootIndex: edgecloud.PtrTo(1)},
+		},
+		SecurityGroups: []edgecloud.ID{{ID: sg.ID}},
+	}, client)
+	require.NoError(t, err)
+	require.Len(t, result.Instances, 1)
+	require.Len(t, result.Volumes, 1)
+	require.Len(t, result.FloatingIPs, 1)
+
+	instance, _, err := client.Instances.Get(ctx, result.Instances[0])
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.InstanceStatusActive, instance.Status)
+	assert.Equal(t, []edgecloud.Name{{Name: "test-sg"}}, instance.SecurityGroups)
+	require.Len(t, instance.Addresses["test-network"], 2)
+	assert.Equal(t, "10.0.0.2", instance.Addresses["test-network"][0].Address.String())
+	assert.Equal(t, subnetID, instance.Addresses["test-network"][0].SubnetID)
+	assert.Len(t, instance.Volumes, 2)
+
+	volume, _, err := client.Volumes.Get(ctx, volumeID)
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.VolumeStatusInUse, volume.Status)
+	assert.Equal(t, instance.ID, volume.InstanceID)
+
+	fip, _, err := client.Floatingips.Get(ctx, result.FloatingIPs[0])
+	require.NoError(t, err)
+	assert.Equal(t, floatingIPStatusActive, fip.Status)
+	assert.Equal(t, "10.0.0.2", fip.FixedIPAddress.String())
+
+	_, err = client.SecurityGroups.Delete(ctx, sg.ID)
+	require.ErrorIs(t, err, edgecloud.ErrConflict)
+	_, _, err = client.Subnetworks.Delete(ctx, subnetID)
+	require.ErrorIs(t, err, edgecloud.ErrConflict)
+
+	resp, _, err := client.Instances.Delete(ctx, instance.ID, &edgecloud.InstanceDeleteOptions{
+		Volumes:         []string{result.Volumes[0]},
+		DeleteFloatings: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, util.WaitForTaskComplete(ctx, client, resp.Tasks[0]))
+
+	_, _, err = client.Instances.Get(ctx, instance.ID)
+	require.ErrorIs(t, err, edgecloud.ErrNotFound)
+	_, _, err = client.Volumes.Get(ctx, result.Volumes[0])
+	require.ErrorIs(t, err, edgecloud.ErrNotFound)
+	_, _, err = client.Floatingips.Get(ctx, result.FloatingIPs[0])
+	require.ErrorIs(t, err, edgecloud.ErrNotFound)
+	volume, _, err = client.Volumes.Get(ctx, volumeID)
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.VolumeStatusAvailable, volume.Status)
+	assert.Empty(t, volume.InstanceID)
+}
+
+func TestServer_DeleteResourceIfExist(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestServer(t)
+
+	volumeID := createVolume(ctx, t, client, "test-volume")
+
+	result, err := util.ExecuteAndExtractTaskResult(ctx, client.Floatingips.Create, &edgecloud.FloatingIPCreateRequest{}, client)
+	require.NoError(t, err)
+	require.Len(t, result.FloatingIPs, 1)
+	fipID := result.FloatingIPs[0]
+
+	result, err = util.ExecuteAndExtractTaskResult(ctx, client.Loadbalancers.Create, &edgecloud.LoadbalancerCreateRequest{
+		Name: "test-lb",
+		Listeners: []edgecloud.LoadbalancerListenerCreateRequest{{
+			Name:         "test-listener",
+			Protocol:     edgecloud.ListenerProtocolHTTP,
+			ProtocolPort: 80,
+		}},
+		FloatingIP: &edgecloud.InterfaceFloatingIP{Source: edgecloud.ExistingFloatingIP, ExistingFloatingID: fipID},
+	}, client)
+	require.NoError(t, err)
+	require.Len(t, result.Loadbalancers, 1)
+	require.Len(t, result.Listeners, 1)
+	lbID := result.Loadbalancers[0]
+
+	lb, _, err := client.Loadbalancers.Get(ctx, lbID)
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.ProvisioningStatusActive, lb.ProvisioningStatus)
+	require.Len(t, lb.FloatingIPs, 1)
+	assert.Equal(t, fipID, lb.FloatingIPs[0].ID)
+	listener, _, err := client.Loadbalancers.ListenerGet(ctx, result.Listeners[0])
+	require.NoError(t, err)
+	assert.Equal(t, lbID, listener.LoadbalancerID)
+
+	require.NoError(t, util.DeleteResourceIfExist(ctx, client, client.Loadbalancers, lbID))
+	fip, _, err := client.Floatingips.Get(ctx, fipID)
+	require.NoError(t, err)
+	assert.Equal(t, floatingIPStatusDown, fip.Status)
+
+	require.NoError(t, util.DeleteResourceIfExist(ctx, client, client.Floatingips, fipID))
+	require.NoError(t, util.DeleteResourceIfExist(ctx, client, client.Volumes, volumeID))
+	require.NoError(t, util.DeleteResourceIfExist(ctx, client, client.Volumes, volumeID))
+
+	volumes, _, err := client.Volumes.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, volumes)
+}
+
+func TestServer_InjectFault(t *testing.T) {
+	ctx := context.Background()
+	server, client := newTestServer(t)
+
+	server.InjectFault(Fault{Method: http.MethodGet, Path: "/v1/volumes/*/*", StatusCode: http.StatusConflict, Times: 1})
+	_, _, err := client.Volumes.List(ctx, nil)
+	require.ErrorIs(t, err, edgecloud.ErrConflict)
+	_, _, err = client.Volumes.List(ctx, nil)
+	require.NoError(t, err)
+
+	server.InjectFault(Fault{Method: http.MethodPost, Path: "/v1/volumes/*/*", TaskError: "no space left"})
+	_, err = util.ExecuteAndExtractTaskResult(ctx, client.Volumes.Create, &edgecloud.VolumeCreateRequest{
+		Name:     "test-volume",
+		Size:     10,
+		Source:   edgecloud.VolumeSourceNewVolume,
+		TypeName: edgecloud.VolumeTypeStandard,
+	}, client)
+	require.ErrorContains(t, err, "no space left")
+
+	volumes, _, err := client.Volumes.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+	assert.Equal(t, edgecloud.VolumeStatusError, volumes[0].Status)
+
+	server.ClearFaults()
+	server.InjectFault(Fault{Latency: 50 * time.Millisecond})
+	start := time.Now()
+	_, _, err = client.Volumes.Get(ctx, volumes[0].ID)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+}
+
+func TestServer_Errors(t *testing.T) {
+	ctx := context.Background()
+	server, client := newTestServer(t)
+
+	_, _, err := client.Volumes.Get(ctx, "f0d19cec-5c3f-4853-886e-304915960ff6")
+	require.ErrorIs(t, err, edgecloud.ErrNotFound)
+
+	resp, err := server.server.Client().Get(server.URL + "/v1/volumes/1/1")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.NotEmpty(t, resp.Header.Get("X-Request-Id"))
+
+	otherRegion, err := server.Client(edgecloud.SetRegion(2))
+	require.NoError(t, err)
+	volumeID := createVolume(ctx, t, otherRegion, "test-volume")
+	_, _, err = client.Volumes.Get(ctx, volumeID)
+	require.ErrorIs(t, err, edgecloud.ErrNotFound)
+}
+
+func TestServer_Pagination(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestServer(t)
+
+	for _, name := range []string{"network-1", "network-2", "network-3"} {
+		_, _, err := client.Networks.Create(ctx, &edgecloud.NetworkCreateRequest{Name: name})
+		require.NoError(t, err)
+	}
+
+	var page struct {
+		Count   int                 `json:"count"`
+		Results []edgecloud.Network `json:"results"`
+	}
+	req, err := client.NewRequest(ctx, http.MethodGet, "/v1/networks/1/1?offset=1&limit=1", nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, &page)
+	require.NoError(t, err)
+	assert.Equal(t, 3, page.Count)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, "network-2", page.Results[0].Name)
+}
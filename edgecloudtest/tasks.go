@@ -0,0 +1,144 @@
+package edgecloudtest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// task is a task of the fake API with the effects it has when it ends.
+type task struct {
+	edgecloud.Task
+
+	// polls is the number of polls left before the task finishes.
+	polls int
+	// err is the error the task ends with, if it fails.
+	err string
+	// resources are the created resources reported when the task finishes.
+	resources map[string]interface{}
+	// onFinish and onError apply the effects of the task when it finishes or fails.
+	onFinish func()
+	onError  func()
+}
+
+// newTask creates a task of the request in the NEW state and returns the response with its ID.
+// The created resources are reported by the task when it finishes.
+func (s *Server) newTask(r *request, taskType string, resources map[string][]string, onFinish, onError func()) *edgecloud.TaskResponse {
+	t := &task{
+		Task: edgecloud.Task{
+			ID:        uuid.NewString(),
+			TaskType:  taskType,
+			ProjectID: r.scope.project,
+			RegionID:  r.scope.region,
+			State:     edgecloud.TaskStateNew,
+			RequestID: r.id,
+			CreatedOn: now(),
+		},
+		polls:     s.taskPolls,
+		err:       r.taskError,
+		resources: make(map[string]interface{}, len(resources)),
+		onFinish:  onFinish,
+		onError:   onError,
+	}
+	for kind, ids := range resources {
+		t.resources[kind] = ids
+	}
+	s.tasks.add(r.scope, t.ID, t)
+
+	return &edgecloud.TaskResponse{Tasks: []string{t.ID}}
+}
+
+// poll moves the task on as it is polled: from NEW to RUNNING, then to FINISHED or ERROR once it was
+// reported RUNNING for the configured number of polls.
+func (s *Server) poll(t *task) {
+	if t.State == edgecloud.TaskStateNew {
+		t.State = edgecloud.TaskStateRunning
+		t.UpdatedOn = edgecloud.PtrTo(now())
+	}
+	if t.State != edgecloud.TaskStateRunning {
+		return
+	}
+
+	if t.polls > 0 {
+		t.polls--
+		return
+	}
+	s.complete(t)
+}
+
+// complete ends the task and applies its effects.
+func (s *Server) complete(t *task) {
+	finishedOn := now()
+	t.UpdatedOn = &finishedOn
+	t.FinishedOn = &finishedOn
+
+	if t.err != "" {
+		t.State = edgecloud.TaskStateError
+		t.Error = edgecloud.PtrTo(t.err)
+		if t.onError != nil {
+			t.onError()
+		}
+
+		return
+	}
+
+	t.State = edgecloud.TaskStateFinished
+	t.CreatedResources = t.resources
+	if t.onFinish != nil {
+		t.onFinish()
+	}
+}
+
+// CompleteTasks ends all the tasks that are still in progress, as if they were polled until they end.
+func (s *Server) CompleteTasks() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.tasks.list(scope{}, isActive) {
+		s.complete(t)
+	}
+}
+
+func isActive(t *task) bool {
+	return t.State == edgecloud.TaskStateNew || t.State == edgecloud.TaskStateRunning
+}
+
+func (s *Server) registerTasks(mux *http.ServeMux) {
+	s.handle(mux, "GET /v1/tasks/{id}", func(r *request) (interface{}, error) {
+		t, ok := s.tasks.get(scope{}, r.PathValue("id"))
+		if !ok {
+			return nil, notFound("task", r.PathValue("id"))
+		}
+		s.poll(t)
+
+		return &t.Task, nil
+	})
+
+	s.handle(mux, "GET /v1/tasks", func(r *request) (interface{}, error) {
+		query := r.URL.Query()
+		tasks := s.tasks.list(scope{}, func(t *task) bool {
+			return (query.Get("project_id") == "" || query.Get("project_id") == strconv.Itoa(t.ProjectID)) &&
+				(query.Get("region_id") == "" || query.Get("region_id") == strconv.Itoa(t.RegionID)) &&
+				(query.Get("state") == "" || query.Get("state") == string(t.State)) &&
+				(query.Get("task_type") == "" || query.Get("task_type") == t.TaskType)
+		})
+
+		return listResponse(r, taskList(tasks))
+	})
+
+	s.handle(mux, "GET /v1/tasks/{project}/{region}/active", func(r *request) (interface{}, error) {
+		return listResponse(r, taskList(s.tasks.list(r.scope, isActive)))
+	})
+}
+
+func taskList(tasks []*task) []*edgecloud.Task {
+	list := make([]*edgecloud.Task, 0, len(tasks))
+	for _, t := range tasks {
+		list = append(list, &t.Task)
+	}
+
+	return list
+}
@@ -0,0 +1,148 @@
+package edgecloudtest
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func (s *Server) registerVolumes(mux *http.ServeMux) {
+	const basePath = "/v1/volumes/{project}/{region}"
+
+	s.handle(mux, "GET "+basePath, func(r *request) (interface{}, error) {
+		instanceID := r.URL.Query().Get("instance_id")
+		return listResponse(r, s.volumes.list(r.scope, func(volume *edgecloud.Volume) bool {
+			return instanceID == "" || volume.InstanceID == instanceID
+		}))
+	})
+
+	s.handle(mux, "GET "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		return s.volume(r.scope, r.PathValue("id"))
+	})
+
+	s.handle(mux, "POST "+basePath, func(r *request) (interface{}, error) {
+		var body edgecloud.VolumeCreateRequest
+		if err := r.decode(&body); err != nil {
+			return nil, err
+		}
+		if body.Name == "" {
+			return nil, badRequest("name is required")
+		}
+		if err := body.Source.IsValid(); err != nil {
+			return nil, badRequest("%v", err)
+		}
+
+		var instance *edgecloud.Instance
+		if body.InstanceIDToAttachTo != "" {
+			var ok bool
+			if instance, ok = s.instances.get(r.scope, body.InstanceIDToAttachTo); !ok {
+				return nil, badRequest("instance %s not found", body.InstanceIDToAttachTo)
+			}
+		}
+
+		volume, err := s.createVolume(r, body.Name, body.TypeName, body.Size, body.Source == edgecloud.VolumeSourceImage)
+		if err != nil {
+			return nil, err
+		}
+		if body.Metadata != nil {
+			volume.Metadata = body.Metadata
+		}
+		volume.MetadataDetailed = metadataDetailed(body.Metadata)
+
+		resp := s.newTask(r, "create_volume", map[string][]string{"volumes": {volume.ID}}, func() {
+			volume.Status = edgecloud.VolumeStatusAvailable
+			if instance != nil {
+				attachVolume(volume, instance)
+			}
+		}, func() {
+			volume.Status = edgecloud.VolumeStatusError
+		})
+		volume.TaskID = resp.Tasks[0]
+		volume.CreatorTaskID = resp.Tasks[0]
+
+		return resp, nil
+	})
+
+	s.handle(mux, "DELETE "+basePath+"/{id}", func(r *request) (interface{}, error) {
+		volume, err := s.volume(r.scope, r.PathValue("id"))
+		if err != nil {
+			return nil, err
+		}
+		if volume.Status == edgecloud.VolumeStatusInUse {
+			return nil, conflict("volume %s is attached to instance %s", volume.ID, volume.InstanceID)
+		}
+
+		status := volume.Status
+		volume.Status = edgecloud.VolumeStatusDeleting
+
+		return s.newTask(r, "delete_volume", nil, func() {
+			s.volumes.remove(volume.ID)
+		}, func() {
+			volume.Status = status
+		}), nil
+	})
+}
+
+func (s *Server) volume(sc scope, id string) (*edgecloud.Volume, error) {
+	volume, ok := s.volumes.get(sc, id)
+	if !ok {
+		return nil, notFound("volume", id)
+	}
+
+	return volume, nil
+}
+
+// createVolume adds a volume in the creating status.
+func (s *Server) createVolume(r *request, name string, volumeType edgecloud.VolumeType, size int, bootable bool) (*edgecloud.Volume, error) {
+	if size <= 0 {
+		return nil, badRequest("size of volume %s must be positive", name)
+	}
+	if volumeType == "" {
+		volumeType = edgecloud.VolumeTypeStandard
+	}
+
+	volume := &edgecloud.Volume{
+		ID:          uuid.NewString(),
+		Name:        name,
+		Status:      edgecloud.VolumeStatusCreating,
+		Size:        size,
+		CreatedAt:   now(),
+		VolumeType:  volumeType,
+		Bootable:    bootable,
+		Metadata:    edgecloud.Metadata{},
+		SnapshotIDs: []string{},
+		Region:      RegionName,
+		RegionID:    r.scope.region,
+		ProjectID:   r.scope.project,
+		Attachments: []edgecloud.Attachment{},
+	}
+	s.volumes.add(r.scope, volume.ID, volume)
+
+	return volume, nil
+}
+
+// attachVolume attaches the volume to the instance.
+func attachVolume(volume *edgecloud.Volume, instance *edgecloud.Instance) {
+	volume.Status = edgecloud.VolumeStatusInUse
+	volume.InstanceID = instance.ID
+	volume.Device = "/dev/vd" + string(rune('a'+len(instance.Volumes)))
+	volume.Attachments = []edgecloud.Attachment{{
+		ServerID:     instance.ID,
+		InstanceName: instance.Name,
+		AttachmentID: uuid.NewString(),
+		VolumeID:     volume.ID,
+		Device:       volume.Device,
+		AttachedAt:   now(),
+	}}
+	instance.Volumes = append(instance.Volumes, edgecloud.InstanceVolume{ID: volume.ID})
+}
+
+// detachVolume detaches the volume from its instance.
+func detachVolume(volume *edgecloud.Volume) {
+	volume.Status = edgecloud.VolumeStatusAvailable
+	volume.InstanceID = ""
+	volume.Device = ""
+	volume.Attachments = []edgecloud.Attachment{}
+}
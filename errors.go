@@ -1,8 +1,12 @@
 package edgecloud
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
 )
 
 var (
@@ -10,6 +14,15 @@ var (
 	ErrResourceDoesntExist              = errors.New("resource doesn't exist")
 )
 
+// Sentinel errors matched by a *ResponseError with errors.Is according to its status code and error code.
+var (
+	ErrNotFound      = errors.New("resource not found")
+	ErrConflict      = errors.New("resource is in conflict with its current state")
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrRateLimited   = errors.New("rate limit exceeded")
+)
+
 // ArgError is an error that represents an error with an input to edgecloud. It
 // identifies the argument and the cause (if possible).
 type ArgError struct {
@@ -30,3 +43,103 @@ func NewArgError(arg, reason string) *ArgError {
 func (e *ArgError) Error() string {
 	return fmt.Sprintf("%s is invalid because %s", e.arg, e.reason)
 }
+
+// FieldError is a validation error of a single request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) String() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// Is reports whether the response error matches one of the sentinel errors
+// ErrNotFound, ErrConflict, ErrQuotaExceeded, ErrUnauthorized or ErrRateLimited.
+func (r *ResponseError) Is(target error) bool {
+	switch target { //nolint:errorlint
+	case ErrNotFound:
+		return r.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return r.StatusCode == http.StatusConflict
+	case ErrUnauthorized:
+		return r.StatusCode == http.StatusUnauthorized
+	case ErrRateLimited:
+		return r.StatusCode == http.StatusTooManyRequests
+	case ErrQuotaExceeded:
+		return r.StatusCode >= http.StatusBadRequest && r.StatusCode < http.StatusInternalServerError &&
+			(strings.Contains(strings.ToLower(r.Code), "quota") || strings.Contains(strings.ToLower(r.Message), "quota"))
+	default:
+		return false
+	}
+}
+
+// UnmarshalJSON decodes the error body of the API. Besides the plain fields, it accepts the error code
+// as exception_class and the field errors both as a list and as a map of field names to messages.
+func (r *ResponseError) UnmarshalJSON(data []byte) error {
+	var body struct {
+		Message        string          `json:"message"`
+		Code           string          `json:"code"`
+		ExceptionClass string          `json:"exception_class"`
+		RequestID      string          `json:"request_id"`
+		Errors         json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	fieldErrors, err := unmarshalFieldErrors(body.Errors)
+	if err != nil {
+		return err
+	}
+
+	r.Message = body.Message
+	r.Code = body.Code
+	if r.Code == "" {
+		r.Code = body.ExceptionClass
+	}
+	r.RequestID = body.RequestID
+	r.FieldErrors = fieldErrors
+
+	return nil
+}
+
+func unmarshalFieldErrors(data json.RawMessage) ([]FieldError, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	var list []FieldError
+	if err := json.Unmarshal(data, &list); err == nil {
+		return list, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var fieldErrors []FieldError
+	for _, name := range names {
+		var messages []string
+		if err := json.Unmarshal(fields[name], &messages); err != nil {
+			var message string
+			if err := json.Unmarshal(fields[name], &message); err != nil {
+				return nil, err
+			}
+			messages = []string{message}
+		}
+
+		for _, message := range messages {
+			fieldErrors = append(fieldErrors, FieldError{Field: name, Message: message})
+		}
+	}
+
+	return fieldErrors, nil
+}
@@ -1,6 +1,15 @@
 package edgecloud
 
-import "testing"
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
 
 func TestArgError(t *testing.T) {
 	expected := "foo is invalid because bar"
@@ -9,3 +18,143 @@ func TestArgError(t *testing.T) {
 		t.Errorf("ArgError().Error() = %q; expected %q", got, expected)
 	}
 }
+
+func TestCheckResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		header   http.Header
+		body     string
+		expected *ResponseError
+		is       []error
+		isNot    []error
+	}{
+		{
+			name:   "not found with request ID in header",
+			status: http.StatusNotFound,
+			header: http.Header{"X-Request-Id": []string{"req-header"}},
+			body:   `{"message": "Instance not found", "exception_class": "InstanceNotFound"}`,
+			expected: &ResponseError{
+				StatusCode: http.StatusNotFound,
+				Message:    "Instance not found",
+				Code:       "InstanceNotFound",
+				RequestID:  "req-header",
+			},
+			is:    []error{ErrNotFound},
+			isNot: []error{ErrConflict, ErrQuotaExceeded, ErrUnauthorized, ErrRateLimited},
+		},
+		{
+			name:   "request ID in body takes precedence",
+			status: http.StatusConflict,
+			header: http.Header{"X-Request-Id": []string{"req-header"}},
+			body:   `{"message": "Resource is locked", "code": "Locked", "request_id": "req-body"}`,
+			expected: &ResponseError{
+				StatusCode: http.StatusConflict,
+				Message:    "Resource is locked",
+				Code:       "Locked",
+				RequestID:  "req-body",
+			},
+			is:    []error{ErrConflict},
+			isNot: []error{ErrNotFound, ErrQuotaExceeded},
+		},
+		{
+			name:   "quota exceeded",
+			status: http.StatusForbidden,
+			body:   `{"message": "Not enough quota", "exception_class": "QuotaExceeded"}`,
+			expected: &ResponseError{
+				StatusCode: http.StatusForbidden,
+				Message:    "Not enough quota",
+				Code:       "QuotaExceeded",
+			},
+			is:    []error{ErrQuotaExceeded},
+			isNot: []error{ErrUnauthorized},
+		},
+		{
+			name:   "field errors as list",
+			status: http.StatusBadRequest,
+			body:   `{"message": "Validation error", "errors": [{"field": "name", "message": "too long"}]}`,
+			expected: &ResponseError{
+				StatusCode:  http.StatusBadRequest,
+				Message:     "Validation error",
+				FieldErrors: []FieldError{{Field: "name", Message: "too long"}},
+			},
+		},
+		{
+			name:   "field errors as map",
+			status: http.StatusUnprocessableEntity,
+			body:   `{"message": "Validation error", "errors": {"name": ["too long", "invalid"], "flavor": "required"}}`,
+			expected: &ResponseError{
+				StatusCode: http.StatusUnprocessableEntity,
+				Message:    "Validation error",
+				FieldErrors: []FieldError{
+					{Field: "flavor", Message: "required"},
+					{Field: "name", Message: "too long"},
+					{Field: "name", Message: "invalid"},
+				},
+			},
+		},
+		{
+			name:   "unauthorized with non JSON body",
+			status: http.StatusUnauthorized,
+			body:   "Unauthorized",
+			expected: &ResponseError{
+				StatusCode: http.StatusUnauthorized,
+				Message:    "Unauthorized",
+			},
+			is: []error{ErrUnauthorized},
+		},
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			expected: &ResponseError{
+				StatusCode: http.StatusTooManyRequests,
+			},
+			is: []error{ErrRateLimited},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := tt.header
+			if header == nil {
+				header = http.Header{}
+			}
+			resp := &http.Response{
+				StatusCode: tt.status,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			}
+			tt.expected.Response = resp
+
+			err := CheckResponse(resp)
+
+			var respErr *ResponseError
+			require.ErrorAs(t, err, &respErr)
+			assert.Equal(t, tt.expected, respErr)
+			for _, target := range tt.is {
+				assert.ErrorIs(t, err, target)
+			}
+			for _, target := range tt.isNot {
+				assert.NotErrorIs(t, err, target)
+			}
+		})
+	}
+}
+
+func TestResponseError_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-id")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "Validation error", "errors": [{"field": "name", "message": "too long"}]}`))
+	})
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	expected := fmt.Sprintf("GET %s/foo: 400 Validation error (name: too long); request_id: req-id", server.URL)
+	assert.EqualError(t, err, expected)
+}
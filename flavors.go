@@ -70,11 +70,13 @@ type flavorsRoot struct {
 
 // List get flavors.
 func (s *FlavorsServiceOp) List(ctx context.Context, opts *FlavorListOptions) ([]Flavor, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Flavors.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(flavorsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, flavorsBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -96,11 +98,13 @@ func (s *FlavorsServiceOp) List(ctx context.Context, opts *FlavorListOptions) ([
 
 // ListBaremetal get baremetal flavors.
 func (s *FlavorsServiceOp) ListBaremetal(ctx context.Context, opts *FlavorListOptions) ([]Flavor, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Flavors.ListBaremetal")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(bmflavorsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, bmflavorsBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -123,11 +127,13 @@ func (s *FlavorsServiceOp) ListBaremetal(ctx context.Context, opts *FlavorListOp
 // Deprecated: use ListBaremetal instead.
 // ListBaremetalForClient get baremetal flavors from default project for current client.
 func (s *FlavorsServiceOp) ListBaremetalForClient(ctx context.Context, opts *FlavorListOptions) ([]Flavor, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Flavors.ListBaremetalForClient")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addRegionPath(bmflavorsBasePathV1)
+	path := s.client.addRegionPath(ctx, bmflavorsBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -2,6 +2,7 @@ package edgecloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -49,8 +50,8 @@ var _ FloatingIPsService = &FloatingipsServiceOp{}
 // FloatingIP represents an EdgecenterCloud FloatingIP.
 type FloatingIP struct {
 	ID                string             `json:"id"`
-	CreatedAt         string             `json:"created_at"`
-	UpdatedAt         string             `json:"updated_at"`
+	CreatedAt         Timestamp          `json:"created_at"`
+	UpdatedAt         Timestamp          `json:"updated_at"`
 	Status            string             `json:"status"`
 	FixedIPAddress    net.IP             `json:"fixed_ip_address,omitempty"`
 	FloatingIPAddress string             `json:"floating_ip_address,omitempty"`
@@ -76,9 +77,20 @@ const (
 	ExistingFloatingIP FloatingIPSource = "existing"
 )
 
+var ErrInvalidFloatingIPSource = errors.New("invalid FloatingIPSource value")
+
+// IsValid reports whether the floating IP source is one of the known values.
+func (s FloatingIPSource) IsValid() error {
+	switch s {
+	case NewFloatingIP, ExistingFloatingIP:
+		return nil
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidFloatingIPSource, s)
+}
+
 type InterfaceFloatingIP struct {
 	Source             FloatingIPSource `json:"source" validate:"required,enum"`
-	ExistingFloatingID string           `json:"existing_floating_id" validate:"rfe=Source:existing,sfe=Source:new,omitempty,UUID"`
+	ExistingFloatingID string           `json:"existing_floating_id" validate:"rfe=Source:existing,sfe=Source:new,omitempty,uuid4"`
 }
 
 // FloatingIPCreateRequest represents a request to create a Floating IP.
@@ -103,11 +115,13 @@ type floatingipsRoot struct {
 
 // List get floating IPs.
 func (s *FloatingipsServiceOp) List(ctx context.Context) ([]FloatingIP, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Floatingips.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(floatingipsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, floatingipsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -125,15 +139,17 @@ func (s *FloatingipsServiceOp) List(ctx context.Context) ([]FloatingIP, *Respons
 
 // Get a Floating IP.
 func (s *FloatingipsServiceOp) Get(ctx context.Context, fipID string) (*FloatingIP, *Response, error) {
+	ctx = withOperation(ctx, "Floatingips.Get")
+
 	if resp, err := isValidUUID(fipID, "fipID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(floatingipsBasePathV1), fipID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, floatingipsBasePathV1), fipID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -151,15 +167,21 @@ func (s *FloatingipsServiceOp) Get(ctx context.Context, fipID string) (*Floating
 
 // Create a Floating IP.
 func (s *FloatingipsServiceOp) Create(ctx context.Context, reqBody *FloatingIPCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Floatingips.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(floatingipsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, floatingipsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -177,15 +199,17 @@ func (s *FloatingipsServiceOp) Create(ctx context.Context, reqBody *FloatingIPCr
 
 // Delete the Floating IP.
 func (s *FloatingipsServiceOp) Delete(ctx context.Context, fipID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Floatingips.Delete")
+
 	if resp, err := isValidUUID(fipID, "fipID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(floatingipsBasePathV1), fipID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, floatingipsBasePathV1), fipID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -203,6 +227,8 @@ func (s *FloatingipsServiceOp) Delete(ctx context.Context, fipID string) (*TaskR
 
 // Assign a floating IP to an instance or a load balancer.
 func (s *FloatingipsServiceOp) Assign(ctx context.Context, fipID string, reqBody *AssignFloatingIPRequest) (*FloatingIP, *Response, error) {
+	ctx = withOperation(ctx, "Floatingips.Assign")
+
 	if resp, err := isValidUUID(fipID, "fipID"); err != nil {
 		return nil, resp, err
 	}
@@ -211,11 +237,11 @@ func (s *FloatingipsServiceOp) Assign(ctx context.Context, fipID string, reqBody
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(floatingipsBasePathV1), fipID, floatingipsAssign)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, floatingipsBasePathV1), fipID, floatingipsAssign)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -233,15 +259,17 @@ func (s *FloatingipsServiceOp) Assign(ctx context.Context, fipID string, reqBody
 
 // UnAssign a floating IP from an instance or a load balancer.
 func (s *FloatingipsServiceOp) UnAssign(ctx context.Context, fipID string) (*FloatingIP, *Response, error) {
+	ctx = withOperation(ctx, "Floatingips.UnAssign")
+
 	if resp, err := isValidUUID(fipID, "fipID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(floatingipsBasePathV1), fipID, floatingipsUnAssign)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, floatingipsBasePathV1), fipID, floatingipsUnAssign)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -259,11 +287,13 @@ func (s *FloatingipsServiceOp) UnAssign(ctx context.Context, fipID string) (*Flo
 
 // ListAvailable floating IPs.
 func (s *FloatingipsServiceOp) ListAvailable(ctx context.Context) ([]FloatingIP, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Floatingips.ListAvailable")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(availableFloatingipsPathV1)
+	path := s.client.addProjectRegionPath(ctx, availableFloatingipsPathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -281,11 +311,13 @@ func (s *FloatingipsServiceOp) ListAvailable(ctx context.Context) ([]FloatingIP,
 
 // MetadataList floating IP detailed metadata items.
 func (s *FloatingipsServiceOp) MetadataList(ctx context.Context, fipID string) ([]MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Floatingips.MetadataList")
+
 	if resp, err := isValidUUID(fipID, "fipID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -294,11 +326,13 @@ func (s *FloatingipsServiceOp) MetadataList(ctx context.Context, fipID string) (
 
 // MetadataCreate or update floating IP metadata.
 func (s *FloatingipsServiceOp) MetadataCreate(ctx context.Context, fipID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Floatingips.MetadataCreate")
+
 	if resp, err := isValidUUID(fipID, "fipID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -307,11 +341,13 @@ func (s *FloatingipsServiceOp) MetadataCreate(ctx context.Context, fipID string,
 
 // MetadataUpdate floating IP metadata.
 func (s *FloatingipsServiceOp) MetadataUpdate(ctx context.Context, fipID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Floatingips.MetadataUpdate")
+
 	if resp, err := isValidUUID(fipID, "fipID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -320,11 +356,13 @@ func (s *FloatingipsServiceOp) MetadataUpdate(ctx context.Context, fipID string,
 
 // MetadataDeleteItem a floating IP metadata item by key.
 func (s *FloatingipsServiceOp) MetadataDeleteItem(ctx context.Context, fipID string, opts *MetadataItemOptions) (*Response, error) {
+	ctx = withOperation(ctx, "Floatingips.MetadataDeleteItem")
+
 	if resp, err := isValidUUID(fipID, "fipID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -333,11 +371,13 @@ func (s *FloatingipsServiceOp) MetadataDeleteItem(ctx context.Context, fipID str
 
 // MetadataGetItem floating IP detailed metadata.
 func (s *FloatingipsServiceOp) MetadataGetItem(ctx context.Context, fipID string, opts *MetadataItemOptions) (*MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Floatingips.MetadataGetItem")
+
 	if resp, err := isValidUUID(fipID, "fipID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
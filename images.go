@@ -60,8 +60,8 @@ type Image struct {
 	MinRAM           int                `json:"min_ram"`
 	MinDisk          int                `json:"min_disk"`
 	OSVersion        string             `json:"os_version"`
-	CreatedAt        string             `json:"created_at"`
-	UpdatedAt        string             `json:"updated_at"`
+	CreatedAt        Timestamp          `json:"created_at"`
+	UpdatedAt        Timestamp          `json:"updated_at"`
 	TaskID           string             `json:"task_id"`
 	ProjectID        int                `json:"project_id"`
 	RegionID         int                `json:"region_id"`
@@ -162,11 +162,13 @@ type imagesRoot struct {
 
 // List get images.
 func (s *ImagesServiceOp) List(ctx context.Context, opts *ImageListOptions) ([]Image, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Images.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(imagesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, imagesBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -188,15 +190,21 @@ func (s *ImagesServiceOp) List(ctx context.Context, opts *ImageListOptions) ([]I
 
 // Create an Image.
 func (s *ImagesServiceOp) Create(ctx context.Context, reqBody *ImageCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Images.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(imagesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, imagesBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -214,15 +222,17 @@ func (s *ImagesServiceOp) Create(ctx context.Context, reqBody *ImageCreateReques
 
 // Get an image.
 func (s *ImagesServiceOp) Get(ctx context.Context, imageID string) (*Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.Get")
+
 	if resp, err := isValidUUID(imageID, "imageID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(imagesBasePathV1), imageID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, imagesBasePathV1), imageID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -240,15 +250,17 @@ func (s *ImagesServiceOp) Get(ctx context.Context, imageID string) (*Image, *Res
 
 // Delete an image.
 func (s *ImagesServiceOp) Delete(ctx context.Context, imageID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Images.Delete")
+
 	if resp, err := isValidUUID(imageID, "imageID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(imagesBasePathV1), imageID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, imagesBasePathV1), imageID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -266,15 +278,17 @@ func (s *ImagesServiceOp) Delete(ctx context.Context, imageID string) (*TaskResp
 
 // Update image fields.
 func (s *ImagesServiceOp) Update(ctx context.Context, imageID string, reqBody *ImageUpdateRequest) (*Image, *Response, error) {
+	ctx = withOperation(ctx, "Images.Update")
+
 	if resp, err := isValidUUID(imageID, "imageID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(imagesBasePathV1), imageID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, imagesBasePathV1), imageID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -292,11 +306,13 @@ func (s *ImagesServiceOp) Update(ctx context.Context, imageID string, reqBody *I
 
 // Upload an Image.
 func (s *ImagesServiceOp) Upload(ctx context.Context, reqBody *ImageUploadRequest) (*TaskResponse, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Images.Upload")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(downloadimageBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, downloadimageBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -314,11 +330,13 @@ func (s *ImagesServiceOp) Upload(ctx context.Context, reqBody *ImageUploadReques
 
 // ImagesBaremetalList get images of baremetal instances.
 func (s *ImagesServiceOp) ImagesBaremetalList(ctx context.Context, opts *ImageListOptions) ([]Image, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Images.ImagesBaremetalList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(bmimagesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, bmimagesBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -340,15 +358,21 @@ func (s *ImagesServiceOp) ImagesBaremetalList(ctx context.Context, opts *ImageLi
 
 // ImagesBaremetalCreate an Image.
 func (s *ImagesServiceOp) ImagesBaremetalCreate(ctx context.Context, reqBody *ImageCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Images.ImagesBaremetalCreate")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(bmimagesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, bmimagesBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -366,11 +390,13 @@ func (s *ImagesServiceOp) ImagesBaremetalCreate(ctx context.Context, reqBody *Im
 
 // ImagesProjectList get images owned by a project.
 func (s *ImagesServiceOp) ImagesProjectList(ctx context.Context) ([]Image, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Images.ImagesProjectList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(projectimagesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, projectimagesBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -388,11 +414,13 @@ func (s *ImagesServiceOp) ImagesProjectList(ctx context.Context) ([]Image, *Resp
 
 // MetadataList security group detailed metadata items.
 func (s *ImagesServiceOp) MetadataList(ctx context.Context, imageID string) ([]MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Images.MetadataList")
+
 	if resp, err := isValidUUID(imageID, "imageID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -401,11 +429,13 @@ func (s *ImagesServiceOp) MetadataList(ctx context.Context, imageID string) ([]M
 
 // MetadataCreate or update security group metadata.
 func (s *ImagesServiceOp) MetadataCreate(ctx context.Context, imageID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Images.MetadataCreate")
+
 	if resp, err := isValidUUID(imageID, "imageID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -414,11 +444,13 @@ func (s *ImagesServiceOp) MetadataCreate(ctx context.Context, imageID string, re
 
 // MetadataUpdate security group metadata.
 func (s *ImagesServiceOp) MetadataUpdate(ctx context.Context, imageID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Images.MetadataUpdate")
+
 	if resp, err := isValidUUID(imageID, "imageID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -427,11 +459,13 @@ func (s *ImagesServiceOp) MetadataUpdate(ctx context.Context, imageID string, re
 
 // MetadataDeleteItem a security group metadata item by key.
 func (s *ImagesServiceOp) MetadataDeleteItem(ctx context.Context, imageID string, opts *MetadataItemOptions) (*Response, error) {
+	ctx = withOperation(ctx, "Images.MetadataDeleteItem")
+
 	if resp, err := isValidUUID(imageID, "imageID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -440,11 +474,13 @@ func (s *ImagesServiceOp) MetadataDeleteItem(ctx context.Context, imageID string
 
 // MetadataGetItem security group detailed metadata.
 func (s *ImagesServiceOp) MetadataGetItem(ctx context.Context, imageID string, opts *MetadataItemOptions) (*MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Images.MetadataGetItem")
+
 	if resp, err := isValidUUID(imageID, "imageID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -56,7 +56,7 @@ func TestImages_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &ImageCreateRequest{Name: "test-image"}
+	request := &ImageCreateRequest{Name: "test-image", VolumeID: testResourceID}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(imagesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
@@ -84,7 +84,7 @@ func TestImages_Create_ResponseError(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &ImageCreateRequest{}
+	request := &ImageCreateRequest{Name: "test-image", VolumeID: testResourceID}
 	URL := path.Join(imagesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
 	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
@@ -322,7 +322,7 @@ func TestImages_ImagesBaremetalCreate(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &ImageCreateRequest{Name: "test-image"}
+	request := &ImageCreateRequest{Name: "test-image", VolumeID: testResourceID}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(bmimagesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
@@ -350,7 +350,7 @@ func TestImages_ImagesBaremetalCreate_ResponseError(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &ImageCreateRequest{}
+	request := &ImageCreateRequest{Name: "test-image", VolumeID: testResourceID}
 	URL := path.Join(bmimagesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
 	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
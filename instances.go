@@ -111,7 +111,7 @@ type Instance struct {
 	ID               string                       `json:"instance_id"`
 	Name             string                       `json:"instance_name"`
 	Addresses        map[string][]InstanceAddress `json:"addresses"`
-	CreatedAt        string                       `json:"instance_created,omitempty"`
+	CreatedAt        Timestamp                    `json:"instance_created"`
 	CreatorTaskID    string                       `json:"creator_task_id,omitempty"`
 	Description      string                       `json:"instance_description,omitempty"`
 	Flavor           *Flavor                      `json:"flavor"`
@@ -122,14 +122,111 @@ type Instance struct {
 	Region           string                       `json:"region"`
 	RegionID         int                          `json:"region_id"`
 	SecurityGroups   []Name                       `json:"security_groups"`
-	Status           string                       `json:"status,omitempty"` // todo: need to implement new status type
+	Status           InstanceStatus               `json:"status,omitempty"`
 	TaskID           string                       `json:"task_id"`
 	TaskState        string                       `json:"task_state,omitempty"`
-	VMState          string                       `json:"vm_state,omitempty"` // todo: need to implement new vm_state type
+	VMState          InstanceVMState              `json:"vm_state,omitempty"`
 	AvailabilityZone string                       `json:"availability_zone"`
 	Volumes          []InstanceVolume             `json:"volumes"`
 }
 
+// InstanceStatus is the status of an Instance.
+type InstanceStatus string
+
+const (
+	InstanceStatusActive           InstanceStatus = "ACTIVE"
+	InstanceStatusBuild            InstanceStatus = "BUILD"
+	InstanceStatusDeleted          InstanceStatus = "DELETED"
+	InstanceStatusDeleting         InstanceStatus = "DELETING"
+	InstanceStatusError            InstanceStatus = "ERROR"
+	InstanceStatusHardReboot       InstanceStatus = "HARD_REBOOT"
+	InstanceStatusMigrating        InstanceStatus = "MIGRATING"
+	InstanceStatusPassword         InstanceStatus = "PASSWORD"
+	InstanceStatusPaused           InstanceStatus = "PAUSED"
+	InstanceStatusReboot           InstanceStatus = "REBOOT"
+	InstanceStatusRebuild          InstanceStatus = "REBUILD"
+	InstanceStatusRescue           InstanceStatus = "RESCUE"
+	InstanceStatusResize           InstanceStatus = "RESIZE"
+	InstanceStatusRevertResize     InstanceStatus = "REVERT_RESIZE"
+	InstanceStatusShelved          InstanceStatus = "SHELVED"
+	InstanceStatusShelvedOffloaded InstanceStatus = "SHELVED_OFFLOADED"
+	InstanceStatusShutoff          InstanceStatus = "SHUTOFF"
+	InstanceStatusSoftDeleted      InstanceStatus = "SOFT_DELETED"
+	InstanceStatusSuspended        InstanceStatus = "SUSPENDED"
+	InstanceStatusUnknown          InstanceStatus = "UNKNOWN"
+	InstanceStatusVerifyResize     InstanceStatus = "VERIFY_RESIZE"
+)
+
+// IsError reports whether the instance is in error.
+func (s InstanceStatus) IsError() bool {
+	return s == InstanceStatusError
+}
+
+// IsTransitional reports whether the instance is changing, e.g. being built or rebooted.
+func (s InstanceStatus) IsTransitional() bool {
+	switch s {
+	case InstanceStatusBuild, InstanceStatusDeleting, InstanceStatusHardReboot, InstanceStatusMigrating,
+		InstanceStatusPassword, InstanceStatusReboot, InstanceStatusRebuild, InstanceStatusResize,
+		InstanceStatusRevertResize:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTerminal reports whether the instance keeps the status until it is acted on, including in error.
+// The UNKNOWN and the unexpected statuses are neither terminal nor transitional.
+func (s InstanceStatus) IsTerminal() bool {
+	switch s {
+	case InstanceStatusActive, InstanceStatusDeleted, InstanceStatusError, InstanceStatusPaused,
+		InstanceStatusRescue, InstanceStatusShelved, InstanceStatusShelvedOffloaded, InstanceStatusShutoff,
+		InstanceStatusSoftDeleted, InstanceStatusSuspended, InstanceStatusVerifyResize:
+		return true
+	default:
+		return false
+	}
+}
+
+// InstanceVMState is the state of the virtual machine of an Instance.
+type InstanceVMState string
+
+const (
+	InstanceVMStateActive           InstanceVMState = "active"
+	InstanceVMStateBuilding         InstanceVMState = "building"
+	InstanceVMStateDeleted          InstanceVMState = "deleted"
+	InstanceVMStateError            InstanceVMState = "error"
+	InstanceVMStatePaused           InstanceVMState = "paused"
+	InstanceVMStateRescued          InstanceVMState = "rescued"
+	InstanceVMStateResized          InstanceVMState = "resized"
+	InstanceVMStateShelved          InstanceVMState = "shelved"
+	InstanceVMStateShelvedOffloaded InstanceVMState = "shelved_offloaded"
+	InstanceVMStateSoftDeleted      InstanceVMState = "soft-delete"
+	InstanceVMStateStopped          InstanceVMState = "stopped"
+	InstanceVMStateSuspended        InstanceVMState = "suspended"
+)
+
+// IsError reports whether the virtual machine is in error.
+func (s InstanceVMState) IsError() bool {
+	return s == InstanceVMStateError
+}
+
+// IsTransitional reports whether the virtual machine is being built.
+func (s InstanceVMState) IsTransitional() bool {
+	return s == InstanceVMStateBuilding
+}
+
+// IsTerminal reports whether the virtual machine keeps the state until it is acted on, including in error.
+func (s InstanceVMState) IsTerminal() bool {
+	switch s {
+	case InstanceVMStateActive, InstanceVMStateDeleted, InstanceVMStateError, InstanceVMStatePaused,
+		InstanceVMStateRescued, InstanceVMStateResized, InstanceVMStateShelved, InstanceVMStateShelvedOffloaded,
+		InstanceVMStateSoftDeleted, InstanceVMStateStopped, InstanceVMStateSuspended:
+		return true
+	default:
+		return false
+	}
+}
+
 // InstanceVolume represent an instance volume struct.
 type InstanceVolume struct {
 	ID                  string `json:"id"`
@@ -160,10 +257,21 @@ const (
 	InterfaceTypeSubnet          InterfaceType = "subnet"
 )
 
+var ErrInvalidInterfaceType = errors.New("invalid InterfaceType value")
+
+// IsValid reports whether the interface type is one of the known values.
+func (t InterfaceType) IsValid() error {
+	switch t {
+	case InterfaceTypeAnySubnet, InterfaceTypeExternal, InterfaceTypeReservedFixedIP, InterfaceTypeSubnet:
+		return nil
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidInterfaceType, t)
+}
+
 type InstanceInterface struct {
 	Type           InterfaceType        `json:"type,omitempty" validate:"omitempty,enum"`
 	NetworkID      string               `json:"network_id,omitempty" validate:"rfe=Type:subnet;any_subnet,omitempty,uuid4"`
-	FloatingIP     *InterfaceFloatingIP `json:"floating_ip,omitempty" validate:"omitempty,dive"`
+	FloatingIP     *InterfaceFloatingIP `json:"floating_ip,omitempty" validate:"omitempty"`
 	PortID         string               `json:"port_id,omitempty" validate:"rfe=Type:reserved_fixed_ip,allowed_without_all=NetworkID SubnetID,omitempty,uuid4"`
 	SubnetID       string               `json:"subnet_id,omitempty" validate:"rfe=Type:subnet,omitempty,uuid4"`
 	SecurityGroups []ID                 `json:"security_groups"`
@@ -178,7 +286,7 @@ type InstanceVolumeCreate struct {
 	Name          string       `json:"name,omitempty" validate:"omitempty"`
 	AttachmentTag string       `json:"attachment_tag,omitempty" validate:"omitempty"`
 	ImageID       string       `json:"image_id,omitempty" validate:"rfe=Source:image,sfe=Source:snapshot;apptemplate;existing-volume;new-volume,allowed_without_all=SnapshotID VolumeID,omitempty,uuid4"`
-	VolumeID      string       `json:"volume_id,omitempty" validate:"rfe=Source:existing-volume,sfe=Source:image;shapshot;apptemplate;new-volume,allowed_without_all=ImageID SnapshotID,omitempty,uuid4"`
+	VolumeID      string       `json:"volume_id,omitempty" validate:"rfe=Source:existing-volume,sfe=Source:image;snapshot;apptemplate;new-volume,allowed_without_all=ImageID SnapshotID,omitempty,uuid4"`
 	SnapshotID    string       `json:"snapshot_id,omitempty" validate:"rfe=Source:snapshot,sfe=Source:image;existing-volume;new-volume;apptemplate,allowed_without_all=ImageID VolumeID,omitempty,uuid4"`
 	AppTemplateID string       `json:"apptemplate_id,omitempty" validate:"rfe=Source:apptemplate,sfe=Source:image;existing-volume;new-volume;snapshot,allowed_without_all=ImageID VolumeID,omitempty,uuid4"`
 	Metadata      Metadata     `json:"metadata,omitempty" validate:"omitempty,dive"`
@@ -194,7 +302,7 @@ type InstanceCreateRequest struct {
 	Username         string                 `json:"username,omitempty" validate:"omitempty,required_with=Password"`
 	Password         string                 `json:"password,omitempty" validate:"omitempty"`
 	Interfaces       []InstanceInterface    `json:"interfaces" required:"true" validate:"required,dive"`
-	SecurityGroups   []ID                   `json:"security_groups,omitempty" validate:"omitempty,dive,uuid4"`
+	SecurityGroups   []ID                   `json:"security_groups,omitempty" validate:"omitempty,dive"`
 	Metadata         Metadata               `json:"metadata,omitempty" validate:"omitempty,dive"`
 	Configuration    map[string]interface{} `json:"configuration,omitempty" validate:"omitempty,dive"`
 	ServerGroupID    string                 `json:"servergroup_id,omitempty" validate:"omitempty,uuid4"`
@@ -286,8 +394,8 @@ type InstancePort struct {
 
 // InstanceMetricsListRequest represents a request to get a Instance Metrics list.
 type InstanceMetricsListRequest struct {
-	TimeUnit     TimeUnit `json:"time_unit" required:"true" validate:"required,name"`
-	TimeInterval int      `json:"time_interval" required:"true" validate:"required,name"`
+	TimeUnit     TimeUnit `json:"time_unit" required:"true" validate:"required,enum"`
+	TimeInterval int      `json:"time_interval" required:"true" validate:"required"`
 }
 
 // InstanceMetrics represents an EdgecenterCloud Instance metrics.
@@ -298,7 +406,7 @@ type InstanceMetrics struct {
 	NetworkBpsIngress int           `json:"network_Bps_ingress"`
 	NetworkPpsEgress  int           `json:"network_pps_egress"`
 	NetworkBpsEgress  int           `json:"network_Bps_egress"`
-	Time              string        `json:"time"`
+	Time              Timestamp     `json:"time"`
 	MemoryUtil        int           `json:"memory_util"`
 }
 
@@ -372,11 +480,13 @@ type instanceRouterInterfaceRoot struct {
 
 // List get instances.
 func (s *InstancesServiceOp) List(ctx context.Context, opts *InstanceListOptions) ([]Instance, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Instances.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -393,20 +503,24 @@ func (s *InstancesServiceOp) List(ctx context.Context, opts *InstanceListOptions
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Instances, resp, err
 }
 
 // Get individual Instance.
 func (s *InstancesServiceOp) Get(ctx context.Context, instanceID string) (*Instance, *Response, error) {
+	ctx = withOperation(ctx, "Instances.Get")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -424,15 +538,21 @@ func (s *InstancesServiceOp) Get(ctx context.Context, instanceID string) (*Insta
 
 // Create an Instance.
 func (s *InstancesServiceOp) Create(ctx context.Context, reqBody *InstanceCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV2)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV2)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -450,15 +570,17 @@ func (s *InstancesServiceOp) Create(ctx context.Context, reqBody *InstanceCreate
 
 // Delete the Instance.
 func (s *InstancesServiceOp) Delete(ctx context.Context, instanceID string, opts *InstanceDeleteOptions) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.Delete")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -480,17 +602,19 @@ func (s *InstancesServiceOp) Delete(ctx context.Context, instanceID string, opts
 
 // Migrate the Instance to another availability zone.
 func (s *InstancesServiceOp) Migrate(ctx context.Context, instanceID string, reqBody *InstanceMigrateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.Migrate")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	path := fmt.Sprintf(
 		"%s/%s/%s",
-		s.client.addProjectRegionPath(instancesBasePathV1),
+		s.client.addProjectRegionPath(ctx, instancesBasePathV1),
 		instanceID,
 		instancesLiveMigration,
 	)
@@ -511,15 +635,17 @@ func (s *InstancesServiceOp) Migrate(ctx context.Context, instanceID string, req
 
 // MetadataGet instance detailed metadata (tags).
 func (s *InstancesServiceOp) MetadataGet(ctx context.Context, instanceID string) (*MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Instances.MetadataGet")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, instanceID, metadataPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -538,11 +664,13 @@ func (s *InstancesServiceOp) MetadataGet(ctx context.Context, instanceID string)
 
 // MetadataList instance detailed metadata items.
 func (s *InstancesServiceOp) MetadataList(ctx context.Context, instanceID string) ([]MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Instances.MetadataList")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -551,11 +679,13 @@ func (s *InstancesServiceOp) MetadataList(ctx context.Context, instanceID string
 
 // MetadataUpdate instance metadata.
 func (s *InstancesServiceOp) MetadataUpdate(ctx context.Context, instanceID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Instances.MetadataUpdate")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -564,11 +694,13 @@ func (s *InstancesServiceOp) MetadataUpdate(ctx context.Context, instanceID stri
 
 // MetadataDeleteItem a load balancer metadata item by key.
 func (s *InstancesServiceOp) MetadataDeleteItem(ctx context.Context, instanceID string, opts *MetadataItemOptions) (*Response, error) {
+	ctx = withOperation(ctx, "Instances.MetadataDeleteItem")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -577,11 +709,13 @@ func (s *InstancesServiceOp) MetadataDeleteItem(ctx context.Context, instanceID
 
 // MetadataGetItem load balancer detailed metadata.
 func (s *InstancesServiceOp) MetadataGetItem(ctx context.Context, instanceID string, opts *MetadataItemOptions) (*MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Instances.MetadataGetItem")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -590,15 +724,17 @@ func (s *InstancesServiceOp) MetadataGetItem(ctx context.Context, instanceID str
 
 // MetadataCreate instance metadata (tags).
 func (s *InstancesServiceOp) MetadataCreate(ctx context.Context, instanceID string, metadata *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Instances.MetadataCreate")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, instanceID, metadataPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, metadata)
@@ -611,11 +747,13 @@ func (s *InstancesServiceOp) MetadataCreate(ctx context.Context, instanceID stri
 
 // CheckLimits check a quota for instance creation.
 func (s *InstancesServiceOp) CheckLimits(ctx context.Context, reqBody *InstanceCheckLimitsRequest) (*map[string]int, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Instances.CheckLimits")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV2)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV2)
 	path = fmt.Sprintf("%s/%s", path, instancesCheckLimits)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -634,19 +772,25 @@ func (s *InstancesServiceOp) CheckLimits(ctx context.Context, reqBody *InstanceC
 
 // UpdateFlavor changes the flavor of the server instance.
 func (s *InstancesServiceOp) UpdateFlavor(ctx context.Context, instanceID string, reqBody *InstanceFlavorUpdateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.UpdateFlavor")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, instanceID, instancesChangeFlavor)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -665,15 +809,17 @@ func (s *InstancesServiceOp) UpdateFlavor(ctx context.Context, instanceID string
 
 // AvailableFlavors get flavors for an instance by volume config.
 func (s *InstancesServiceOp) AvailableFlavors(ctx context.Context, reqBody *InstanceCheckFlavorVolumeRequest, opts *FlavorsOptions) ([]Flavor, *Response, error) {
+	ctx = withOperation(ctx, "Instances.AvailableFlavors")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path, err := addOptions(s.client.addProjectRegionPath(instancesBasePathV1), opts)
+	path, err := addOptions(s.client.addProjectRegionPath(ctx, instancesBasePathV1), opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -696,15 +842,17 @@ func (s *InstancesServiceOp) AvailableFlavors(ctx context.Context, reqBody *Inst
 
 // AvailableFlavorsToResize Get flavors to resize into.
 func (s *InstancesServiceOp) AvailableFlavorsToResize(ctx context.Context, instanceID string, opts *FlavorsOptions) ([]Flavor, *Response, error) {
+	ctx = withOperation(ctx, "Instances.AvailableFlavorsToResize")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesAvailableFlavors)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesAvailableFlavors)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -726,11 +874,13 @@ func (s *InstancesServiceOp) AvailableFlavorsToResize(ctx context.Context, insta
 
 // AvailableNames get instance naming restrictions that are applied to specified project and region.
 func (s *InstancesServiceOp) AvailableNames(ctx context.Context) (*InstanceAvailableNames, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Instances.AvailableNames")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instancesAvailableNames)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instancesAvailableNames)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -748,6 +898,8 @@ func (s *InstancesServiceOp) AvailableNames(ctx context.Context) (*InstanceAvail
 
 // Rename the Instance.
 func (s *InstancesServiceOp) Rename(ctx context.Context, instanceID string, reqBody *Name) (*Instance, *Response, error) {
+	ctx = withOperation(ctx, "Instances.Rename")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
@@ -756,11 +908,11 @@ func (s *InstancesServiceOp) Rename(ctx context.Context, instanceID string, reqB
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -778,15 +930,17 @@ func (s *InstancesServiceOp) Rename(ctx context.Context, instanceID string, reqB
 
 // PortsList get network ports.
 func (s *InstancesServiceOp) PortsList(ctx context.Context, instanceID string) ([]InstancePort, *Response, error) {
+	ctx = withOperation(ctx, "Instances.PortsList")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesPorts)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesPorts)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -804,15 +958,17 @@ func (s *InstancesServiceOp) PortsList(ctx context.Context, instanceID string) (
 
 // InstanceStart start the instance.
 func (s *InstancesServiceOp) InstanceStart(ctx context.Context, instanceID string) (*Instance, *Response, error) {
+	ctx = withOperation(ctx, "Instances.InstanceStart")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesStart)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesStart)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -830,15 +986,17 @@ func (s *InstancesServiceOp) InstanceStart(ctx context.Context, instanceID strin
 
 // InstanceStop stop the instance.
 func (s *InstancesServiceOp) InstanceStop(ctx context.Context, instanceID string) (*Instance, *Response, error) {
+	ctx = withOperation(ctx, "Instances.InstanceStop")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesStop)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesStop)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -856,15 +1014,17 @@ func (s *InstancesServiceOp) InstanceStop(ctx context.Context, instanceID string
 
 // InstancePowercycle powercycle the instance.
 func (s *InstancesServiceOp) InstancePowercycle(ctx context.Context, instanceID string) (*Instance, *Response, error) {
+	ctx = withOperation(ctx, "Instances.InstancePowercycle")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesPowercycle)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesPowercycle)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -882,15 +1042,17 @@ func (s *InstancesServiceOp) InstancePowercycle(ctx context.Context, instanceID
 
 // InstanceReboot reboot the instance.
 func (s *InstancesServiceOp) InstanceReboot(ctx context.Context, instanceID string) (*Instance, *Response, error) {
+	ctx = withOperation(ctx, "Instances.InstanceReboot")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesReboot)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesReboot)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -908,15 +1070,17 @@ func (s *InstancesServiceOp) InstanceReboot(ctx context.Context, instanceID stri
 
 // InstanceSuspend suspend the instance.
 func (s *InstancesServiceOp) InstanceSuspend(ctx context.Context, instanceID string) (*Instance, *Response, error) {
+	ctx = withOperation(ctx, "Instances.InstanceSuspend")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesSuspend)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesSuspend)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -934,15 +1098,17 @@ func (s *InstancesServiceOp) InstanceSuspend(ctx context.Context, instanceID str
 
 // InstanceResume resume the instance.
 func (s *InstancesServiceOp) InstanceResume(ctx context.Context, instanceID string) (*Instance, *Response, error) {
+	ctx = withOperation(ctx, "Instances.InstanceResume")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesResume)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesResume)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -960,15 +1126,17 @@ func (s *InstancesServiceOp) InstanceResume(ctx context.Context, instanceID stri
 
 // MetricsList get instance metrics.
 func (s *InstancesServiceOp) MetricsList(ctx context.Context, instanceID string, reqBody *InstanceMetricsListRequest) ([]InstanceMetrics, *Response, error) {
+	ctx = withOperation(ctx, "Instances.MetricsList")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesMetrics)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesMetrics)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, reqBody)
 	if err != nil {
@@ -986,15 +1154,17 @@ func (s *InstancesServiceOp) MetricsList(ctx context.Context, instanceID string,
 
 // FilterBySecurityGroup returns a list of instances with the filter by the security group.
 func (s *InstancesServiceOp) FilterBySecurityGroup(ctx context.Context, securityGroupID string) ([]Instance, *Response, error) {
+	ctx = withOperation(ctx, "Instances.FilterBySecurityGroup")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(securitygroupsBasePathV1), securityGroupID, instancesInstances)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, securitygroupsBasePathV1), securityGroupID, instancesInstances)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -1012,15 +1182,17 @@ func (s *InstancesServiceOp) FilterBySecurityGroup(ctx context.Context, security
 
 // SecurityGroupList returns a list of instance security groups.
 func (s *InstancesServiceOp) SecurityGroupList(ctx context.Context, instanceID string) ([]IDName, *Response, error) {
+	ctx = withOperation(ctx, "Instances.SecurityGroupList")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesSecurityGroups)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesSecurityGroups)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -1038,6 +1210,8 @@ func (s *InstancesServiceOp) SecurityGroupList(ctx context.Context, instanceID s
 
 // SecurityGroupAssign the security group to the server.
 func (s *InstancesServiceOp) SecurityGroupAssign(ctx context.Context, instanceID string, reqBody *AssignSecurityGroupRequest) (*Response, error) {
+	ctx = withOperation(ctx, "Instances.SecurityGroupAssign")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return resp, err
 	}
@@ -1046,11 +1220,11 @@ func (s *InstancesServiceOp) SecurityGroupAssign(ctx context.Context, instanceID
 		return nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesAddSecurityGroup)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesAddSecurityGroup)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -1062,6 +1236,8 @@ func (s *InstancesServiceOp) SecurityGroupAssign(ctx context.Context, instanceID
 
 // SecurityGroupUnAssign the security group to the server.
 func (s *InstancesServiceOp) SecurityGroupUnAssign(ctx context.Context, instanceID string, reqBody *AssignSecurityGroupRequest) (*Response, error) {
+	ctx = withOperation(ctx, "Instances.SecurityGroupUnAssign")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return resp, err
 	}
@@ -1070,11 +1246,11 @@ func (s *InstancesServiceOp) SecurityGroupUnAssign(ctx context.Context, instance
 		return nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesDelSecurityGroup)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesDelSecurityGroup)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -1086,15 +1262,17 @@ func (s *InstancesServiceOp) SecurityGroupUnAssign(ctx context.Context, instance
 
 // GetConsole get an Instance console URL.
 func (s *InstancesServiceOp) GetConsole(ctx context.Context, instanceID string) (*RemoteConsole, *Response, error) {
+	ctx = withOperation(ctx, "Instances.GetConsole")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesGetConsole)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesGetConsole)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -1112,6 +1290,8 @@ func (s *InstancesServiceOp) GetConsole(ctx context.Context, instanceID string)
 
 // AttachInterface to the instance.
 func (s *InstancesServiceOp) AttachInterface(ctx context.Context, instanceID string, reqBody *InstanceAttachInterfaceRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.AttachInterface")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
@@ -1120,11 +1300,11 @@ func (s *InstancesServiceOp) AttachInterface(ctx context.Context, instanceID str
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, instanceID, instancesAttachInterface)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -1143,6 +1323,8 @@ func (s *InstancesServiceOp) AttachInterface(ctx context.Context, instanceID str
 
 // DetachInterface from the instance.
 func (s *InstancesServiceOp) DetachInterface(ctx context.Context, instanceID string, reqBody *InstanceDetachInterfaceRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.DetachInterface")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
@@ -1151,11 +1333,11 @@ func (s *InstancesServiceOp) DetachInterface(ctx context.Context, instanceID str
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, instanceID, instancesDetachInterface)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -1174,15 +1356,17 @@ func (s *InstancesServiceOp) DetachInterface(ctx context.Context, instanceID str
 
 // InterfaceList returns a list of network interfaces attached to the Instance.
 func (s *InstancesServiceOp) InterfaceList(ctx context.Context, instanceID string) ([]InstancePortInterface, *Response, error) {
+	ctx = withOperation(ctx, "Instances.InterfaceList")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(instancesBasePathV1), instanceID, instancesInterfaces)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, instancesBasePathV1), instanceID, instancesInterfaces)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -1200,6 +1384,8 @@ func (s *InstancesServiceOp) InterfaceList(ctx context.Context, instanceID strin
 
 // PutIntoServerGroup put an instance into server group.
 func (s *InstancesServiceOp) PutIntoServerGroup(ctx context.Context, instanceID string, reqBody *InstancePutIntoServerGroupRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.PutIntoServerGroup")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
@@ -1208,11 +1394,11 @@ func (s *InstancesServiceOp) PutIntoServerGroup(ctx context.Context, instanceID
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, instanceID, instancesPutIntoServerGroup)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -1231,15 +1417,17 @@ func (s *InstancesServiceOp) PutIntoServerGroup(ctx context.Context, instanceID
 
 // RemoveFromServerGroup remove an instance from server group.
 func (s *InstancesServiceOp) RemoveFromServerGroup(ctx context.Context, instanceID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Instances.RemoveFromServerGroup")
+
 	if resp, err := isValidUUID(instanceID, "instanceID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(instancesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, instancesBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, instanceID, instancesRemoveFromServerGroup)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
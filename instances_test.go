@@ -157,7 +157,12 @@ func TestInstances_Create_ResponseError(t *testing.T) {
 		_, _ = fmt.Fprint(w, "Bad request")
 	})
 
-	request := &InstanceCreateRequest{}
+	request := &InstanceCreateRequest{
+		Names:      []string{"test-instance"},
+		Flavor:     "g1-standard-1-2",
+		Interfaces: []InstanceInterface{{Type: InterfaceTypeExternal}},
+		Volumes:    []InstanceVolumeCreate{{TypeName: VolumeTypeStandard, Size: 5, Source: VolumeSourceNewVolume}},
+	}
 	respActual, resp, err := client.Instances.Create(ctx, request)
 	assert.Nil(t, respActual)
 	assert.Error(t, err)
@@ -444,7 +449,7 @@ func TestInstances_UpdateFlavor_ResponseError(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &InstanceFlavorUpdateRequest{}
+	request := &InstanceFlavorUpdateRequest{FlavorID: "g1-standard-1-2"}
 
 	URL := path.Join(instancesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID), testResourceID, instancesChangeFlavor)
 	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
@@ -1453,3 +1458,16 @@ func TestInstances_Migrate_ResponseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, resp.StatusCode, 400)
 }
+
+func TestInstanceStatus(t *testing.T) {
+	assert.True(t, InstanceStatusBuild.IsTransitional())
+	assert.False(t, InstanceStatusBuild.IsTerminal())
+	assert.True(t, InstanceStatusShutoff.IsTerminal())
+	assert.False(t, InstanceStatusShutoff.IsError())
+	assert.True(t, InstanceStatusError.IsError())
+	assert.True(t, InstanceStatusError.IsTerminal())
+	assert.False(t, InstanceStatusUnknown.IsTerminal())
+	assert.False(t, InstanceStatusUnknown.IsTransitional())
+	assert.True(t, InstanceVMStateBuilding.IsTransitional())
+	assert.True(t, InstanceVMStateStopped.IsTerminal())
+}
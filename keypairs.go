@@ -38,28 +38,28 @@ var _ KeyPairsService = &KeyPairsServiceOp{}
 
 // KeyPair represents an EdgecenterCloud Key Pair.
 type KeyPair struct {
-	SSHKeyID        string `json:"sshkey_id"`
-	PublicKey       string `json:"public_key"`
-	PrivateKey      string `json:"private_key"`
-	Fingerprint     string `json:"fingerprint"`
-	SSHKeyName      string `json:"sshkey_name"`
-	State           string `json:"state"`
-	SharedInProject bool   `json:"shared_in_project"`
-	CreatedAt       string `json:"created_at"`
-	ProjectID       int    `json:"project_id"`
+	SSHKeyID        string    `json:"sshkey_id"`
+	PublicKey       string    `json:"public_key"`
+	PrivateKey      string    `json:"private_key"`
+	Fingerprint     string    `json:"fingerprint"`
+	SSHKeyName      string    `json:"sshkey_name"`
+	State           string    `json:"state"`
+	SharedInProject bool      `json:"shared_in_project"`
+	CreatedAt       Timestamp `json:"created_at"`
+	ProjectID       int       `json:"project_id"`
 }
 
 // KeyPairV2 represents an EdgecenterCloud Key Pair.
 type KeyPairV2 struct {
-	PublicKey       string `json:"public_key"`
-	Fingerprint     string `json:"fingerprint"`
-	CreatedAt       string `json:"created_at"`
-	State           string `json:"state"`
-	ProjectID       int    `json:"project_id"`
-	SSHKeyID        string `json:"sshkey_id"`
-	SharedInProject bool   `json:"shared_in_project"`
-	PrivateKey      string `json:"private_key"`
-	SSHKeyName      string `json:"sshkey_name"`
+	PublicKey       string    `json:"public_key"`
+	Fingerprint     string    `json:"fingerprint"`
+	CreatedAt       Timestamp `json:"created_at"`
+	State           string    `json:"state"`
+	ProjectID       int       `json:"project_id"`
+	SSHKeyID        string    `json:"sshkey_id"`
+	SharedInProject bool      `json:"shared_in_project"`
+	PrivateKey      string    `json:"private_key"`
+	SSHKeyName      string    `json:"sshkey_name"`
 }
 
 // KeyPairCreateRequest represents a request to create a Key Pair.
@@ -102,11 +102,13 @@ type KeyPairsListOptionsV2 struct {
 
 // List get KeyPairs.
 func (s *KeyPairsServiceOp) List(ctx context.Context) ([]KeyPair, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "KeyPairs.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(keypairsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, keypairsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -125,6 +127,8 @@ func (s *KeyPairsServiceOp) List(ctx context.Context) ([]KeyPair, *Response, err
 
 // ListV2 get KeyPairs.
 func (s *KeyPairsServiceOp) ListV2(ctx context.Context, opts *KeyPairsListOptionsV2) ([]KeyPairV2, *Response, error) {
+	ctx = withOperation(ctx, "KeyPairs.ListV2")
+
 	path, err := addOptions(keypairsBasePathV2, opts)
 	if err != nil {
 		return nil, nil, err
@@ -147,15 +151,17 @@ func (s *KeyPairsServiceOp) ListV2(ctx context.Context, opts *KeyPairsListOption
 
 // Get individual Key Pair.
 func (s *KeyPairsServiceOp) Get(ctx context.Context, keypairID string) (*KeyPair, *Response, error) {
+	ctx = withOperation(ctx, "KeyPairs.Get")
+
 	if resp, err := isValidUUID(keypairID, "keypairID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(keypairsBasePathV1), keypairID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, keypairsBasePathV1), keypairID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -173,11 +179,13 @@ func (s *KeyPairsServiceOp) Get(ctx context.Context, keypairID string) (*KeyPair
 
 // GetV2 individual Key Pair.
 func (s *KeyPairsServiceOp) GetV2(ctx context.Context, keypairID string) (*KeyPairV2, *Response, error) {
+	ctx = withOperation(ctx, "KeyPairs.GetV2")
+
 	if resp, err := isValidUUID(keypairID, "keypairID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -200,15 +208,21 @@ func (s *KeyPairsServiceOp) GetV2(ctx context.Context, keypairID string) (*KeyPa
 
 // Create a Key Pair.
 func (s *KeyPairsServiceOp) Create(ctx context.Context, reqBody *KeyPairCreateRequest) (*KeyPair, *Response, error) {
+	ctx = withOperation(ctx, "KeyPairs.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(keypairsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, keypairsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -225,11 +239,17 @@ func (s *KeyPairsServiceOp) Create(ctx context.Context, reqBody *KeyPairCreateRe
 }
 
 func (s *KeyPairsServiceOp) CreateV2(ctx context.Context, reqBody *KeyPairCreateRequestV2) (*KeyPairV2, *Response, error) {
+	ctx = withOperation(ctx, "KeyPairs.CreateV2")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -250,15 +270,17 @@ func (s *KeyPairsServiceOp) CreateV2(ctx context.Context, reqBody *KeyPairCreate
 
 // Delete the Key Pair.
 func (s *KeyPairsServiceOp) Delete(ctx context.Context, keypairID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "KeyPairs.Delete")
+
 	if resp, err := isValidUUID(keypairID, "keypairID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(keypairsBasePathV1), keypairID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, keypairsBasePathV1), keypairID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -276,11 +298,13 @@ func (s *KeyPairsServiceOp) Delete(ctx context.Context, keypairID string) (*Task
 
 // DeleteV2 the Key Pair.
 func (s *KeyPairsServiceOp) DeleteV2(ctx context.Context, keypairID string) (*Response, error) {
+	ctx = withOperation(ctx, "KeyPairs.DeleteV2")
+
 	if resp, err := isValidUUID(keypairID, "keypairID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -296,6 +320,8 @@ func (s *KeyPairsServiceOp) DeleteV2(ctx context.Context, keypairID string) (*Re
 
 // Share a Key Pair to view for all users in project.
 func (s *KeyPairsServiceOp) Share(ctx context.Context, keypairID string, reqBody *KeyPairShareRequest) (*KeyPair, *Response, error) {
+	ctx = withOperation(ctx, "KeyPairs.Share")
+
 	if resp, err := isValidUUID(keypairID, "keypairID"); err != nil {
 		return nil, resp, err
 	}
@@ -304,11 +330,11 @@ func (s *KeyPairsServiceOp) Share(ctx context.Context, keypairID string, reqBody
 		return nil, nil, NewArgError("shareRequest", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(keypairsBasePathV1), keypairID, keypairsShare)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, keypairsBasePathV1), keypairID, keypairsShare)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
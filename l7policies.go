@@ -46,8 +46,8 @@ type L7Policy struct {
 	RedirectPrefix     *string        `json:"redirect_prefix"`
 	Action             L7PolicyAction `json:"action"`
 	Rules              []L7Rule       `json:"rules"`
-	CreatedAt          string         `json:"created_at"`
-	UpdatedAt          string         `json:"updated_at,omitempty"`
+	CreatedAt          Timestamp      `json:"created_at"`
+	UpdatedAt          *Timestamp     `json:"updated_at,omitempty"`
 }
 
 type L7PolicyAction string
@@ -90,11 +90,13 @@ type l7policiesRoot struct {
 
 // List get L7policies.
 func (s *L7PoliciesServiceOp) List(ctx context.Context) ([]L7Policy, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "L7Policies.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(l7policiesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, l7policiesBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -112,15 +114,21 @@ func (s *L7PoliciesServiceOp) List(ctx context.Context) ([]L7Policy, *Response,
 
 // Create a L7Policy.
 func (s *L7PoliciesServiceOp) Create(ctx context.Context, reqBody *L7PolicyCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "L7Policies.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(l7policiesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, l7policiesBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -138,15 +146,17 @@ func (s *L7PoliciesServiceOp) Create(ctx context.Context, reqBody *L7PolicyCreat
 
 // Delete a L7Policy.
 func (s *L7PoliciesServiceOp) Delete(ctx context.Context, l7PolicyID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "L7Policies.Delete")
+
 	if resp, err := isValidUUID(l7PolicyID, "l7PolicyID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(l7policiesBasePathV1), l7PolicyID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, l7policiesBasePathV1), l7PolicyID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -164,15 +174,17 @@ func (s *L7PoliciesServiceOp) Delete(ctx context.Context, l7PolicyID string) (*T
 
 // Get a L7Policy.
 func (s *L7PoliciesServiceOp) Get(ctx context.Context, l7PolicyID string) (*L7Policy, *Response, error) {
+	ctx = withOperation(ctx, "L7Policies.Get")
+
 	if resp, err := isValidUUID(l7PolicyID, "l7PolicyID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(l7policiesBasePathV1), l7PolicyID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, l7policiesBasePathV1), l7PolicyID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -190,6 +202,8 @@ func (s *L7PoliciesServiceOp) Get(ctx context.Context, l7PolicyID string) (*L7Po
 
 // Update replace L7Policy properties.
 func (s *L7PoliciesServiceOp) Update(ctx context.Context, l7PolicyID string, reqBody *L7PolicyUpdateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "L7Policies.Update")
+
 	if resp, err := isValidUUID(l7PolicyID, "l7PolicyID"); err != nil {
 		return nil, resp, err
 	}
@@ -198,11 +212,15 @@ func (s *L7PoliciesServiceOp) Update(ctx context.Context, l7PolicyID string, req
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(l7policiesBasePathV1), l7PolicyID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, l7policiesBasePathV1), l7PolicyID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody)
 	if err != nil {
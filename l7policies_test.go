@@ -38,7 +38,7 @@ func TestL7Policies_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &L7PolicyCreateRequest{Name: "test-l7policy"}
+	request := &L7PolicyCreateRequest{Name: "test-l7policy", ListenerID: testResourceID, Action: L7PolicyActionReject}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(l7policiesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
@@ -110,7 +110,7 @@ func TestL7Policies_Update(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &L7PolicyUpdateRequest{}
+	request := &L7PolicyUpdateRequest{Action: L7PolicyActionReject}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(l7policiesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID), testResourceID)
 
@@ -93,15 +93,17 @@ type l7rulesRoot struct {
 
 // List get L7Rules.
 func (s *L7RulesServiceOp) List(ctx context.Context, l7PolicyID string) ([]L7Rule, *Response, error) {
+	ctx = withOperation(ctx, "L7Rules.List")
+
 	if resp, err := isValidUUID(l7PolicyID, "l7PolicyID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(l7policiesBasePathV1), l7PolicyID, l7rulesPath)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, l7policiesBasePathV1), l7PolicyID, l7rulesPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -119,6 +121,8 @@ func (s *L7RulesServiceOp) List(ctx context.Context, l7PolicyID string) ([]L7Rul
 
 // Create a L7Rule.
 func (s *L7RulesServiceOp) Create(ctx context.Context, l7PolicyID string, reqBody *L7RuleCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "L7Rules.Create")
+
 	if resp, err := isValidUUID(l7PolicyID, "l7PolicyID"); err != nil {
 		return nil, resp, err
 	}
@@ -127,11 +131,15 @@ func (s *L7RulesServiceOp) Create(ctx context.Context, l7PolicyID string, reqBod
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(l7policiesBasePathV1), l7PolicyID, l7rulesPath)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, l7policiesBasePathV1), l7PolicyID, l7rulesPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -149,6 +157,8 @@ func (s *L7RulesServiceOp) Create(ctx context.Context, l7PolicyID string, reqBod
 
 // Delete a L7Rule.
 func (s *L7RulesServiceOp) Delete(ctx context.Context, l7PolicyID string, l7RuleID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "L7Rules.Delete")
+
 	if resp, err := isValidUUID(l7PolicyID, "l7PolicyID"); err != nil {
 		return nil, resp, err
 	}
@@ -157,11 +167,11 @@ func (s *L7RulesServiceOp) Delete(ctx context.Context, l7PolicyID string, l7Rule
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s/%s", s.client.addProjectRegionPath(l7policiesBasePathV1), l7PolicyID, l7rulesPath, l7RuleID)
+	path := fmt.Sprintf("%s/%s/%s/%s", s.client.addProjectRegionPath(ctx, l7policiesBasePathV1), l7PolicyID, l7rulesPath, l7RuleID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -179,15 +189,17 @@ func (s *L7RulesServiceOp) Delete(ctx context.Context, l7PolicyID string, l7Rule
 
 // Get a L7Rule.
 func (s *L7RulesServiceOp) Get(ctx context.Context, l7PolicyID string, l7RuleID string) (*L7Rule, *Response, error) {
+	ctx = withOperation(ctx, "L7Rules.Get")
+
 	if resp, err := isValidUUID(l7PolicyID, "l7PolicyID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s/%s", s.client.addProjectRegionPath(l7policiesBasePathV1), l7PolicyID, l7rulesPath, l7RuleID)
+	path := fmt.Sprintf("%s/%s/%s/%s", s.client.addProjectRegionPath(ctx, l7policiesBasePathV1), l7PolicyID, l7rulesPath, l7RuleID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -205,6 +217,8 @@ func (s *L7RulesServiceOp) Get(ctx context.Context, l7PolicyID string, l7RuleID
 
 // Update replace L7Rule properties.
 func (s *L7RulesServiceOp) Update(ctx context.Context, l7PolicyID string, l7RuleID string, reqBody *L7RuleUpdateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "L7Rules.Update")
+
 	if resp, err := isValidUUID(l7PolicyID, "l7PolicyID"); err != nil {
 		return nil, resp, err
 	}
@@ -213,11 +227,15 @@ func (s *L7RulesServiceOp) Update(ctx context.Context, l7PolicyID string, l7Rule
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s/%s", s.client.addProjectRegionPath(l7policiesBasePathV1), l7PolicyID, l7rulesPath, l7RuleID)
+	path := fmt.Sprintf("%s/%s/%s/%s", s.client.addProjectRegionPath(ctx, l7policiesBasePathV1), l7PolicyID, l7rulesPath, l7RuleID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody)
 	if err != nil {
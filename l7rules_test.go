@@ -38,7 +38,7 @@ func TestL7Rules_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &L7RuleCreateRequest{Key: "test-l7rule"}
+	request := &L7RuleCreateRequest{Key: "test-l7rule", CompareType: L7RuleCompareTypeContains, Value: "test", Type: L7RuleTypeHeader}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(l7policiesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID), testResourceID, l7rulesPath)
 
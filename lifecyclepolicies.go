@@ -440,11 +440,13 @@ type LifeCyclePoliciesServiceOp struct {
 
 // List returns a list of lifecycle policies.
 func (s LifeCyclePoliciesServiceOp) List(ctx context.Context, listOpts *LifeCyclePolicyListOptions) ([]LifeCyclePolicy, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "LifeCyclePolicies.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1)
 	path, err := addOptions(path, listOpts)
 	if err != nil {
 		return nil, nil, err
@@ -474,11 +476,13 @@ func (s LifeCyclePoliciesServiceOp) List(ctx context.Context, listOpts *LifeCycl
 
 // Get returns a lifecycle policy with specified unique id.
 func (s LifeCyclePoliciesServiceOp) Get(ctx context.Context, lifecyclePolicyID int, getOpts *LifeCyclePolicyGetOptions) (*LifeCyclePolicy, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "LifeCyclePolicies.Get")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d", s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1), lifecyclePolicyID)
+	path := fmt.Sprintf("%s/%d", s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1), lifecyclePolicyID)
 	path, err := addOptions(path, getOpts)
 	if err != nil {
 		return nil, nil, err
@@ -505,14 +509,20 @@ func (s LifeCyclePoliciesServiceOp) Get(ctx context.Context, lifecyclePolicyID i
 
 // Create is create new lifecycle policy.
 func (s LifeCyclePoliciesServiceOp) Create(ctx context.Context, reqBody *LifeCyclePolicyCreateRequest) (*LifeCyclePolicy, *Response, error) {
+	ctx = withOperation(ctx, "LifeCyclePolicies.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
-	if resp, err := s.client.Validate(); err != nil {
+
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -536,14 +546,20 @@ func (s LifeCyclePoliciesServiceOp) Create(ctx context.Context, reqBody *LifeCyc
 // Update updates a lifecycle policy with specified unique id.
 // reqBody are used to construct request body.
 func (s LifeCyclePoliciesServiceOp) Update(ctx context.Context, lifeCyclePolicyID int, reqBody *LifeCyclePolicyUpdateRequest) (*LifeCyclePolicy, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "LifeCyclePolicies.Update")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
-	path := s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1)
+
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+	path := s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1)
 	path = fmt.Sprintf("%s/%d", path, lifeCyclePolicyID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
@@ -567,11 +583,13 @@ func (s LifeCyclePoliciesServiceOp) Update(ctx context.Context, lifeCyclePolicyI
 
 // Delete deletes a lifecycle policy with specified unique id.
 func (s LifeCyclePoliciesServiceOp) Delete(ctx context.Context, lifeCyclePolicyID int) (*Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "LifeCyclePolicies.Delete")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d", s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1), lifeCyclePolicyID)
+	path := fmt.Sprintf("%s/%d", s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1), lifeCyclePolicyID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -583,14 +601,16 @@ func (s LifeCyclePoliciesServiceOp) Delete(ctx context.Context, lifeCyclePolicyI
 
 // AddSchedules adds a schedules to lifecycle policy with specified unique id.
 func (s LifeCyclePoliciesServiceOp) AddSchedules(ctx context.Context, lifeCyclePolicyID int, reqBody *LifeCyclePolicyAddSchedulesRequest) (*LifeCyclePolicy, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "LifeCyclePolicies.AddSchedules")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
-	path := s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1)
 	path = fmt.Sprintf("%s/%d/%s", path, lifeCyclePolicyID, addSchedulesSubPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -614,14 +634,16 @@ func (s LifeCyclePoliciesServiceOp) AddSchedules(ctx context.Context, lifeCycleP
 
 // RemoveSchedules removes a schedules from lifecycle policy with specified unique id.
 func (s LifeCyclePoliciesServiceOp) RemoveSchedules(ctx context.Context, lifeCyclePolicyID int, reqBody *LifeCyclePolicyRemoveSchedulesRequest) (*LifeCyclePolicy, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "LifeCyclePolicies.RemoveSchedules")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
-	path := s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1)
 	path = fmt.Sprintf("%s/%d/%s", path, lifeCyclePolicyID, removeSchedulesSubPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -645,14 +667,16 @@ func (s LifeCyclePoliciesServiceOp) RemoveSchedules(ctx context.Context, lifeCyc
 
 // AddVolumes adds a volumes to lifecycle policy with specified unique id.
 func (s LifeCyclePoliciesServiceOp) AddVolumes(ctx context.Context, lifeCyclePolicyID int, reqBody *LifeCyclePolicyAddVolumesRequest) (*LifeCyclePolicy, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "LifeCyclePolicies.AddVolumes")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
-	path := s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1)
 	path = fmt.Sprintf("%s/%d/%s", path, lifeCyclePolicyID, addVolumesSubPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody)
@@ -676,14 +700,16 @@ func (s LifeCyclePoliciesServiceOp) AddVolumes(ctx context.Context, lifeCyclePol
 
 // RemoveVolumes removes a volumes from lifecycle policy with specified unique id.
 func (s LifeCyclePoliciesServiceOp) RemoveVolumes(ctx context.Context, lifeCyclePolicyID int, reqBody *LifeCyclePolicyRemoveVolumesRequest) (*LifeCyclePolicy, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "LifeCyclePolicies.RemoveVolumes")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
-	path := s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1)
 	path = fmt.Sprintf("%s/%d/%s", path, lifeCyclePolicyID, removeVolumesSubPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody)
@@ -707,23 +733,27 @@ func (s LifeCyclePoliciesServiceOp) RemoveVolumes(ctx context.Context, lifeCycle
 
 // EstimateCronMaxPolicyUsage estimates usage of resources and costs for CRON lifecycle policy.
 func (s LifeCyclePoliciesServiceOp) EstimateCronMaxPolicyUsage(ctx context.Context, reqBody *LifeCyclePolicyEstimateCronRequest) (*LifeCyclePolicyMaxPolicyUsage, *Response, error) {
+	ctx = withOperation(ctx, "LifeCyclePolicies.EstimateCronMaxPolicyUsage")
+
 	return s.estimateMaxPolicyUsage(ctx, reqBody)
 }
 
 // EstimateIntervalMaxPolicyUsage estimates usage of resources and costs for Interval lifecycle policy.
 func (s LifeCyclePoliciesServiceOp) EstimateIntervalMaxPolicyUsage(ctx context.Context, reqBody *LifeCyclePolicyEstimateIntervalRequest) (*LifeCyclePolicyMaxPolicyUsage, *Response, error) {
+	ctx = withOperation(ctx, "LifeCyclePolicies.EstimateIntervalMaxPolicyUsage")
+
 	return s.estimateMaxPolicyUsage(ctx, reqBody)
 }
 
 func (s LifeCyclePoliciesServiceOp) estimateMaxPolicyUsage(ctx context.Context, reqBody interface{}) (*LifeCyclePolicyMaxPolicyUsage, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
-	path := s.client.addProjectRegionPath(lifecyclePoliciesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lifecyclePoliciesBasePathV1)
 	path = fmt.Sprintf("%s/%s", path, estimateMaxPolicyUsageSubPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
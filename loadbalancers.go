@@ -3,6 +3,7 @@ package edgecloud
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -96,8 +97,8 @@ type Loadbalancer struct {
 	VipNetworkID       string             `json:"vip_network_id"`
 	ProvisioningStatus ProvisioningStatus `json:"provisioning_status"`
 	OperatingStatus    OperatingStatus    `json:"operating_status"`
-	CreatedAt          string             `json:"created_at"`
-	UpdatedAt          string             `json:"updated_at"`
+	CreatedAt          Timestamp          `json:"created_at"`
+	UpdatedAt          Timestamp          `json:"updated_at"`
 	CreatorTaskID      string             `json:"creator_task_id"`
 	TaskID             string             `json:"task_id"`
 	MetadataDetailed   []MetadataDetailed `json:"metadata,omitempty"`
@@ -158,8 +159,8 @@ type PoolMember struct {
 
 // LoadbalancerMetricsListRequest represents a request to get a Loadbalancer Metrics list.
 type LoadbalancerMetricsListRequest struct {
-	TimeInterval int      `json:"time_interval" required:"true" validate:"required,name"`
-	TimeUnit     TimeUnit `json:"time_unit" required:"true" validate:"required,name"`
+	TimeInterval int      `json:"time_interval" required:"true" validate:"required"`
+	TimeUnit     TimeUnit `json:"time_unit" required:"true" validate:"required,enum"`
 }
 
 type TimeUnit string
@@ -169,15 +170,26 @@ const (
 	TimeUnitDay  TimeUnit = "day"
 )
 
+var ErrInvalidTimeUnit = errors.New("invalid TimeUnit value")
+
+// IsValid reports whether the time unit is one of the known values.
+func (u TimeUnit) IsValid() error {
+	switch u {
+	case TimeUnitHour, TimeUnitDay:
+		return nil
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidTimeUnit, u)
+}
+
 // LoadbalancerMetrics represents an EdgecenterCloud Loadbalancer metrics.
 type LoadbalancerMetrics struct {
-	CPUUtil           int    `json:"cpu_util"`
-	Time              string `json:"time"`
-	NetworkBpsEgress  int    `json:"network_Bps_egress"`
-	NetworkBpsIngress int    `json:"network_Bps_ingress"`
-	NetworkPpsEgress  int    `json:"network_pps_egress"`
-	NetworkPpsIngress int    `json:"network_pps_ingress"`
-	MemoryUtil        int    `json:"memory_util"`
+	CPUUtil           int       `json:"cpu_util"`
+	Time              Timestamp `json:"time"`
+	NetworkBpsEgress  int       `json:"network_Bps_egress"`
+	NetworkBpsIngress int       `json:"network_Bps_ingress"`
+	NetworkPpsEgress  int       `json:"network_pps_egress"`
+	NetworkPpsIngress int       `json:"network_pps_ingress"`
+	MemoryUtil        int       `json:"memory_util"`
 }
 
 // LoadbalancerStats represents an EdgecenterCloud Loadbalancer statistic.
@@ -216,6 +228,26 @@ const (
 	ProvisioningStatusPendingDelete ProvisioningStatus = "PENDING_DELETE"
 )
 
+// IsError reports whether the provisioning failed.
+func (s ProvisioningStatus) IsError() bool {
+	return s == ProvisioningStatusError
+}
+
+// IsTransitional reports whether the provisioning is pending.
+func (s ProvisioningStatus) IsTransitional() bool {
+	switch s {
+	case ProvisioningStatusPendingCreate, ProvisioningStatusPendingUpdate, ProvisioningStatusPendingDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTerminal reports whether the provisioning is over, successfully or not.
+func (s ProvisioningStatus) IsTerminal() bool {
+	return s == ProvisioningStatusActive || s == ProvisioningStatusDeleted || s == ProvisioningStatusError
+}
+
 // LoadbalancerCreateRequest represents a request to create a Loadbalancer.
 type LoadbalancerCreateRequest struct {
 	Name         string                              `json:"name" required:"true" validate:"required,name"`
@@ -226,7 +258,7 @@ type LoadbalancerCreateRequest struct {
 	VipSubnetID  string                              `json:"vip_subnet_id,omitempty"`
 	Metadata     Metadata                            `json:"metadata,omitempty" validate:"omitempty,dive"`
 	Tags         []string                            `json:"tag,omitempty"`
-	FloatingIP   *InterfaceFloatingIP                `json:"floating_ip,omitempty" validate:"omitempty,dive"`
+	FloatingIP   *InterfaceFloatingIP                `json:"floating_ip,omitempty" validate:"omitempty"`
 }
 
 type LoadbalancerChangeFlavorRequest struct {
@@ -501,11 +533,13 @@ type loadbalancerMetricsRoot struct {
 
 // List get load balancers.
 func (s *LoadbalancersServiceOp) List(ctx context.Context, opts *LoadbalancerListOptions) ([]Loadbalancer, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Loadbalancers.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(loadbalancersBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, loadbalancersBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -527,15 +561,17 @@ func (s *LoadbalancersServiceOp) List(ctx context.Context, opts *LoadbalancerLis
 
 // Get individual Loadbalancer.
 func (s *LoadbalancersServiceOp) Get(ctx context.Context, loadbalancerID string) (*Loadbalancer, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.Get")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(loadbalancersBasePathV1), loadbalancerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, loadbalancersBasePathV1), loadbalancerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -553,15 +589,21 @@ func (s *LoadbalancersServiceOp) Get(ctx context.Context, loadbalancerID string)
 
 // Create a Loadbalancer.
 func (s *LoadbalancersServiceOp) Create(ctx context.Context, reqBody *LoadbalancerCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(loadbalancersBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, loadbalancersBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -579,15 +621,17 @@ func (s *LoadbalancersServiceOp) Create(ctx context.Context, reqBody *Loadbalanc
 
 // Delete the Loadbalancer.
 func (s *LoadbalancersServiceOp) Delete(ctx context.Context, loadbalancerID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.Delete")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(loadbalancersBasePathV1), loadbalancerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, loadbalancersBasePathV1), loadbalancerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -605,11 +649,13 @@ func (s *LoadbalancersServiceOp) Delete(ctx context.Context, loadbalancerID stri
 
 // ListenerList get load balancer listeners.
 func (s *LoadbalancersServiceOp) ListenerList(ctx context.Context, opts *ListenerListOptions) ([]Listener, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Loadbalancers.ListenerList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(lblistenersBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lblistenersBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -631,15 +677,17 @@ func (s *LoadbalancersServiceOp) ListenerList(ctx context.Context, opts *Listene
 
 // ListenerGet a Loadbalancer Listener.
 func (s *LoadbalancersServiceOp) ListenerGet(ctx context.Context, listenerID string) (*Listener, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.ListenerGet")
+
 	if resp, err := isValidUUID(listenerID, "listenerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(lblistenersBasePathV1), listenerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, lblistenersBasePathV1), listenerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -657,15 +705,21 @@ func (s *LoadbalancersServiceOp) ListenerGet(ctx context.Context, listenerID str
 
 // ListenerCreate a Loadbalancer Listener.
 func (s *LoadbalancersServiceOp) ListenerCreate(ctx context.Context, reqBody *ListenerCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.ListenerCreate")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(lblistenersBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lblistenersBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -683,15 +737,17 @@ func (s *LoadbalancersServiceOp) ListenerCreate(ctx context.Context, reqBody *Li
 
 // ListenerDelete the Loadbalancer Listener.
 func (s *LoadbalancersServiceOp) ListenerDelete(ctx context.Context, listenerID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.ListenerDelete")
+
 	if resp, err := isValidUUID(listenerID, "listenerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(lblistenersBasePathV1), listenerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, lblistenersBasePathV1), listenerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -709,6 +765,8 @@ func (s *LoadbalancersServiceOp) ListenerDelete(ctx context.Context, listenerID
 
 // ListenerUpdate a Loadbalancer Listener.
 func (s *LoadbalancersServiceOp) ListenerUpdate(ctx context.Context, listenerID string, reqBody *ListenerUpdateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.ListenerUpdate")
+
 	if resp, err := isValidUUID(listenerID, "listenerID"); err != nil {
 		return nil, resp, err
 	}
@@ -717,11 +775,15 @@ func (s *LoadbalancersServiceOp) ListenerUpdate(ctx context.Context, listenerID
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(lblistenersBasePathV2), listenerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, lblistenersBasePathV2), listenerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -739,6 +801,8 @@ func (s *LoadbalancersServiceOp) ListenerUpdate(ctx context.Context, listenerID
 
 // ListenerRename a Loadbalancer Listener.
 func (s *LoadbalancersServiceOp) ListenerRename(ctx context.Context, listenerID string, reqBody *Name) (*Listener, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.ListenerRename")
+
 	if resp, err := isValidUUID(listenerID, "listenerID"); err != nil {
 		return nil, resp, err
 	}
@@ -747,11 +811,11 @@ func (s *LoadbalancersServiceOp) ListenerRename(ctx context.Context, listenerID
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(lblistenersBasePathV1), listenerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, lblistenersBasePathV1), listenerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -769,15 +833,17 @@ func (s *LoadbalancersServiceOp) ListenerRename(ctx context.Context, listenerID
 
 // PoolGet a Loadbalancer Pool.
 func (s *LoadbalancersServiceOp) PoolGet(ctx context.Context, poolID string) (*Pool, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.PoolGet")
+
 	if resp, err := isValidUUID(poolID, "poolID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(lbpoolsBasePathV1), poolID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, lbpoolsBasePathV1), poolID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -795,15 +861,21 @@ func (s *LoadbalancersServiceOp) PoolGet(ctx context.Context, poolID string) (*P
 
 // PoolCreate a Loadbalancer Pool.
 func (s *LoadbalancersServiceOp) PoolCreate(ctx context.Context, reqBody *PoolCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.PoolCreate")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(lbpoolsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lbpoolsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -821,15 +893,17 @@ func (s *LoadbalancersServiceOp) PoolCreate(ctx context.Context, reqBody *PoolCr
 
 // PoolDelete the Loadbalancer Pool.
 func (s *LoadbalancersServiceOp) PoolDelete(ctx context.Context, poolID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.PoolDelete")
+
 	if resp, err := isValidUUID(poolID, "poolID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(lbpoolsBasePathV1), poolID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, lbpoolsBasePathV1), poolID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -847,15 +921,17 @@ func (s *LoadbalancersServiceOp) PoolDelete(ctx context.Context, poolID string)
 
 // PoolUpdate a Loadbalancer Pool.
 func (s *LoadbalancersServiceOp) PoolUpdate(ctx context.Context, poolID string, reqBody *PoolUpdateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.PoolUpdate")
+
 	if resp, err := isValidUUID(poolID, "poolID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(lbpoolsBasePathV1), poolID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, lbpoolsBasePathV1), poolID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -873,11 +949,13 @@ func (s *LoadbalancersServiceOp) PoolUpdate(ctx context.Context, poolID string,
 
 // PoolList get Loadbalancer Pools.
 func (s *LoadbalancersServiceOp) PoolList(ctx context.Context, opts *PoolListOptions) ([]Pool, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Loadbalancers.PoolList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(lbpoolsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lbpoolsBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -899,6 +977,8 @@ func (s *LoadbalancersServiceOp) PoolList(ctx context.Context, opts *PoolListOpt
 
 // PoolMemberCreate a Loadbalancer Pool Member.
 func (s *LoadbalancersServiceOp) PoolMemberCreate(ctx context.Context, poolID string, reqBody *PoolMemberCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.PoolMemberCreate")
+
 	if resp, err := isValidUUID(poolID, "poolID"); err != nil {
 		return nil, resp, err
 	}
@@ -907,11 +987,15 @@ func (s *LoadbalancersServiceOp) PoolMemberCreate(ctx context.Context, poolID st
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(lbpoolsBasePathV1), poolID, loadbalancersMember)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, lbpoolsBasePathV1), poolID, loadbalancersMember)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -929,6 +1013,8 @@ func (s *LoadbalancersServiceOp) PoolMemberCreate(ctx context.Context, poolID st
 
 // PoolMemberDelete the Loadbalancer Pool Member.
 func (s *LoadbalancersServiceOp) PoolMemberDelete(ctx context.Context, poolID, memberID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.PoolMemberDelete")
+
 	if resp, err := isValidUUID(poolID, "poolID"); err != nil {
 		return nil, resp, err
 	}
@@ -937,11 +1023,11 @@ func (s *LoadbalancersServiceOp) PoolMemberDelete(ctx context.Context, poolID, m
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s/%s", s.client.addProjectRegionPath(lbpoolsBasePathV1), poolID, loadbalancersMember, memberID)
+	path := fmt.Sprintf("%s/%s/%s/%s", s.client.addProjectRegionPath(ctx, lbpoolsBasePathV1), poolID, loadbalancersMember, memberID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -959,6 +1045,8 @@ func (s *LoadbalancersServiceOp) PoolMemberDelete(ctx context.Context, poolID, m
 
 // HealthMonitorCreate a Loadbalancer Pool HealthMonitor.
 func (s *LoadbalancersServiceOp) HealthMonitorCreate(ctx context.Context, poolID string, reqBody *HealthMonitorCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.HealthMonitorCreate")
+
 	if resp, err := isValidUUID(poolID, "poolID"); err != nil {
 		return nil, resp, err
 	}
@@ -967,11 +1055,15 @@ func (s *LoadbalancersServiceOp) HealthMonitorCreate(ctx context.Context, poolID
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(lbpoolsBasePathV1), poolID, loadbalancersHealthMonitor)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, lbpoolsBasePathV1), poolID, loadbalancersHealthMonitor)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -989,15 +1081,17 @@ func (s *LoadbalancersServiceOp) HealthMonitorCreate(ctx context.Context, poolID
 
 // HealthMonitorDelete the Loadbalancer Pool HealthMonitor.
 func (s *LoadbalancersServiceOp) HealthMonitorDelete(ctx context.Context, poolID string) (*Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.HealthMonitorDelete")
+
 	if resp, err := isValidUUID(poolID, "poolID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(lbpoolsBasePathV1), poolID, loadbalancersHealthMonitor)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, lbpoolsBasePathV1), poolID, loadbalancersHealthMonitor)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -1009,11 +1103,13 @@ func (s *LoadbalancersServiceOp) HealthMonitorDelete(ctx context.Context, poolID
 
 // CheckLimits check a quota for load balancer creation.
 func (s *LoadbalancersServiceOp) CheckLimits(ctx context.Context, reqBody *LoadbalancerCheckLimitsRequest) (*map[string]int, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Loadbalancers.CheckLimits")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(loadbalancersBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, loadbalancersBasePathV1)
 	path = fmt.Sprintf("%s/%s", path, loadbalancersCheckLimits)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -1032,15 +1128,17 @@ func (s *LoadbalancersServiceOp) CheckLimits(ctx context.Context, reqBody *Loadb
 
 // Rename a load balancer.
 func (s *LoadbalancersServiceOp) Rename(ctx context.Context, loadbalancerID string, reqBody *Name) (*Loadbalancer, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.Rename")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(loadbalancersBasePathV1), loadbalancerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, loadbalancersBasePathV1), loadbalancerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -1058,15 +1156,17 @@ func (s *LoadbalancersServiceOp) Rename(ctx context.Context, loadbalancerID stri
 
 // MetricsList get load balancer metrics.
 func (s *LoadbalancersServiceOp) MetricsList(ctx context.Context, loadbalancerID string, reqBody *LoadbalancerMetricsListRequest) ([]LoadbalancerMetrics, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.MetricsList")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(loadbalancersBasePathV1), loadbalancerID, loadbalancersMetrics)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, loadbalancersBasePathV1), loadbalancerID, loadbalancersMetrics)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, reqBody)
 	if err != nil {
@@ -1084,6 +1184,8 @@ func (s *LoadbalancersServiceOp) MetricsList(ctx context.Context, loadbalancerID
 
 // ChangeFlavor a load balancer flavor.
 func (s *LoadbalancersServiceOp) ChangeFlavor(ctx context.Context, loadbalancerID string, reqBody *LoadbalancerChangeFlavorRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.ChangeFlavor")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return nil, resp, err
 	}
@@ -1092,11 +1194,11 @@ func (s *LoadbalancersServiceOp) ChangeFlavor(ctx context.Context, loadbalancerI
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(loadbalancersBasePathV1), loadbalancerID, loadbalancersChangeFlavor)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, loadbalancersBasePathV1), loadbalancerID, loadbalancersChangeFlavor)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -1114,11 +1216,13 @@ func (s *LoadbalancersServiceOp) ChangeFlavor(ctx context.Context, loadbalancerI
 
 // FlavorList get load balancer flavors.
 func (s *LoadbalancersServiceOp) FlavorList(ctx context.Context, opts *FlavorsOptions) ([]Flavor, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Loadbalancers.FlavorList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(lbflavorsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, lbflavorsBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -1140,11 +1244,13 @@ func (s *LoadbalancersServiceOp) FlavorList(ctx context.Context, opts *FlavorsOp
 
 // MetadataList load balancer detailed metadata items.
 func (s *LoadbalancersServiceOp) MetadataList(ctx context.Context, loadbalancerID string) ([]MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.MetadataList")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -1153,11 +1259,13 @@ func (s *LoadbalancersServiceOp) MetadataList(ctx context.Context, loadbalancerI
 
 // MetadataCreate or update load balancer metadata.
 func (s *LoadbalancersServiceOp) MetadataCreate(ctx context.Context, loadbalancerID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.MetadataCreate")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -1166,11 +1274,13 @@ func (s *LoadbalancersServiceOp) MetadataCreate(ctx context.Context, loadbalance
 
 // MetadataUpdate load balancer metadata.
 func (s *LoadbalancersServiceOp) MetadataUpdate(ctx context.Context, loadbalancerID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.MetadataUpdate")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -1179,11 +1289,13 @@ func (s *LoadbalancersServiceOp) MetadataUpdate(ctx context.Context, loadbalance
 
 // MetadataDeleteItem a load balancer metadata item by key.
 func (s *LoadbalancersServiceOp) MetadataDeleteItem(ctx context.Context, loadbalancerID string, opts *MetadataItemOptions) (*Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.MetadataDeleteItem")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -1192,11 +1304,13 @@ func (s *LoadbalancersServiceOp) MetadataDeleteItem(ctx context.Context, loadbal
 
 // MetadataGetItem load balancer detailed metadata.
 func (s *LoadbalancersServiceOp) MetadataGetItem(ctx context.Context, loadbalancerID string, opts *MetadataItemOptions) (*MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Loadbalancers.MetadataGetItem")
+
 	if resp, err := isValidUUID(loadbalancerID, "loadbalancerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
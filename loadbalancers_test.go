@@ -127,7 +127,10 @@ func TestLoadbalancers_Create_ResponseError(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &LoadbalancerCreateRequest{}
+	request := &LoadbalancerCreateRequest{
+		Name:   "test-loadbalancer",
+		Flavor: "lb1-1-2",
+	}
 	URL := path.Join(loadbalancersBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
 	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
@@ -368,7 +371,12 @@ func TestLoadbalancers_ListenerCreate_ResponseError(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &ListenerCreateRequest{}
+	request := &ListenerCreateRequest{
+		Name:           "test-loadbalancer",
+		Protocol:       ListenerProtocolTCP,
+		ProtocolPort:   80,
+		LoadbalancerID: testResourceID,
+	}
 	URL := path.Join(lblistenersBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
 	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
@@ -624,7 +632,12 @@ func TestLoadbalancers_PoolCreate_ResponseError(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &PoolCreateRequest{}
+	request := &PoolCreateRequest{
+		LoadbalancerPoolCreateRequest: LoadbalancerPoolCreateRequest{
+			Name:       "test-loadbalancer",
+			ListenerID: testResourceID,
+		},
+	}
 	URL := path.Join(lbpoolsBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
 	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,218 @@
+package edgecloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are the request headers whose values are never logged.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Auth-Token"}
+
+// sensitiveFields are the JSON fields whose values are never logged, at any depth of the request
+// and response bodies: the secret payloads, private keys, passwords, user data of the instances
+// and connection strings of the user actions.
+var sensitiveFields = []string{
+	"password",
+	"admin_password",
+	"user_data",
+	"payload",
+	"private_key",
+	"connection_string",
+	"token",
+	"access_token",
+	"refresh_token",
+}
+
+// requestStartContextKey is the context key under which the logging middleware stores the start time of a request.
+type requestStartContextKey struct{}
+
+// LoggingConfig configures the logging of the API requests with log/slog.
+type LoggingConfig struct {
+	// Logger used for the records. slog.Default() is used if nil.
+	Logger *slog.Logger
+
+	// Levels of the records of the sent requests, of the successful responses and of the failed requests.
+	// They default to slog.LevelDebug, slog.LevelInfo and slog.LevelError, respectively.
+	RequestLevel  slog.Leveler
+	ResponseLevel slog.Leveler
+	ErrorLevel    slog.Leveler
+
+	// LogHeaders adds the request headers to the records. The Authorization header is always redacted.
+	LogHeaders bool
+
+	// LogBodies adds the request bodies and the decoded response bodies to the records.
+	// The values of sensitive fields, like passwords, private keys and secret payloads, are always redacted.
+	LogBodies bool
+
+	// RedactFields are the names of extra JSON fields to redact in the logged bodies.
+	RedactFields []string
+}
+
+// WithLogging is a client option for logging every request made with Do and its outcome with log/slog.
+// The logging is a Middleware, so it is called in order with the other middlewares of the client.
+func WithLogging(config LoggingConfig) ClientOpt {
+	return WithMiddleware(newLoggingMiddleware(config))
+}
+
+func newLoggingMiddleware(config LoggingConfig) Middleware {
+	logger := config.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	requestLevel := levelOrDefault(config.RequestLevel, slog.LevelDebug)
+	responseLevel := levelOrDefault(config.ResponseLevel, slog.LevelInfo)
+	errorLevel := levelOrDefault(config.ErrorLevel, slog.LevelError)
+
+	redactFields := make(map[string]struct{}, len(sensitiveFields)+len(config.RedactFields))
+	for _, fields := range [][]string{sensitiveFields, config.RedactFields} {
+		for _, field := range fields {
+			redactFields[strings.ToLower(field)] = struct{}{}
+		}
+	}
+
+	return Middleware{
+		BeforeRequest: func(ctx context.Context, req *http.Request) (context.Context, error) {
+			ctx = context.WithValue(ctx, requestStartContextKey{}, time.Now())
+			if !logger.Enabled(ctx, requestLevel.Level()) {
+				return ctx, nil
+			}
+
+			attrs := requestAttrs(ctx, req)
+			if config.LogHeaders {
+				attrs = append(attrs, headerAttr(req.Header))
+			}
+			if config.LogBodies && req.GetBody != nil {
+				if body, err := req.GetBody(); err == nil {
+					data, _ := io.ReadAll(body)
+					attrs = append(attrs, slog.String("body", redactBody(data, redactFields)))
+				}
+			}
+			logger.LogAttrs(ctx, requestLevel.Level(), "edgecloud request", attrs...)
+
+			return ctx, nil
+		},
+		AfterResponse: func(ctx context.Context, req *http.Request, resp *Response, v interface{}) {
+			if !logger.Enabled(ctx, responseLevel.Level()) {
+				return
+			}
+
+			attrs := append(requestAttrs(ctx, req), responseAttrs(ctx, resp)...)
+			if config.LogBodies && v != nil {
+				if data, err := json.Marshal(v); err == nil {
+					attrs = append(attrs, slog.String("body", redactBody(data, redactFields)))
+				}
+			}
+			logger.LogAttrs(ctx, responseLevel.Level(), "edgecloud response", attrs...)
+		},
+		OnError: func(ctx context.Context, req *http.Request, resp *Response, err error) {
+			if !logger.Enabled(ctx, errorLevel.Level()) {
+				return
+			}
+
+			attrs := append(requestAttrs(ctx, req), responseAttrs(ctx, resp)...)
+			attrs = append(attrs, slog.String("error", err.Error()))
+			logger.LogAttrs(ctx, errorLevel.Level(), "edgecloud request failed", attrs...)
+		},
+	}
+}
+
+func levelOrDefault(level slog.Leveler, defaultLevel slog.Level) slog.Leveler {
+	if level == nil {
+		return defaultLevel
+	}
+
+	return level
+}
+
+func requestAttrs(ctx context.Context, req *http.Request) []slog.Attr {
+	return []slog.Attr{
+		slog.String("operation", operationName(ctx, req)),
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+	}
+}
+
+func responseAttrs(ctx context.Context, resp *Response) []slog.Attr {
+	var attrs []slog.Attr
+	if started, ok := ctx.Value(requestStartContextKey{}).(time.Time); ok {
+		attrs = append(attrs, slog.Duration("duration", time.Since(started)))
+	}
+	if resp != nil && resp.Response != nil {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if requestID := resp.Header.Get(headerRequestID); requestID != "" {
+			attrs = append(attrs, slog.String("request_id", requestID))
+		}
+	}
+
+	return attrs
+}
+
+func headerAttr(header http.Header) slog.Attr {
+	header = header.Clone()
+	for _, name := range sensitiveHeaders {
+		if header.Get(name) != "" {
+			header.Set(name, redacted)
+		}
+	}
+
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attrs := make([]any, 0, len(names))
+	for _, name := range names {
+		attrs = append(attrs, slog.String(name, strings.Join(header[name], ", ")))
+	}
+
+	return slog.Group("headers", attrs...)
+}
+
+// redactBody returns the JSON body with the values of the given fields redacted.
+// A body that is not valid JSON is not logged, as its sensitive parts cannot be found.
+func redactBody(data []byte, fields map[string]struct{}) string {
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return ""
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return fmt.Sprintf("[%d bytes of non-JSON body]", len(data))
+	}
+
+	out, err := json.Marshal(redactValue(body, fields))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes of body]", len(data))
+	}
+
+	return string(out)
+}
+
+func redactValue(value interface{}, fields map[string]struct{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if _, ok := fields[strings.ToLower(key)]; ok && field != nil && field != "" {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(field, fields)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, fields)
+		}
+	}
+
+	return value
+}
@@ -36,7 +36,7 @@ type MetadataRoot struct {
 
 // metadataList helper for same logic methods.
 func metadataList(ctx context.Context, client *Client, id, resourcePath string) ([]MetadataDetailed, *Response, error) {
-	path := fmt.Sprintf("%s/%s/%s", client.addProjectRegionPath(resourcePath), id, metadataPath)
+	path := fmt.Sprintf("%s/%s/%s", client.addProjectRegionPath(ctx, resourcePath), id, metadataPath)
 
 	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -54,7 +54,7 @@ func metadataList(ctx context.Context, client *Client, id, resourcePath string)
 
 // metadataCreate helper for same logic methods.
 func metadataCreate(ctx context.Context, client *Client, id, resourcePath string, metadata *Metadata) (*Response, error) {
-	path := fmt.Sprintf("%s/%s/%s", client.addProjectRegionPath(resourcePath), id, metadataPath)
+	path := fmt.Sprintf("%s/%s/%s", client.addProjectRegionPath(ctx, resourcePath), id, metadataPath)
 
 	req, err := client.NewRequest(ctx, http.MethodPost, path, metadata)
 	if err != nil {
@@ -66,7 +66,7 @@ func metadataCreate(ctx context.Context, client *Client, id, resourcePath string
 
 // metadataUpdate helper for same logic methods.
 func metadataUpdate(ctx context.Context, client *Client, id, resourcePath string, metadata *Metadata) (*Response, error) {
-	path := fmt.Sprintf("%s/%s/%s", client.addProjectRegionPath(resourcePath), id, metadataPath)
+	path := fmt.Sprintf("%s/%s/%s", client.addProjectRegionPath(ctx, resourcePath), id, metadataPath)
 
 	req, err := client.NewRequest(ctx, http.MethodPut, path, metadata)
 	if err != nil {
@@ -78,7 +78,7 @@ func metadataUpdate(ctx context.Context, client *Client, id, resourcePath string
 
 // metadataDeleteItem helper for same logic methods.
 func metadataDeleteItem(ctx context.Context, client *Client, id, resourcePath string, opts *MetadataItemOptions) (*Response, error) {
-	path := client.addProjectRegionPath(resourcePath)
+	path := client.addProjectRegionPath(ctx, resourcePath)
 
 	path = fmt.Sprintf("%s/%s/%s", path, id, metadataItemPath)
 
@@ -96,7 +96,7 @@ func metadataDeleteItem(ctx context.Context, client *Client, id, resourcePath st
 
 // metadataGetItem helper for same logic methods.
 func metadataGetItem(ctx context.Context, client *Client, id, resourcePath string, opts *MetadataItemOptions) (*MetadataDetailed, *Response, error) {
-	path := client.addProjectRegionPath(resourcePath)
+	path := client.addProjectRegionPath(ctx, resourcePath)
 
 	path = fmt.Sprintf("%s/%s/%s", path, id, metadataItemPath)
 
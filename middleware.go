@@ -0,0 +1,66 @@
+package edgecloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// Middleware is a set of hooks called around every request made with Client.Do. Any hook may be nil.
+//
+// The BeforeRequest hooks of the client middlewares are called in the order the middlewares were added,
+// then exactly one of the AfterResponse or OnError hooks is called in the reverse order.
+// Middlewares do not depend on the HTTP client, so they see each request once, whether retries are enabled or not.
+type Middleware struct {
+	// BeforeRequest is called before the request is sent. It may modify the request, e.g. add headers,
+	// and return a derived context, which is passed to the request and to the following hooks.
+	// Returning an error aborts the request; the OnError hooks of this and of the preceding middlewares
+	// are called with a nil response then.
+	BeforeRequest func(ctx context.Context, req *http.Request) (context.Context, error)
+
+	// AfterResponse is called after a successful response has been decoded into v.
+	AfterResponse func(ctx context.Context, req *http.Request, resp *Response, v interface{})
+
+	// OnError is called when the request fails, either on the client side or with an API error response.
+	OnError func(ctx context.Context, req *http.Request, resp *Response, err error)
+}
+
+// WithMiddleware is a client option for adding middlewares called around every request.
+// It may be used several times, the middlewares are kept in the order they were added.
+func WithMiddleware(middlewares ...Middleware) ClientOpt {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares[:len(c.middlewares):len(c.middlewares)], middlewares...)
+		return nil
+	}
+}
+
+// beforeRequest calls the BeforeRequest hooks and returns the middlewares that were called.
+func (c *Client) beforeRequest(ctx context.Context, req *http.Request) (context.Context, []Middleware, error) {
+	for i, m := range c.middlewares {
+		if m.BeforeRequest == nil {
+			continue
+		}
+
+		var err error
+		if ctx, err = m.BeforeRequest(ctx, req); err != nil {
+			return ctx, c.middlewares[:i+1], err
+		}
+	}
+
+	return ctx, c.middlewares, nil
+}
+
+func afterResponse(ctx context.Context, middlewares []Middleware, req *http.Request, resp *Response, v interface{}) {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if hook := middlewares[i].AfterResponse; hook != nil {
+			hook(ctx, req, resp, v)
+		}
+	}
+}
+
+func onError(ctx context.Context, middlewares []Middleware, req *http.Request, resp *Response, err error) {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if hook := middlewares[i].OnError; hook != nil {
+			hook(ctx, req, resp, err)
+		}
+	}
+}
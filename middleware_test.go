@@ -0,0 +1,168 @@
+package edgecloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type contextKey string
+
+func recordingMiddleware(name string, calls *[]string) Middleware {
+	return Middleware{
+		BeforeRequest: func(ctx context.Context, req *http.Request) (context.Context, error) {
+			*calls = append(*calls, name+".before")
+			req.Header.Set("X-"+name, name)
+			return context.WithValue(ctx, contextKey(name), name), nil
+		},
+		AfterResponse: func(ctx context.Context, req *http.Request, resp *Response, v interface{}) {
+			*calls = append(*calls, fmt.Sprintf("%s.after(%v)", name, ctx.Value(contextKey(name))))
+		},
+		OnError: func(ctx context.Context, req *http.Request, resp *Response, err error) {
+			*calls = append(*calls, fmt.Sprintf("%s.error(%v)", name, ctx.Value(contextKey(name))))
+		},
+	}
+}
+
+func TestWithMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "first", r.Header.Get("X-first"))
+		assert.Equal(t, "second", r.Header.Get("X-second"))
+		_, _ = fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	var calls []string
+	require.NoError(t, WithMiddleware(recordingMiddleware("first", &calls))(client))
+	require.NoError(t, WithMiddleware(recordingMiddleware("second", &calls))(client))
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first.before", "second.before", "second.after(second)", "first.after(first)"}, calls)
+}
+
+func TestWithMiddleware_OnError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var calls []string
+	var errResp *Response
+	var hookErr error
+	require.NoError(t, WithMiddleware(recordingMiddleware("first", &calls), Middleware{
+		OnError: func(ctx context.Context, req *http.Request, resp *Response, err error) {
+			errResp, hookErr = resp, err
+		},
+	})(client))
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, []string{"first.before", "first.error(first)"}, calls)
+	assert.Equal(t, err, hookErr)
+	assert.Equal(t, http.StatusNotFound, errResp.StatusCode)
+}
+
+func TestWithMiddleware_BeforeRequestError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	errAborted := errors.New("aborted")
+	var calls []string
+	require.NoError(t, WithMiddleware(recordingMiddleware("first", &calls), Middleware{
+		BeforeRequest: func(ctx context.Context, req *http.Request) (context.Context, error) {
+			return ctx, errAborted
+		},
+	}, recordingMiddleware("third", &calls))(client))
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	assert.ErrorIs(t, err, errAborted)
+	assert.Nil(t, resp)
+	assert.Zero(t, requests)
+	assert.Equal(t, []string{"first.before", "first.error(first)"}, calls)
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestWithMiddleware_WithRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	})
+
+	var calls []string
+	transport := &countingTransport{}
+	c, err := New(&http.Client{Transport: transport},
+		WithRetryAndBackoffs(RetryConfig{RetryMax: 2, RetryWaitMin: PtrTo(0.01), RetryWaitMax: PtrTo(0.01)}),
+		WithMiddleware(recordingMiddleware("first", &calls)),
+	)
+	require.NoError(t, err)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	req, err := c.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+
+	_, err = c.Do(ctx, req, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 2, transport.requests)
+	assert.Equal(t, []string{"first.before", "first.after(first)"}, calls)
+}
+
+func TestSetRequestCompletionCallback(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	var completed int
+	require.NoError(t, SetRequestCompletionCallback(func(req *http.Request, resp *http.Response) {
+		completed = resp.StatusCode
+	})(client))
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, completed)
+}
@@ -2,8 +2,10 @@ package edgecloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 const (
@@ -138,7 +140,7 @@ type MKaaSNode struct {
 	State      NodeState  `json:"state"`
 	Status     NodeStatus `json:"status"`
 	IPAddress  string     `json:"ip_address"`
-	CreatedAt  string     `json:"created_at"`
+	CreatedAt  Timestamp  `json:"created_at"`
 }
 
 type MKaaSNodeListOptions struct {
@@ -203,27 +205,92 @@ type MKaaSClusterUpgradeVersionRequest struct {
 	TargetVersion string `json:"target_version"`
 }
 
+// MKaaSClusterStatus is the status of an MKaaSCluster. MKaaSCluster.Processing reports as well whether
+// the cluster is changing, e.g. while its pools are scaled.
+type MKaaSClusterStatus string
+
+const (
+	MKaaSClusterStatusProvisioning MKaaSClusterStatus = "PROVISIONING"
+	MKaaSClusterStatusProvisioned  MKaaSClusterStatus = "PROVISIONED"
+	MKaaSClusterStatusUpdating     MKaaSClusterStatus = "UPDATING"
+	MKaaSClusterStatusDeleting     MKaaSClusterStatus = "DELETING"
+	MKaaSClusterStatusError        MKaaSClusterStatus = "ERROR"
+)
+
+// IsError reports whether the cluster is in error.
+func (s MKaaSClusterStatus) IsError() bool {
+	return s == MKaaSClusterStatusError
+}
+
+// IsTransitional reports whether the cluster is changing, e.g. being provisioned or deleted.
+func (s MKaaSClusterStatus) IsTransitional() bool {
+	return s == MKaaSClusterStatusProvisioning || s == MKaaSClusterStatusUpdating || s == MKaaSClusterStatusDeleting
+}
+
+// IsTerminal reports whether the cluster keeps the status until it is acted on, including in error.
+func (s MKaaSClusterStatus) IsTerminal() bool {
+	return s == MKaaSClusterStatusProvisioned || s == MKaaSClusterStatusError
+}
+
 // MKaaSCluster represents an EdgecenterCloud MkaaS Cluster.
 type MKaaSCluster struct {
-	ID                int          `json:"id"`
-	RegionID          int          `json:"region_id"`
-	ProjectID         int          `json:"project_id"`
-	SSHKeypairName    string       `json:"ssh_keypair_name"`
-	Name              string       `json:"name"`
-	NetworkID         string       `json:"network_id"`
-	SubnetID          string       `json:"subnet_id"`
-	ControlPlane      ControlPlane `json:"control_plane"`
-	Pools             []MKaaSPool  `json:"pools"`
-	InternalIP        string       `json:"internal_ip"`
-	ExternalIP        string       `json:"external_ip"`
-	Existed           string       `json:"existed,omitempty"` // Duration string (e.g., "237h36m46.703341967s")
-	Created           string       `json:"created"`
-	Processing        bool         `json:"processing"`
-	Status            string       `json:"status"`
-	Stage             string       `json:"stage"`
-	PodSubnet         string       `json:"pod_subnet"`
-	ServiceSubnet     string       `json:"service_subnet"`
-	AutoscalerEnabled bool         `json:"autoscaler_enabled"`
+	ID             int           `json:"id"`
+	RegionID       int           `json:"region_id"`
+	ProjectID      int           `json:"project_id"`
+	SSHKeypairName string        `json:"ssh_keypair_name"`
+	Name           string        `json:"name"`
+	NetworkID      string        `json:"network_id"`
+	SubnetID       string        `json:"subnet_id"`
+	ControlPlane   ControlPlane  `json:"control_plane"`
+	Pools          []MKaaSPool   `json:"pools"`
+	InternalIP     string        `json:"internal_ip"`
+	ExternalIP     string        `json:"external_ip"`
+	Existed        time.Duration `json:"existed,omitempty"`
+	// ExistedRaw is the existed value of the API when it is not a duration, Existed being zero then.
+	ExistedRaw        string             `json:"-"`
+	Created           Timestamp          `json:"created"`
+	Processing        bool               `json:"processing"`
+	Status            MKaaSClusterStatus `json:"status"`
+	Stage             string             `json:"stage"`
+	PodSubnet         string             `json:"pod_subnet"`
+	ServiceSubnet     string             `json:"service_subnet"`
+	AutoscalerEnabled bool               `json:"autoscaler_enabled"`
+}
+
+// mkaasClusterJSON is the JSON of an MKaaSCluster, whose Existed is a duration string, e.g. "237h36m46.703341967s".
+type mkaasClusterJSON struct {
+	*mkaasCluster
+	Existed string `json:"existed,omitempty"`
+}
+
+type mkaasCluster MKaaSCluster
+
+func (c *MKaaSCluster) UnmarshalJSON(data []byte) error {
+	aux := mkaasClusterJSON{mkaasCluster: (*mkaasCluster)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.Existed != "" {
+		// an unknown format is kept as is, like the timestamps, rather than failing the decoding of the cluster
+		existed, err := time.ParseDuration(aux.Existed)
+		if err != nil {
+			c.ExistedRaw = aux.Existed
+		} else {
+			c.Existed = existed
+		}
+	}
+
+	return nil
+}
+
+func (c MKaaSCluster) MarshalJSON() ([]byte, error) {
+	aux := mkaasClusterJSON{mkaasCluster: (*mkaasCluster)(&c), Existed: c.ExistedRaw}
+	if c.Existed != 0 {
+		aux.Existed = c.Existed.String()
+	}
+
+	return json.Marshal(aux)
 }
 
 type ControlPlaneCreateRequest struct {
@@ -333,11 +400,13 @@ type MKaaSTaint struct {
 }
 
 func (m *MKaaSServiceOp) ClusterCreate(ctx context.Context, reqBody MKaaSClusterCreateRequest) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.ClusterCreate")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := m.client.addProjectRegionPath(MKaaSClustersBasePathV2)
+	path := m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2)
 
 	req, err := m.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -354,11 +423,13 @@ func (m *MKaaSServiceOp) ClusterCreate(ctx context.Context, reqBody MKaaSCluster
 }
 
 func (m *MKaaSServiceOp) ClustersList(ctx context.Context, opts *MKaaSClusterListOptions) ([]MKaaSCluster, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.ClustersList")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := m.client.addProjectRegionPath(MKaaSClustersBasePathV2)
+	path := m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -375,15 +446,19 @@ func (m *MKaaSServiceOp) ClustersList(ctx context.Context, opts *MKaaSClusterLis
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Clusters, resp, err
 }
 
 func (m *MKaaSServiceOp) ClusterGet(ctx context.Context, clusterID int) (*MKaaSCluster, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.ClusterGet")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID)
+	path := fmt.Sprintf("%s/%d", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -402,11 +477,13 @@ func (m *MKaaSServiceOp) ClusterGet(ctx context.Context, clusterID int) (*MKaaSC
 func (m *MKaaSServiceOp) ClusterUpdateName(
 	ctx context.Context, clusterID int, reqBody MKaaSClusterUpdateNameRequest,
 ) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.ClusterUpdateName")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d/name", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID)
+	path := fmt.Sprintf("%s/%d/name", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -425,13 +502,15 @@ func (m *MKaaSServiceOp) ClusterUpdateName(
 func (m *MKaaSServiceOp) ClusterUpdateMasterNodeCount(
 	ctx context.Context, clusterID int, reqBody MKaaSClusterUpdateMasterNodeCountRequest,
 ) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.ClusterUpdateMasterNodeCount")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	path := fmt.Sprintf(
 		"%s/%d/master_node_count",
-		m.client.addProjectRegionPath(MKaaSClustersBasePathV2),
+		m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2),
 		clusterID,
 	)
 
@@ -452,11 +531,13 @@ func (m *MKaaSServiceOp) ClusterUpdateMasterNodeCount(
 func (m *MKaaSServiceOp) ClusterUpgradeVersion(
 	ctx context.Context, clusterID int, reqBody MKaaSClusterUpgradeVersionRequest,
 ) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.ClusterUpgradeVersion")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d/upgrade_version", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID)
+	path := fmt.Sprintf("%s/%d/upgrade_version", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -473,11 +554,13 @@ func (m *MKaaSServiceOp) ClusterUpgradeVersion(
 }
 
 func (m *MKaaSServiceOp) ClusterDelete(ctx context.Context, clusterID int) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.ClusterDelete")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID)
+	path := fmt.Sprintf("%s/%d", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -494,11 +577,13 @@ func (m *MKaaSServiceOp) ClusterDelete(ctx context.Context, clusterID int) (*Tas
 }
 
 func (m *MKaaSServiceOp) PoolCreate(ctx context.Context, clusterID int, reqBody MKaaSPoolCreateRequest) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolCreate")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d/pools", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID)
+	path := fmt.Sprintf("%s/%d/pools", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -515,11 +600,13 @@ func (m *MKaaSServiceOp) PoolCreate(ctx context.Context, clusterID int, reqBody
 }
 
 func (m *MKaaSServiceOp) PoolUpdateName(ctx context.Context, clusterID, poolID int, reqBody MKaaSPoolUpdateNameRequest) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolUpdateName")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d/pools/%d/name", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID, poolID)
+	path := fmt.Sprintf("%s/%d/pools/%d/name", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID, poolID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -536,11 +623,13 @@ func (m *MKaaSServiceOp) PoolUpdateName(ctx context.Context, clusterID, poolID i
 }
 
 func (m *MKaaSServiceOp) PoolUpdateNodeCount(ctx context.Context, clusterID, poolID int, reqBody MKaaSPoolUpdateScaleRequest) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolUpdateNodeCount")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d/pools/%d/scale", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID, poolID)
+	path := fmt.Sprintf("%s/%d/pools/%d/scale", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID, poolID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -557,11 +646,13 @@ func (m *MKaaSServiceOp) PoolUpdateNodeCount(ctx context.Context, clusterID, poo
 }
 
 func (m *MKaaSServiceOp) PoolsList(ctx context.Context, clusterID int, opts *MKaaSPoolListOptions) ([]MKaaSPool, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolsList")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d/pools", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID)
+	path := fmt.Sprintf("%s/%d/pools", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -578,15 +669,19 @@ func (m *MKaaSServiceOp) PoolsList(ctx context.Context, clusterID int, opts *MKa
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Pools, resp, err
 }
 
 func (m *MKaaSServiceOp) PoolGet(ctx context.Context, clusterID, poolID int) (*MKaaSPool, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolGet")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d/pools/%d", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID, poolID)
+	path := fmt.Sprintf("%s/%d/pools/%d", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID, poolID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -603,11 +698,13 @@ func (m *MKaaSServiceOp) PoolGet(ctx context.Context, clusterID, poolID int) (*M
 }
 
 func (m *MKaaSServiceOp) PoolDelete(ctx context.Context, clusterID, poolID int) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolDelete")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d/pools/%d", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID, poolID)
+	path := fmt.Sprintf("%s/%d/pools/%d", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID, poolID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -626,11 +723,13 @@ func (m *MKaaSServiceOp) PoolDelete(ctx context.Context, clusterID, poolID int)
 func (m *MKaaSServiceOp) PoolUpdateSecurityGroups(ctx context.Context, clusterID, poolID int,
 	reqBody MKaaSPoolUpdateSecurityGroupsRequest,
 ) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolUpdateSecurityGroups")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%d/pools/%d/secgroups", m.client.addProjectRegionPath(MKaaSClustersBasePathV2), clusterID, poolID)
+	path := fmt.Sprintf("%s/%d/pools/%d/secgroups", m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2), clusterID, poolID)
 
 	req, err := m.client.NewRequest(ctx, http.MethodPut, path, reqBody)
 	if err != nil {
@@ -651,13 +750,15 @@ func (m *MKaaSServiceOp) PoolUpdateTaints(
 	clusterID, poolID int,
 	reqBody MKaaSPoolUpdateTaintsRequest,
 ) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolUpdateTaints")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	path := fmt.Sprintf(
 		"%s/%d/pools/%d/taints",
-		m.client.addProjectRegionPath(MKaaSClustersBasePathV2),
+		m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2),
 		clusterID,
 		poolID,
 	)
@@ -681,13 +782,15 @@ func (m *MKaaSServiceOp) PoolUpdateLabels(
 	clusterID, poolID int,
 	reqBody MKaaSPoolUpdateLabelsRequest,
 ) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolUpdateLabels")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	path := fmt.Sprintf(
 		"%s/%d/pools/%d/labels",
-		m.client.addProjectRegionPath(MKaaSClustersBasePathV2),
+		m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2),
 		clusterID,
 		poolID,
 	)
@@ -711,13 +814,15 @@ func (m *MKaaSServiceOp) PoolUpdateAutoscaling(
 	clusterID, poolID int,
 	reqBody MKaaSPoolUpdateAutoscalingRequest,
 ) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.PoolUpdateAutoscaling")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	path := fmt.Sprintf(
 		"%s/%d/pools/%d/autoscaling",
-		m.client.addProjectRegionPath(MKaaSClustersBasePathV2),
+		m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2),
 		clusterID,
 		poolID,
 	)
@@ -739,13 +844,15 @@ func (m *MKaaSServiceOp) PoolUpdateAutoscaling(
 func (m *MKaaSServiceOp) NodesList(
 	ctx context.Context, clusterID, poolID int, opts *MKaaSNodeListOptions,
 ) ([]MKaaSNode, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.NodesList")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	path := fmt.Sprintf(
 		"%s/%d/pools/%d/nodes",
-		m.client.addProjectRegionPath(MKaaSClustersBasePathV2),
+		m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2),
 		clusterID,
 		poolID,
 	)
@@ -765,6 +872,8 @@ func (m *MKaaSServiceOp) NodesList(
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Total}
+
 	if root.Nodes == nil {
 		return []MKaaSNode{}, resp, nil
 	}
@@ -776,13 +885,15 @@ func (m *MKaaSServiceOp) NodesList(
 func (m *MKaaSServiceOp) NodesDelete(
 	ctx context.Context, clusterID, poolID int, reqBody MKaaSNodesDeleteRequest,
 ) (*TaskResponse, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.NodesDelete")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
 	path := fmt.Sprintf(
 		"%s/%d/pools/%d/nodes/delete",
-		m.client.addProjectRegionPath(MKaaSClustersBasePathV2),
+		m.client.addProjectRegionPath(ctx, MKaaSClustersBasePathV2),
 		clusterID,
 		poolID,
 	)
@@ -804,11 +915,13 @@ func (m *MKaaSServiceOp) NodesDelete(
 func (m *MKaaSServiceOp) FlavorsList(
 	ctx context.Context, opts *MKaaSFlavorListOptions,
 ) (*MKaaSFlavorsList, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.FlavorsList")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/flavors", m.client.addProjectRegionPath(MKaaSBasePathV2))
+	path := fmt.Sprintf("%s/flavors", m.client.addProjectRegionPath(ctx, MKaaSBasePathV2))
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -843,7 +956,9 @@ func (m *MKaaSServiceOp) VersionsList(
 	ctx context.Context,
 	regionID int,
 ) (*MKaaSKubernetesVersionsResult, *Response, error) {
-	if resp, err := m.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "MKaaS.VersionsList")
+
+	if resp, err := m.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -7,6 +7,7 @@ import (
 	"path"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -135,6 +136,31 @@ func TestMKaaSServiceOp_ClustersGet(t *testing.T) {
 	require.Equal(t, respActual, expectedResp)
 }
 
+func TestMKaaSCluster_Existed(t *testing.T) {
+	var cluster MKaaSCluster
+	err := json.Unmarshal([]byte(`{"id": 1, "existed": "237h36m46.703341967s", "created": "2024-08-06T17:40:32+0000"}`), &cluster)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cluster.ID)
+	assert.Equal(t, 237*time.Hour+36*time.Minute+46703341967*time.Microsecond/1000, cluster.Existed)
+	assert.Equal(t, time.Date(2024, 8, 6, 17, 40, 32, 0, time.UTC), cluster.Created.UTC())
+
+	data, err := json.Marshal(cluster)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"existed":"237h36m46.703341967s"`)
+	assert.Contains(t, string(data), `"created":"2024-08-06T17:40:32+0000"`)
+
+	cluster = MKaaSCluster{}
+	err = json.Unmarshal([]byte(`{"id": 2, "existed": "1 day"}`), &cluster)
+	require.NoError(t, err)
+	assert.Equal(t, 2, cluster.ID)
+	assert.Zero(t, cluster.Existed)
+	assert.Equal(t, "1 day", cluster.ExistedRaw)
+
+	data, err = json.Marshal(cluster)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"existed":"1 day"`)
+}
+
 func TestMKaaSServiceOp_VersionsList(t *testing.T) {
 	setup()
 	defer teardown()
@@ -759,3 +785,12 @@ func TestMKaaSServiceOp_FlavorsList_WithOptions(t *testing.T) {
 	require.Empty(t, actual.Masters)
 	require.Empty(t, actual.Workers)
 }
+
+func TestMKaaSClusterStatus(t *testing.T) {
+	assert.True(t, MKaaSClusterStatusDeleting.IsTransitional())
+	assert.False(t, MKaaSClusterStatusDeleting.IsTerminal())
+	assert.True(t, MKaaSClusterStatusProvisioned.IsTerminal())
+	assert.True(t, MKaaSClusterStatusError.IsError())
+	assert.True(t, MKaaSClusterStatusError.IsTerminal())
+	assert.False(t, MKaaSClusterStatus("unexpected").IsTransitional())
+}
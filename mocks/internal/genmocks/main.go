@@ -0,0 +1,351 @@
+// Command genmocks generates the mocks of the service interfaces of the edgecloud package.
+//
+// The mocked interfaces are the exported interfaces whose names end with Service, and the interfaces
+// they embed. Run it with go generate in the mocks directory.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	modulePath  = "github.com/Edge-Center/edgecentercloud-go/v2"
+	packageName = "edgecloud"
+
+	// receiver is the name of the receiver of the mock methods. The parameters are renamed if they clash with it.
+	receiver = "m"
+)
+
+var (
+	errNoError     = errors.New("the last result is not an error")
+	errInvalidType = errors.New("the interfaces refer to types of packages outside the standard library")
+)
+
+func main() {
+	src := flag.String("src", ".", "directory of the edgecloud package")
+	output := flag.String("o", "mocks_gen.go", "output file")
+	flag.Parse()
+
+	code, err := generate(*src)
+	if err != nil {
+		log.Fatalf("genmocks: %v", err)
+	}
+	if err := os.WriteFile(*output, code, 0o644); err != nil { //nolint:gosec
+		log.Fatalf("genmocks: %v", err)
+	}
+}
+
+// generate returns the source of the mocks of the interfaces of the package in the directory.
+func generate(dir string) ([]byte, error) {
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{pkg: pkg, imports: make(map[string]string)}
+	for _, name := range mockedInterfaces(pkg) {
+		if err := g.mock(name, pkg.Scope().Lookup(name).Type().Underlying().(*types.Interface)); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	if len(g.invalid) > 0 {
+		return nil, fmt.Errorf("%w: %s", errInvalidType, strings.Join(g.invalid, ", "))
+	}
+
+	return g.source()
+}
+
+func loadPackage(dir string) (*types.Package, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	parsed, ok := pkgs[packageName]
+	if !ok {
+		return nil, fmt.Errorf("package %s not found in %s", packageName, dir)
+	}
+
+	files := make([]*ast.File, 0, len(parsed.Files))
+	for _, file := range parsed.Files {
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return fset.File(files[i].Pos()).Name() < fset.File(files[j].Pos()).Name()
+	})
+
+	// the service interfaces refer to the standard library only, so the other imports are replaced with empty
+	// packages and the errors they cause are ignored, which saves type-checking the dependencies
+	conf := types.Config{
+		Importer: stdImporter{importer.Default()},
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check(modulePath, fset, files, nil)
+
+	return pkg, nil
+}
+
+// stdImporter imports the packages of the standard library, and empty packages instead of the others.
+type stdImporter struct {
+	types.Importer
+}
+
+func (i stdImporter) Import(importPath string) (*types.Package, error) {
+	if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+		pkg := types.NewPackage(importPath, path.Base(importPath))
+		pkg.MarkComplete()
+
+		return pkg, nil
+	}
+
+	return i.Importer.Import(importPath)
+}
+
+// mockedInterfaces returns the sorted names of the service interfaces and the interfaces they embed.
+func mockedInterfaces(pkg *types.Package) []string {
+	mocked := make(map[string]bool)
+
+	var add func(name string)
+	add = func(name string) {
+		if mocked[name] {
+			return
+		}
+		mocked[name] = true
+		iface := pkg.Scope().Lookup(name).Type().Underlying().(*types.Interface)
+		for i := 0; i < iface.NumEmbeddeds(); i++ {
+			if named, ok := iface.EmbeddedType(i).(*types.Named); ok && named.Obj().Pkg() == pkg {
+				add(named.Obj().Name())
+			}
+		}
+	}
+
+	for _, name := range pkg.Scope().Names() {
+		obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok || !obj.Exported() || !strings.HasSuffix(name, "Service") {
+			continue
+		}
+		if _, ok := obj.Type().Underlying().(*types.Interface); ok {
+			add(name)
+		}
+	}
+
+	names := make([]string, 0, len(mocked))
+	for name := range mocked {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+type generator struct {
+	pkg *types.Package
+	buf bytes.Buffer
+	// imports maps the paths of the imported packages to their names.
+	imports map[string]string
+	// invalid are the types that could not be resolved.
+	invalid []string
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// qualifier qualifies the types of the other packages, including the edgecloud package.
+func (g *generator) qualifier(pkg *types.Package) string {
+	name := pkg.Name()
+	if pkg == g.pkg {
+		name = packageName
+	}
+	g.imports[pkg.Path()] = name
+
+	return name
+}
+
+func (g *generator) typeString(t types.Type) string {
+	s := types.TypeString(t, g.qualifier)
+	if strings.Contains(s, "invalid type") {
+		g.invalid = append(g.invalid, s)
+	}
+
+	return s
+}
+
+func (g *generator) mock(name string, iface *types.Interface) error {
+	methods := make([]*types.Func, 0, iface.NumMethods())
+	for i := 0; i < iface.NumMethods(); i++ {
+		methods = append(methods, iface.Method(i))
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name() < methods[j].Name() })
+
+	iname := g.typeString(types.NewNamed(types.NewTypeName(0, g.pkg, name, nil), nil, nil))
+	g.printf("// %s is a mock of the %s interface.\n", name, iname)
+	g.printf("type %s struct {\n", name)
+	for _, method := range methods {
+		g.printf("%sFunc func%s\n", method.Name(), g.signature(method.Type().(*types.Signature)))
+	}
+	g.printf("\ncalls\n}\n\n")
+
+	for _, method := range methods {
+		if err := g.method(name, method); err != nil {
+			return fmt.Errorf("%s: %w", method.Name(), err)
+		}
+	}
+	g.printf("var _ %s = (*%s)(nil)\n\n", iname, name)
+
+	return nil
+}
+
+func (g *generator) method(mock string, method *types.Func) error {
+	sig := method.Type().(*types.Signature)
+	results := sig.Results()
+	if results.Len() == 0 || g.typeString(results.At(results.Len()-1).Type()) != "error" {
+		return errNoError
+	}
+
+	names := paramNames(sig)
+	args := make([]string, len(names))
+	copy(args, names)
+	if sig.Variadic() {
+		args[len(args)-1] += "..."
+	}
+
+	g.printf("// %s calls %sFunc.\n", method.Name(), method.Name())
+	g.printf("func (%s *%s) %s%s {\n", receiver, mock, method.Name(), g.signature(sig))
+	g.printf("%s.record(%q", receiver, method.Name())
+	for _, name := range names {
+		g.printf(", %s", name)
+	}
+	g.printf(")\n")
+
+	g.printf("if %s.%sFunc == nil {\n", receiver, method.Name())
+	zeros := make([]string, 0, results.Len())
+	for i := 0; i < results.Len()-1; i++ {
+		zeros = append(zeros, zeroValue(g.typeString(results.At(i).Type()), results.At(i).Type()))
+	}
+	zeros = append(zeros, fmt.Sprintf("unexpectedCall(%q, %q)", mock, method.Name()))
+	g.printf("return %s\n}\n\n", strings.Join(zeros, ", "))
+	g.printf("return %s.%sFunc(%s)\n}\n\n", receiver, method.Name(), strings.Join(args, ", "))
+
+	return nil
+}
+
+// signature returns the parameters and the results of the signature with the parameter names of paramNames.
+func (g *generator) signature(sig *types.Signature) string {
+	names := paramNames(sig)
+	params := make([]string, sig.Params().Len())
+	for i := range params {
+		t := sig.Params().At(i).Type()
+		if sig.Variadic() && i == len(params)-1 {
+			params[i] = names[i] + " ..." + g.typeString(t.(*types.Slice).Elem())
+			continue
+		}
+		params[i] = names[i] + " " + g.typeString(t)
+	}
+
+	results := make([]string, sig.Results().Len())
+	for i := range results {
+		results[i] = g.typeString(sig.Results().At(i).Type())
+	}
+
+	s := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+		return s
+	case 1:
+		return s + " " + results[0]
+	default:
+		return s + " (" + strings.Join(results, ", ") + ")"
+	}
+}
+
+// paramNames returns the names of the parameters of the signature. The unnamed parameters are named ctx
+// if they are contexts, and after their position otherwise.
+func paramNames(sig *types.Signature) []string {
+	names := make([]string, sig.Params().Len())
+	used := map[string]bool{receiver: true}
+	for i := range names {
+		param := sig.Params().At(i)
+		name := param.Name()
+		if name == "" || name == "_" {
+			name = "arg" + strconv.Itoa(i)
+			if types.TypeString(param.Type(), nil) == "context.Context" {
+				name = "ctx"
+			}
+		}
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+		names[i] = name
+	}
+
+	return names
+}
+
+func zeroValue(typeString string, t types.Type) string {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		case u.Info()&types.IsString != 0:
+			return `""`
+		default:
+			return "0"
+		}
+	case *types.Struct, *types.Array:
+		return typeString + "{}"
+	default:
+		return "nil"
+	}
+}
+
+func (g *generator) source() ([]byte, error) {
+	var std, other, local []string
+	for importPath, name := range g.imports {
+		spec := strconv.Quote(importPath)
+		if path.Base(importPath) != name {
+			spec = name + " " + spec
+		}
+		switch {
+		case strings.HasPrefix(importPath, "github.com/Edge-Center/"):
+			local = append(local, spec)
+		case !strings.Contains(strings.Split(importPath, "/")[0], "."):
+			std = append(std, spec)
+		default:
+			other = append(other, spec)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by genmocks. DO NOT EDIT.\n\npackage mocks\n\nimport (\n")
+	for _, group := range [][]string{std, other, local} {
+		if len(group) == 0 {
+			continue
+		}
+		sort.Strings(group)
+		buf.WriteString(strings.Join(group, "\n") + "\n\n")
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(g.buf.Bytes())
+
+	return format.Source(buf.Bytes())
+}
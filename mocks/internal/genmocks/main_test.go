@@ -0,0 +1,18 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	code, err := generate("../../..")
+	require.NoError(t, err)
+
+	generated, err := os.ReadFile("../../mocks_gen.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(code), string(generated), "the mocks are out of date, run go generate ./mocks")
+}
@@ -0,0 +1,88 @@
+// Package mocks provides programmable mocks of the service interfaces of the edgecloud package, so that
+// the code using the client can be unit tested without an API server.
+//
+// Every mock has a <Method>Func field for each method of its interface, including the methods of
+// the embedded interfaces. A method calls its function, or returns ErrUnexpectedCall if the function
+// is not set. The calls are recorded, so that they can be checked afterwards:
+//
+//	instances := &mocks.InstancesService{
+//		GetFunc: func(ctx context.Context, id string) (*edgecloud.Instance, *edgecloud.Response, error) {
+//			return &edgecloud.Instance{ID: id, Status: "ACTIVE"}, nil, nil
+//		},
+//	}
+//	client.Instances = instances
+//
+//	...
+//
+//	calls := instances.CallsTo("Get")
+//
+// The mocks are generated from the interfaces with go generate, and a compile-time check makes the build
+// fail when a mock does not implement its interface anymore.
+package mocks
+
+//go:generate go run ./internal/genmocks -src ../ -o mocks_gen.go
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrUnexpectedCall is returned by the methods of the mocks whose function is not set.
+var ErrUnexpectedCall = errors.New("unexpected call of mock method")
+
+// Call is a recorded call of a mock method.
+type Call struct {
+	// Method is the name of the called method.
+	Method string
+	// Args are the arguments of the call, including the context.
+	Args []interface{}
+}
+
+// calls records the calls of a mock. It is embedded in every mock.
+type calls struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (c *calls) record(method string, args ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls of the mock methods in the order they were made.
+func (c *calls) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Call(nil), c.calls...)
+}
+
+// CallsTo returns the calls of the method in the order they were made.
+func (c *calls) CallsTo(method string) []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var calls []Call
+	for _, call := range c.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// ResetCalls forgets the recorded calls.
+func (c *calls) ResetCalls() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = nil
+}
+
+func unexpectedCall(mock, method string) error {
+	return fmt.Errorf("%w %s.%s", ErrUnexpectedCall, mock, method)
+}
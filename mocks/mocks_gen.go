@@ -0,0 +1,5145 @@
+// Code generated by genmocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// AvailabilityZonesService is a mock of the edgecloud.AvailabilityZonesService interface.
+type AvailabilityZonesService struct {
+	ListFunc func(ctx context.Context) (*edgecloud.AvailabilityZonesList, *edgecloud.Response, error)
+
+	calls
+}
+
+// List calls ListFunc.
+func (m *AvailabilityZonesService) List(ctx context.Context) (*edgecloud.AvailabilityZonesList, *edgecloud.Response, error) {
+	m.record("List", ctx)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("AvailabilityZonesService", "List")
+	}
+
+	return m.ListFunc(ctx)
+}
+
+var _ edgecloud.AvailabilityZonesService = (*AvailabilityZonesService)(nil)
+
+// BareMetalService is a mock of the edgecloud.BareMetalService interface.
+type BareMetalService struct {
+	BareMetalCheckQuotasForInstanceCreationFunc func(ctx context.Context, arg1 *edgecloud.BareMetalQuotaCheckRequest) (edgecloud.Quota, *edgecloud.Response, error)
+	BareMetalCreateInstanceFunc                 func(ctx context.Context, arg1 *edgecloud.BareMetalServerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	BareMetalGetCountAvailableNodesFunc         func(ctx context.Context) (*edgecloud.BareMetalCapacity, *edgecloud.Response, error)
+	BareMetalListFlavorsFunc                    func(ctx context.Context, arg1 *edgecloud.BareMetalFlavorsOpts, arg2 *edgecloud.BareMetalFlavorsRequest) ([]edgecloud.BareMetalFlavor, *edgecloud.Response, error)
+	BareMetalListInstancesFunc                  func(ctx context.Context, arg1 *edgecloud.BareMetalInstancesListOpts) ([]edgecloud.Instance, *edgecloud.Response, error)
+	BareMetalRebuildInstanceFunc                func(ctx context.Context, arg1 string, arg2 *edgecloud.BareMetalRebuildRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// BareMetalCheckQuotasForInstanceCreation calls BareMetalCheckQuotasForInstanceCreationFunc.
+func (m *BareMetalService) BareMetalCheckQuotasForInstanceCreation(ctx context.Context, arg1 *edgecloud.BareMetalQuotaCheckRequest) (edgecloud.Quota, *edgecloud.Response, error) {
+	m.record("BareMetalCheckQuotasForInstanceCreation", ctx, arg1)
+	if m.BareMetalCheckQuotasForInstanceCreationFunc == nil {
+		return nil, nil, unexpectedCall("BareMetalService", "BareMetalCheckQuotasForInstanceCreation")
+	}
+
+	return m.BareMetalCheckQuotasForInstanceCreationFunc(ctx, arg1)
+}
+
+// BareMetalCreateInstance calls BareMetalCreateInstanceFunc.
+func (m *BareMetalService) BareMetalCreateInstance(ctx context.Context, arg1 *edgecloud.BareMetalServerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("BareMetalCreateInstance", ctx, arg1)
+	if m.BareMetalCreateInstanceFunc == nil {
+		return nil, nil, unexpectedCall("BareMetalService", "BareMetalCreateInstance")
+	}
+
+	return m.BareMetalCreateInstanceFunc(ctx, arg1)
+}
+
+// BareMetalGetCountAvailableNodes calls BareMetalGetCountAvailableNodesFunc.
+func (m *BareMetalService) BareMetalGetCountAvailableNodes(ctx context.Context) (*edgecloud.BareMetalCapacity, *edgecloud.Response, error) {
+	m.record("BareMetalGetCountAvailableNodes", ctx)
+	if m.BareMetalGetCountAvailableNodesFunc == nil {
+		return nil, nil, unexpectedCall("BareMetalService", "BareMetalGetCountAvailableNodes")
+	}
+
+	return m.BareMetalGetCountAvailableNodesFunc(ctx)
+}
+
+// BareMetalListFlavors calls BareMetalListFlavorsFunc.
+func (m *BareMetalService) BareMetalListFlavors(ctx context.Context, arg1 *edgecloud.BareMetalFlavorsOpts, arg2 *edgecloud.BareMetalFlavorsRequest) ([]edgecloud.BareMetalFlavor, *edgecloud.Response, error) {
+	m.record("BareMetalListFlavors", ctx, arg1, arg2)
+	if m.BareMetalListFlavorsFunc == nil {
+		return nil, nil, unexpectedCall("BareMetalService", "BareMetalListFlavors")
+	}
+
+	return m.BareMetalListFlavorsFunc(ctx, arg1, arg2)
+}
+
+// BareMetalListInstances calls BareMetalListInstancesFunc.
+func (m *BareMetalService) BareMetalListInstances(ctx context.Context, arg1 *edgecloud.BareMetalInstancesListOpts) ([]edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("BareMetalListInstances", ctx, arg1)
+	if m.BareMetalListInstancesFunc == nil {
+		return nil, nil, unexpectedCall("BareMetalService", "BareMetalListInstances")
+	}
+
+	return m.BareMetalListInstancesFunc(ctx, arg1)
+}
+
+// BareMetalRebuildInstance calls BareMetalRebuildInstanceFunc.
+func (m *BareMetalService) BareMetalRebuildInstance(ctx context.Context, arg1 string, arg2 *edgecloud.BareMetalRebuildRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("BareMetalRebuildInstance", ctx, arg1, arg2)
+	if m.BareMetalRebuildInstanceFunc == nil {
+		return nil, nil, unexpectedCall("BareMetalService", "BareMetalRebuildInstance")
+	}
+
+	return m.BareMetalRebuildInstanceFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.BareMetalService = (*BareMetalService)(nil)
+
+// DBaaSBackups is a mock of the edgecloud.DBaaSBackups interface.
+type DBaaSBackups struct {
+	BackupCreateFunc    func(ctx context.Context, reqBody edgecloud.DBaaSBackupCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	BackupDeleteFunc    func(ctx context.Context, backupID string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	BackupGetFunc       func(ctx context.Context, backupID string, includePrices bool) (*edgecloud.DBaaSBackup, *edgecloud.Response, error)
+	BackupUpdateFunc    func(ctx context.Context, backupID string, reqBody edgecloud.DBaaSBackupUpdateRequest) (*edgecloud.DBaaSBackup, *edgecloud.Response, error)
+	BackupsListFunc     func(ctx context.Context, opts *edgecloud.DBaaSBackupListOptions) ([]edgecloud.DBaaSBackup, *edgecloud.Response, error)
+	BackupsListPageFunc func(ctx context.Context, opts *edgecloud.DBaaSBackupListOptions) (*edgecloud.DBaaSBackupsPage, *edgecloud.Response, error)
+
+	calls
+}
+
+// BackupCreate calls BackupCreateFunc.
+func (m *DBaaSBackups) BackupCreate(ctx context.Context, reqBody edgecloud.DBaaSBackupCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("BackupCreate", ctx, reqBody)
+	if m.BackupCreateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSBackups", "BackupCreate")
+	}
+
+	return m.BackupCreateFunc(ctx, reqBody)
+}
+
+// BackupDelete calls BackupDeleteFunc.
+func (m *DBaaSBackups) BackupDelete(ctx context.Context, backupID string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("BackupDelete", ctx, backupID)
+	if m.BackupDeleteFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSBackups", "BackupDelete")
+	}
+
+	return m.BackupDeleteFunc(ctx, backupID)
+}
+
+// BackupGet calls BackupGetFunc.
+func (m *DBaaSBackups) BackupGet(ctx context.Context, backupID string, includePrices bool) (*edgecloud.DBaaSBackup, *edgecloud.Response, error) {
+	m.record("BackupGet", ctx, backupID, includePrices)
+	if m.BackupGetFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSBackups", "BackupGet")
+	}
+
+	return m.BackupGetFunc(ctx, backupID, includePrices)
+}
+
+// BackupUpdate calls BackupUpdateFunc.
+func (m *DBaaSBackups) BackupUpdate(ctx context.Context, backupID string, reqBody edgecloud.DBaaSBackupUpdateRequest) (*edgecloud.DBaaSBackup, *edgecloud.Response, error) {
+	m.record("BackupUpdate", ctx, backupID, reqBody)
+	if m.BackupUpdateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSBackups", "BackupUpdate")
+	}
+
+	return m.BackupUpdateFunc(ctx, backupID, reqBody)
+}
+
+// BackupsList calls BackupsListFunc.
+func (m *DBaaSBackups) BackupsList(ctx context.Context, opts *edgecloud.DBaaSBackupListOptions) ([]edgecloud.DBaaSBackup, *edgecloud.Response, error) {
+	m.record("BackupsList", ctx, opts)
+	if m.BackupsListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSBackups", "BackupsList")
+	}
+
+	return m.BackupsListFunc(ctx, opts)
+}
+
+// BackupsListPage calls BackupsListPageFunc.
+func (m *DBaaSBackups) BackupsListPage(ctx context.Context, opts *edgecloud.DBaaSBackupListOptions) (*edgecloud.DBaaSBackupsPage, *edgecloud.Response, error) {
+	m.record("BackupsListPage", ctx, opts)
+	if m.BackupsListPageFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSBackups", "BackupsListPage")
+	}
+
+	return m.BackupsListPageFunc(ctx, opts)
+}
+
+var _ edgecloud.DBaaSBackups = (*DBaaSBackups)(nil)
+
+// DBaaSClusters is a mock of the edgecloud.DBaaSClusters interface.
+type DBaaSClusters struct {
+	ClusterCreateFunc              func(ctx context.Context, arg1 edgecloud.DBaaSClusterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterDeleteFunc              func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterGetFunc                 func(ctx context.Context, arg1 string) (*edgecloud.DBaaSCluster, *edgecloud.Response, error)
+	ClusterUpdateFunc              func(ctx context.Context, arg1 string, arg2 edgecloud.DBaaSClusterUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterUpdateAccessControlFunc func(ctx context.Context, arg1 string, arg2 edgecloud.DBaaSClusterAccessControlUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClustersListFunc               func(ctx context.Context, arg1 *edgecloud.DBaaSClusterListOptions) ([]edgecloud.DBaaSCluster, *edgecloud.Response, error)
+
+	calls
+}
+
+// ClusterCreate calls ClusterCreateFunc.
+func (m *DBaaSClusters) ClusterCreate(ctx context.Context, arg1 edgecloud.DBaaSClusterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterCreate", ctx, arg1)
+	if m.ClusterCreateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSClusters", "ClusterCreate")
+	}
+
+	return m.ClusterCreateFunc(ctx, arg1)
+}
+
+// ClusterDelete calls ClusterDeleteFunc.
+func (m *DBaaSClusters) ClusterDelete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterDelete", ctx, arg1)
+	if m.ClusterDeleteFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSClusters", "ClusterDelete")
+	}
+
+	return m.ClusterDeleteFunc(ctx, arg1)
+}
+
+// ClusterGet calls ClusterGetFunc.
+func (m *DBaaSClusters) ClusterGet(ctx context.Context, arg1 string) (*edgecloud.DBaaSCluster, *edgecloud.Response, error) {
+	m.record("ClusterGet", ctx, arg1)
+	if m.ClusterGetFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSClusters", "ClusterGet")
+	}
+
+	return m.ClusterGetFunc(ctx, arg1)
+}
+
+// ClusterUpdate calls ClusterUpdateFunc.
+func (m *DBaaSClusters) ClusterUpdate(ctx context.Context, arg1 string, arg2 edgecloud.DBaaSClusterUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpdate", ctx, arg1, arg2)
+	if m.ClusterUpdateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSClusters", "ClusterUpdate")
+	}
+
+	return m.ClusterUpdateFunc(ctx, arg1, arg2)
+}
+
+// ClusterUpdateAccessControl calls ClusterUpdateAccessControlFunc.
+func (m *DBaaSClusters) ClusterUpdateAccessControl(ctx context.Context, arg1 string, arg2 edgecloud.DBaaSClusterAccessControlUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpdateAccessControl", ctx, arg1, arg2)
+	if m.ClusterUpdateAccessControlFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSClusters", "ClusterUpdateAccessControl")
+	}
+
+	return m.ClusterUpdateAccessControlFunc(ctx, arg1, arg2)
+}
+
+// ClustersList calls ClustersListFunc.
+func (m *DBaaSClusters) ClustersList(ctx context.Context, arg1 *edgecloud.DBaaSClusterListOptions) ([]edgecloud.DBaaSCluster, *edgecloud.Response, error) {
+	m.record("ClustersList", ctx, arg1)
+	if m.ClustersListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSClusters", "ClustersList")
+	}
+
+	return m.ClustersListFunc(ctx, arg1)
+}
+
+var _ edgecloud.DBaaSClusters = (*DBaaSClusters)(nil)
+
+// DBaaSDatabases is a mock of the edgecloud.DBaaSDatabases interface.
+type DBaaSDatabases struct {
+	DatabaseCreateFunc func(ctx context.Context, clusterID string, reqBody edgecloud.DBaaSDatabaseCreateRequest) (*edgecloud.DBaaSDatabase, *edgecloud.Response, error)
+	DatabaseDeleteFunc func(ctx context.Context, clusterID string, databaseName string) (*edgecloud.DBaaSDatabase, *edgecloud.Response, error)
+	DatabasesListFunc  func(ctx context.Context, clusterID string, opts *edgecloud.DBaaSDatabaseListOptions) ([]edgecloud.DBaaSDatabase, *edgecloud.Response, error)
+
+	calls
+}
+
+// DatabaseCreate calls DatabaseCreateFunc.
+func (m *DBaaSDatabases) DatabaseCreate(ctx context.Context, clusterID string, reqBody edgecloud.DBaaSDatabaseCreateRequest) (*edgecloud.DBaaSDatabase, *edgecloud.Response, error) {
+	m.record("DatabaseCreate", ctx, clusterID, reqBody)
+	if m.DatabaseCreateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSDatabases", "DatabaseCreate")
+	}
+
+	return m.DatabaseCreateFunc(ctx, clusterID, reqBody)
+}
+
+// DatabaseDelete calls DatabaseDeleteFunc.
+func (m *DBaaSDatabases) DatabaseDelete(ctx context.Context, clusterID string, databaseName string) (*edgecloud.DBaaSDatabase, *edgecloud.Response, error) {
+	m.record("DatabaseDelete", ctx, clusterID, databaseName)
+	if m.DatabaseDeleteFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSDatabases", "DatabaseDelete")
+	}
+
+	return m.DatabaseDeleteFunc(ctx, clusterID, databaseName)
+}
+
+// DatabasesList calls DatabasesListFunc.
+func (m *DBaaSDatabases) DatabasesList(ctx context.Context, clusterID string, opts *edgecloud.DBaaSDatabaseListOptions) ([]edgecloud.DBaaSDatabase, *edgecloud.Response, error) {
+	m.record("DatabasesList", ctx, clusterID, opts)
+	if m.DatabasesListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSDatabases", "DatabasesList")
+	}
+
+	return m.DatabasesListFunc(ctx, clusterID, opts)
+}
+
+var _ edgecloud.DBaaSDatabases = (*DBaaSDatabases)(nil)
+
+// DBaaSDbmsService is a mock of the edgecloud.DBaaSDbmsService interface.
+type DBaaSDbmsService struct {
+	DbmsListFunc func(ctx context.Context, opts *edgecloud.DBaaSDbmsListOptions) ([]edgecloud.DBaaSDbms, *edgecloud.Response, error)
+
+	calls
+}
+
+// DbmsList calls DbmsListFunc.
+func (m *DBaaSDbmsService) DbmsList(ctx context.Context, opts *edgecloud.DBaaSDbmsListOptions) ([]edgecloud.DBaaSDbms, *edgecloud.Response, error) {
+	m.record("DbmsList", ctx, opts)
+	if m.DbmsListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSDbmsService", "DbmsList")
+	}
+
+	return m.DbmsListFunc(ctx, opts)
+}
+
+var _ edgecloud.DBaaSDbmsService = (*DBaaSDbmsService)(nil)
+
+// DBaaSService is a mock of the edgecloud.DBaaSService interface.
+type DBaaSService struct {
+	BackupCreateFunc               func(ctx context.Context, reqBody edgecloud.DBaaSBackupCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	BackupDeleteFunc               func(ctx context.Context, backupID string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	BackupGetFunc                  func(ctx context.Context, backupID string, includePrices bool) (*edgecloud.DBaaSBackup, *edgecloud.Response, error)
+	BackupUpdateFunc               func(ctx context.Context, backupID string, reqBody edgecloud.DBaaSBackupUpdateRequest) (*edgecloud.DBaaSBackup, *edgecloud.Response, error)
+	BackupsListFunc                func(ctx context.Context, opts *edgecloud.DBaaSBackupListOptions) ([]edgecloud.DBaaSBackup, *edgecloud.Response, error)
+	BackupsListPageFunc            func(ctx context.Context, opts *edgecloud.DBaaSBackupListOptions) (*edgecloud.DBaaSBackupsPage, *edgecloud.Response, error)
+	ClusterCreateFunc              func(ctx context.Context, arg1 edgecloud.DBaaSClusterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterDeleteFunc              func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterGetFunc                 func(ctx context.Context, arg1 string) (*edgecloud.DBaaSCluster, *edgecloud.Response, error)
+	ClusterUpdateFunc              func(ctx context.Context, arg1 string, arg2 edgecloud.DBaaSClusterUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterUpdateAccessControlFunc func(ctx context.Context, arg1 string, arg2 edgecloud.DBaaSClusterAccessControlUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClustersListFunc               func(ctx context.Context, arg1 *edgecloud.DBaaSClusterListOptions) ([]edgecloud.DBaaSCluster, *edgecloud.Response, error)
+	DatabaseCreateFunc             func(ctx context.Context, clusterID string, reqBody edgecloud.DBaaSDatabaseCreateRequest) (*edgecloud.DBaaSDatabase, *edgecloud.Response, error)
+	DatabaseDeleteFunc             func(ctx context.Context, clusterID string, databaseName string) (*edgecloud.DBaaSDatabase, *edgecloud.Response, error)
+	DatabasesListFunc              func(ctx context.Context, clusterID string, opts *edgecloud.DBaaSDatabaseListOptions) ([]edgecloud.DBaaSDatabase, *edgecloud.Response, error)
+	DbmsListFunc                   func(ctx context.Context, opts *edgecloud.DBaaSDbmsListOptions) ([]edgecloud.DBaaSDbms, *edgecloud.Response, error)
+	UserCreateFunc                 func(ctx context.Context, clusterID string, reqBody edgecloud.DBaaSUserCreateRequest) (*edgecloud.DBaaSUser, *edgecloud.Response, error)
+	UserDeleteFunc                 func(ctx context.Context, clusterID string, username string) (*edgecloud.Response, error)
+	UserGetFunc                    func(ctx context.Context, clusterID string, username string) (*edgecloud.DBaaSUser, *edgecloud.Response, error)
+	UserGrantAccessFunc            func(ctx context.Context, clusterID string, username string, database string) (*edgecloud.Response, error)
+	UserRevokeAccessFunc           func(ctx context.Context, clusterID string, username string, database string) (*edgecloud.Response, error)
+	UserUpdateFunc                 func(ctx context.Context, clusterID string, username string, reqBody edgecloud.DBaaSUserUpdateRequest) (*edgecloud.Response, error)
+	UsersListFunc                  func(ctx context.Context, clusterID string, opts *edgecloud.DBaaSUserListOptions) ([]edgecloud.DBaaSUser, *edgecloud.Response, error)
+
+	calls
+}
+
+// BackupCreate calls BackupCreateFunc.
+func (m *DBaaSService) BackupCreate(ctx context.Context, reqBody edgecloud.DBaaSBackupCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("BackupCreate", ctx, reqBody)
+	if m.BackupCreateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "BackupCreate")
+	}
+
+	return m.BackupCreateFunc(ctx, reqBody)
+}
+
+// BackupDelete calls BackupDeleteFunc.
+func (m *DBaaSService) BackupDelete(ctx context.Context, backupID string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("BackupDelete", ctx, backupID)
+	if m.BackupDeleteFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "BackupDelete")
+	}
+
+	return m.BackupDeleteFunc(ctx, backupID)
+}
+
+// BackupGet calls BackupGetFunc.
+func (m *DBaaSService) BackupGet(ctx context.Context, backupID string, includePrices bool) (*edgecloud.DBaaSBackup, *edgecloud.Response, error) {
+	m.record("BackupGet", ctx, backupID, includePrices)
+	if m.BackupGetFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "BackupGet")
+	}
+
+	return m.BackupGetFunc(ctx, backupID, includePrices)
+}
+
+// BackupUpdate calls BackupUpdateFunc.
+func (m *DBaaSService) BackupUpdate(ctx context.Context, backupID string, reqBody edgecloud.DBaaSBackupUpdateRequest) (*edgecloud.DBaaSBackup, *edgecloud.Response, error) {
+	m.record("BackupUpdate", ctx, backupID, reqBody)
+	if m.BackupUpdateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "BackupUpdate")
+	}
+
+	return m.BackupUpdateFunc(ctx, backupID, reqBody)
+}
+
+// BackupsList calls BackupsListFunc.
+func (m *DBaaSService) BackupsList(ctx context.Context, opts *edgecloud.DBaaSBackupListOptions) ([]edgecloud.DBaaSBackup, *edgecloud.Response, error) {
+	m.record("BackupsList", ctx, opts)
+	if m.BackupsListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "BackupsList")
+	}
+
+	return m.BackupsListFunc(ctx, opts)
+}
+
+// BackupsListPage calls BackupsListPageFunc.
+func (m *DBaaSService) BackupsListPage(ctx context.Context, opts *edgecloud.DBaaSBackupListOptions) (*edgecloud.DBaaSBackupsPage, *edgecloud.Response, error) {
+	m.record("BackupsListPage", ctx, opts)
+	if m.BackupsListPageFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "BackupsListPage")
+	}
+
+	return m.BackupsListPageFunc(ctx, opts)
+}
+
+// ClusterCreate calls ClusterCreateFunc.
+func (m *DBaaSService) ClusterCreate(ctx context.Context, arg1 edgecloud.DBaaSClusterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterCreate", ctx, arg1)
+	if m.ClusterCreateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "ClusterCreate")
+	}
+
+	return m.ClusterCreateFunc(ctx, arg1)
+}
+
+// ClusterDelete calls ClusterDeleteFunc.
+func (m *DBaaSService) ClusterDelete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterDelete", ctx, arg1)
+	if m.ClusterDeleteFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "ClusterDelete")
+	}
+
+	return m.ClusterDeleteFunc(ctx, arg1)
+}
+
+// ClusterGet calls ClusterGetFunc.
+func (m *DBaaSService) ClusterGet(ctx context.Context, arg1 string) (*edgecloud.DBaaSCluster, *edgecloud.Response, error) {
+	m.record("ClusterGet", ctx, arg1)
+	if m.ClusterGetFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "ClusterGet")
+	}
+
+	return m.ClusterGetFunc(ctx, arg1)
+}
+
+// ClusterUpdate calls ClusterUpdateFunc.
+func (m *DBaaSService) ClusterUpdate(ctx context.Context, arg1 string, arg2 edgecloud.DBaaSClusterUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpdate", ctx, arg1, arg2)
+	if m.ClusterUpdateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "ClusterUpdate")
+	}
+
+	return m.ClusterUpdateFunc(ctx, arg1, arg2)
+}
+
+// ClusterUpdateAccessControl calls ClusterUpdateAccessControlFunc.
+func (m *DBaaSService) ClusterUpdateAccessControl(ctx context.Context, arg1 string, arg2 edgecloud.DBaaSClusterAccessControlUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpdateAccessControl", ctx, arg1, arg2)
+	if m.ClusterUpdateAccessControlFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "ClusterUpdateAccessControl")
+	}
+
+	return m.ClusterUpdateAccessControlFunc(ctx, arg1, arg2)
+}
+
+// ClustersList calls ClustersListFunc.
+func (m *DBaaSService) ClustersList(ctx context.Context, arg1 *edgecloud.DBaaSClusterListOptions) ([]edgecloud.DBaaSCluster, *edgecloud.Response, error) {
+	m.record("ClustersList", ctx, arg1)
+	if m.ClustersListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "ClustersList")
+	}
+
+	return m.ClustersListFunc(ctx, arg1)
+}
+
+// DatabaseCreate calls DatabaseCreateFunc.
+func (m *DBaaSService) DatabaseCreate(ctx context.Context, clusterID string, reqBody edgecloud.DBaaSDatabaseCreateRequest) (*edgecloud.DBaaSDatabase, *edgecloud.Response, error) {
+	m.record("DatabaseCreate", ctx, clusterID, reqBody)
+	if m.DatabaseCreateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "DatabaseCreate")
+	}
+
+	return m.DatabaseCreateFunc(ctx, clusterID, reqBody)
+}
+
+// DatabaseDelete calls DatabaseDeleteFunc.
+func (m *DBaaSService) DatabaseDelete(ctx context.Context, clusterID string, databaseName string) (*edgecloud.DBaaSDatabase, *edgecloud.Response, error) {
+	m.record("DatabaseDelete", ctx, clusterID, databaseName)
+	if m.DatabaseDeleteFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "DatabaseDelete")
+	}
+
+	return m.DatabaseDeleteFunc(ctx, clusterID, databaseName)
+}
+
+// DatabasesList calls DatabasesListFunc.
+func (m *DBaaSService) DatabasesList(ctx context.Context, clusterID string, opts *edgecloud.DBaaSDatabaseListOptions) ([]edgecloud.DBaaSDatabase, *edgecloud.Response, error) {
+	m.record("DatabasesList", ctx, clusterID, opts)
+	if m.DatabasesListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "DatabasesList")
+	}
+
+	return m.DatabasesListFunc(ctx, clusterID, opts)
+}
+
+// DbmsList calls DbmsListFunc.
+func (m *DBaaSService) DbmsList(ctx context.Context, opts *edgecloud.DBaaSDbmsListOptions) ([]edgecloud.DBaaSDbms, *edgecloud.Response, error) {
+	m.record("DbmsList", ctx, opts)
+	if m.DbmsListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "DbmsList")
+	}
+
+	return m.DbmsListFunc(ctx, opts)
+}
+
+// UserCreate calls UserCreateFunc.
+func (m *DBaaSService) UserCreate(ctx context.Context, clusterID string, reqBody edgecloud.DBaaSUserCreateRequest) (*edgecloud.DBaaSUser, *edgecloud.Response, error) {
+	m.record("UserCreate", ctx, clusterID, reqBody)
+	if m.UserCreateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "UserCreate")
+	}
+
+	return m.UserCreateFunc(ctx, clusterID, reqBody)
+}
+
+// UserDelete calls UserDeleteFunc.
+func (m *DBaaSService) UserDelete(ctx context.Context, clusterID string, username string) (*edgecloud.Response, error) {
+	m.record("UserDelete", ctx, clusterID, username)
+	if m.UserDeleteFunc == nil {
+		return nil, unexpectedCall("DBaaSService", "UserDelete")
+	}
+
+	return m.UserDeleteFunc(ctx, clusterID, username)
+}
+
+// UserGet calls UserGetFunc.
+func (m *DBaaSService) UserGet(ctx context.Context, clusterID string, username string) (*edgecloud.DBaaSUser, *edgecloud.Response, error) {
+	m.record("UserGet", ctx, clusterID, username)
+	if m.UserGetFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "UserGet")
+	}
+
+	return m.UserGetFunc(ctx, clusterID, username)
+}
+
+// UserGrantAccess calls UserGrantAccessFunc.
+func (m *DBaaSService) UserGrantAccess(ctx context.Context, clusterID string, username string, database string) (*edgecloud.Response, error) {
+	m.record("UserGrantAccess", ctx, clusterID, username, database)
+	if m.UserGrantAccessFunc == nil {
+		return nil, unexpectedCall("DBaaSService", "UserGrantAccess")
+	}
+
+	return m.UserGrantAccessFunc(ctx, clusterID, username, database)
+}
+
+// UserRevokeAccess calls UserRevokeAccessFunc.
+func (m *DBaaSService) UserRevokeAccess(ctx context.Context, clusterID string, username string, database string) (*edgecloud.Response, error) {
+	m.record("UserRevokeAccess", ctx, clusterID, username, database)
+	if m.UserRevokeAccessFunc == nil {
+		return nil, unexpectedCall("DBaaSService", "UserRevokeAccess")
+	}
+
+	return m.UserRevokeAccessFunc(ctx, clusterID, username, database)
+}
+
+// UserUpdate calls UserUpdateFunc.
+func (m *DBaaSService) UserUpdate(ctx context.Context, clusterID string, username string, reqBody edgecloud.DBaaSUserUpdateRequest) (*edgecloud.Response, error) {
+	m.record("UserUpdate", ctx, clusterID, username, reqBody)
+	if m.UserUpdateFunc == nil {
+		return nil, unexpectedCall("DBaaSService", "UserUpdate")
+	}
+
+	return m.UserUpdateFunc(ctx, clusterID, username, reqBody)
+}
+
+// UsersList calls UsersListFunc.
+func (m *DBaaSService) UsersList(ctx context.Context, clusterID string, opts *edgecloud.DBaaSUserListOptions) ([]edgecloud.DBaaSUser, *edgecloud.Response, error) {
+	m.record("UsersList", ctx, clusterID, opts)
+	if m.UsersListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSService", "UsersList")
+	}
+
+	return m.UsersListFunc(ctx, clusterID, opts)
+}
+
+var _ edgecloud.DBaaSService = (*DBaaSService)(nil)
+
+// DBaaSUsers is a mock of the edgecloud.DBaaSUsers interface.
+type DBaaSUsers struct {
+	UserCreateFunc       func(ctx context.Context, clusterID string, reqBody edgecloud.DBaaSUserCreateRequest) (*edgecloud.DBaaSUser, *edgecloud.Response, error)
+	UserDeleteFunc       func(ctx context.Context, clusterID string, username string) (*edgecloud.Response, error)
+	UserGetFunc          func(ctx context.Context, clusterID string, username string) (*edgecloud.DBaaSUser, *edgecloud.Response, error)
+	UserGrantAccessFunc  func(ctx context.Context, clusterID string, username string, database string) (*edgecloud.Response, error)
+	UserRevokeAccessFunc func(ctx context.Context, clusterID string, username string, database string) (*edgecloud.Response, error)
+	UserUpdateFunc       func(ctx context.Context, clusterID string, username string, reqBody edgecloud.DBaaSUserUpdateRequest) (*edgecloud.Response, error)
+	UsersListFunc        func(ctx context.Context, clusterID string, opts *edgecloud.DBaaSUserListOptions) ([]edgecloud.DBaaSUser, *edgecloud.Response, error)
+
+	calls
+}
+
+// UserCreate calls UserCreateFunc.
+func (m *DBaaSUsers) UserCreate(ctx context.Context, clusterID string, reqBody edgecloud.DBaaSUserCreateRequest) (*edgecloud.DBaaSUser, *edgecloud.Response, error) {
+	m.record("UserCreate", ctx, clusterID, reqBody)
+	if m.UserCreateFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSUsers", "UserCreate")
+	}
+
+	return m.UserCreateFunc(ctx, clusterID, reqBody)
+}
+
+// UserDelete calls UserDeleteFunc.
+func (m *DBaaSUsers) UserDelete(ctx context.Context, clusterID string, username string) (*edgecloud.Response, error) {
+	m.record("UserDelete", ctx, clusterID, username)
+	if m.UserDeleteFunc == nil {
+		return nil, unexpectedCall("DBaaSUsers", "UserDelete")
+	}
+
+	return m.UserDeleteFunc(ctx, clusterID, username)
+}
+
+// UserGet calls UserGetFunc.
+func (m *DBaaSUsers) UserGet(ctx context.Context, clusterID string, username string) (*edgecloud.DBaaSUser, *edgecloud.Response, error) {
+	m.record("UserGet", ctx, clusterID, username)
+	if m.UserGetFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSUsers", "UserGet")
+	}
+
+	return m.UserGetFunc(ctx, clusterID, username)
+}
+
+// UserGrantAccess calls UserGrantAccessFunc.
+func (m *DBaaSUsers) UserGrantAccess(ctx context.Context, clusterID string, username string, database string) (*edgecloud.Response, error) {
+	m.record("UserGrantAccess", ctx, clusterID, username, database)
+	if m.UserGrantAccessFunc == nil {
+		return nil, unexpectedCall("DBaaSUsers", "UserGrantAccess")
+	}
+
+	return m.UserGrantAccessFunc(ctx, clusterID, username, database)
+}
+
+// UserRevokeAccess calls UserRevokeAccessFunc.
+func (m *DBaaSUsers) UserRevokeAccess(ctx context.Context, clusterID string, username string, database string) (*edgecloud.Response, error) {
+	m.record("UserRevokeAccess", ctx, clusterID, username, database)
+	if m.UserRevokeAccessFunc == nil {
+		return nil, unexpectedCall("DBaaSUsers", "UserRevokeAccess")
+	}
+
+	return m.UserRevokeAccessFunc(ctx, clusterID, username, database)
+}
+
+// UserUpdate calls UserUpdateFunc.
+func (m *DBaaSUsers) UserUpdate(ctx context.Context, clusterID string, username string, reqBody edgecloud.DBaaSUserUpdateRequest) (*edgecloud.Response, error) {
+	m.record("UserUpdate", ctx, clusterID, username, reqBody)
+	if m.UserUpdateFunc == nil {
+		return nil, unexpectedCall("DBaaSUsers", "UserUpdate")
+	}
+
+	return m.UserUpdateFunc(ctx, clusterID, username, reqBody)
+}
+
+// UsersList calls UsersListFunc.
+func (m *DBaaSUsers) UsersList(ctx context.Context, clusterID string, opts *edgecloud.DBaaSUserListOptions) ([]edgecloud.DBaaSUser, *edgecloud.Response, error) {
+	m.record("UsersList", ctx, clusterID, opts)
+	if m.UsersListFunc == nil {
+		return nil, nil, unexpectedCall("DBaaSUsers", "UsersList")
+	}
+
+	return m.UsersListFunc(ctx, clusterID, opts)
+}
+
+var _ edgecloud.DBaaSUsers = (*DBaaSUsers)(nil)
+
+// FlavorsService is a mock of the edgecloud.FlavorsService interface.
+type FlavorsService struct {
+	ListFunc                   func(ctx context.Context, arg1 *edgecloud.FlavorListOptions) ([]edgecloud.Flavor, *edgecloud.Response, error)
+	ListBaremetalFunc          func(ctx context.Context, arg1 *edgecloud.FlavorListOptions) ([]edgecloud.Flavor, *edgecloud.Response, error)
+	ListBaremetalForClientFunc func(ctx context.Context, arg1 *edgecloud.FlavorListOptions) ([]edgecloud.Flavor, *edgecloud.Response, error)
+
+	calls
+}
+
+// List calls ListFunc.
+func (m *FlavorsService) List(ctx context.Context, arg1 *edgecloud.FlavorListOptions) ([]edgecloud.Flavor, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("FlavorsService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// ListBaremetal calls ListBaremetalFunc.
+func (m *FlavorsService) ListBaremetal(ctx context.Context, arg1 *edgecloud.FlavorListOptions) ([]edgecloud.Flavor, *edgecloud.Response, error) {
+	m.record("ListBaremetal", ctx, arg1)
+	if m.ListBaremetalFunc == nil {
+		return nil, nil, unexpectedCall("FlavorsService", "ListBaremetal")
+	}
+
+	return m.ListBaremetalFunc(ctx, arg1)
+}
+
+// ListBaremetalForClient calls ListBaremetalForClientFunc.
+func (m *FlavorsService) ListBaremetalForClient(ctx context.Context, arg1 *edgecloud.FlavorListOptions) ([]edgecloud.Flavor, *edgecloud.Response, error) {
+	m.record("ListBaremetalForClient", ctx, arg1)
+	if m.ListBaremetalForClientFunc == nil {
+		return nil, nil, unexpectedCall("FlavorsService", "ListBaremetalForClient")
+	}
+
+	return m.ListBaremetalForClientFunc(ctx, arg1)
+}
+
+var _ edgecloud.FlavorsService = (*FlavorsService)(nil)
+
+// FloatingIPMetadata is a mock of the edgecloud.FloatingIPMetadata interface.
+type FloatingIPMetadata struct {
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+
+	calls
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *FloatingIPMetadata) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("FloatingIPMetadata", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *FloatingIPMetadata) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("FloatingIPMetadata", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *FloatingIPMetadata) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPMetadata", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *FloatingIPMetadata) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPMetadata", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *FloatingIPMetadata) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("FloatingIPMetadata", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.FloatingIPMetadata = (*FloatingIPMetadata)(nil)
+
+// FloatingIPsService is a mock of the edgecloud.FloatingIPsService interface.
+type FloatingIPsService struct {
+	AssignFunc             func(ctx context.Context, arg1 string, arg2 *edgecloud.AssignFloatingIPRequest) (*edgecloud.FloatingIP, *edgecloud.Response, error)
+	CreateFunc             func(ctx context.Context, arg1 *edgecloud.FloatingIPCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc             func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc                func(ctx context.Context, arg1 string) (*edgecloud.FloatingIP, *edgecloud.Response, error)
+	ListFunc               func(ctx context.Context) ([]edgecloud.FloatingIP, *edgecloud.Response, error)
+	ListAvailableFunc      func(ctx context.Context) ([]edgecloud.FloatingIP, *edgecloud.Response, error)
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	UnAssignFunc           func(ctx context.Context, arg1 string) (*edgecloud.FloatingIP, *edgecloud.Response, error)
+
+	calls
+}
+
+// Assign calls AssignFunc.
+func (m *FloatingIPsService) Assign(ctx context.Context, arg1 string, arg2 *edgecloud.AssignFloatingIPRequest) (*edgecloud.FloatingIP, *edgecloud.Response, error) {
+	m.record("Assign", ctx, arg1, arg2)
+	if m.AssignFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPsService", "Assign")
+	}
+
+	return m.AssignFunc(ctx, arg1, arg2)
+}
+
+// Create calls CreateFunc.
+func (m *FloatingIPsService) Create(ctx context.Context, arg1 *edgecloud.FloatingIPCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPsService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *FloatingIPsService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPsService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *FloatingIPsService) Get(ctx context.Context, arg1 string) (*edgecloud.FloatingIP, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPsService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *FloatingIPsService) List(ctx context.Context) ([]edgecloud.FloatingIP, *edgecloud.Response, error) {
+	m.record("List", ctx)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPsService", "List")
+	}
+
+	return m.ListFunc(ctx)
+}
+
+// ListAvailable calls ListAvailableFunc.
+func (m *FloatingIPsService) ListAvailable(ctx context.Context) ([]edgecloud.FloatingIP, *edgecloud.Response, error) {
+	m.record("ListAvailable", ctx)
+	if m.ListAvailableFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPsService", "ListAvailable")
+	}
+
+	return m.ListAvailableFunc(ctx)
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *FloatingIPsService) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("FloatingIPsService", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *FloatingIPsService) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("FloatingIPsService", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *FloatingIPsService) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPsService", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *FloatingIPsService) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPsService", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *FloatingIPsService) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("FloatingIPsService", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+// UnAssign calls UnAssignFunc.
+func (m *FloatingIPsService) UnAssign(ctx context.Context, arg1 string) (*edgecloud.FloatingIP, *edgecloud.Response, error) {
+	m.record("UnAssign", ctx, arg1)
+	if m.UnAssignFunc == nil {
+		return nil, nil, unexpectedCall("FloatingIPsService", "UnAssign")
+	}
+
+	return m.UnAssignFunc(ctx, arg1)
+}
+
+var _ edgecloud.FloatingIPsService = (*FloatingIPsService)(nil)
+
+// ImagesBaremetal is a mock of the edgecloud.ImagesBaremetal interface.
+type ImagesBaremetal struct {
+	ImagesBaremetalCreateFunc func(ctx context.Context, arg1 *edgecloud.ImageCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ImagesBaremetalListFunc   func(ctx context.Context, arg1 *edgecloud.ImageListOptions) ([]edgecloud.Image, *edgecloud.Response, error)
+
+	calls
+}
+
+// ImagesBaremetalCreate calls ImagesBaremetalCreateFunc.
+func (m *ImagesBaremetal) ImagesBaremetalCreate(ctx context.Context, arg1 *edgecloud.ImageCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ImagesBaremetalCreate", ctx, arg1)
+	if m.ImagesBaremetalCreateFunc == nil {
+		return nil, nil, unexpectedCall("ImagesBaremetal", "ImagesBaremetalCreate")
+	}
+
+	return m.ImagesBaremetalCreateFunc(ctx, arg1)
+}
+
+// ImagesBaremetalList calls ImagesBaremetalListFunc.
+func (m *ImagesBaremetal) ImagesBaremetalList(ctx context.Context, arg1 *edgecloud.ImageListOptions) ([]edgecloud.Image, *edgecloud.Response, error) {
+	m.record("ImagesBaremetalList", ctx, arg1)
+	if m.ImagesBaremetalListFunc == nil {
+		return nil, nil, unexpectedCall("ImagesBaremetal", "ImagesBaremetalList")
+	}
+
+	return m.ImagesBaremetalListFunc(ctx, arg1)
+}
+
+var _ edgecloud.ImagesBaremetal = (*ImagesBaremetal)(nil)
+
+// ImagesMetadata is a mock of the edgecloud.ImagesMetadata interface.
+type ImagesMetadata struct {
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+
+	calls
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *ImagesMetadata) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("ImagesMetadata", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *ImagesMetadata) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("ImagesMetadata", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *ImagesMetadata) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("ImagesMetadata", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *ImagesMetadata) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("ImagesMetadata", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *ImagesMetadata) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("ImagesMetadata", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.ImagesMetadata = (*ImagesMetadata)(nil)
+
+// ImagesProject is a mock of the edgecloud.ImagesProject interface.
+type ImagesProject struct {
+	ImagesProjectListFunc func(ctx context.Context) ([]edgecloud.Image, *edgecloud.Response, error)
+
+	calls
+}
+
+// ImagesProjectList calls ImagesProjectListFunc.
+func (m *ImagesProject) ImagesProjectList(ctx context.Context) ([]edgecloud.Image, *edgecloud.Response, error) {
+	m.record("ImagesProjectList", ctx)
+	if m.ImagesProjectListFunc == nil {
+		return nil, nil, unexpectedCall("ImagesProject", "ImagesProjectList")
+	}
+
+	return m.ImagesProjectListFunc(ctx)
+}
+
+var _ edgecloud.ImagesProject = (*ImagesProject)(nil)
+
+// ImagesService is a mock of the edgecloud.ImagesService interface.
+type ImagesService struct {
+	CreateFunc                func(ctx context.Context, arg1 *edgecloud.ImageCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc                func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc                   func(ctx context.Context, arg1 string) (*edgecloud.Image, *edgecloud.Response, error)
+	ImagesBaremetalCreateFunc func(ctx context.Context, arg1 *edgecloud.ImageCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ImagesBaremetalListFunc   func(ctx context.Context, arg1 *edgecloud.ImageListOptions) ([]edgecloud.Image, *edgecloud.Response, error)
+	ImagesProjectListFunc     func(ctx context.Context) ([]edgecloud.Image, *edgecloud.Response, error)
+	ListFunc                  func(ctx context.Context, arg1 *edgecloud.ImageListOptions) ([]edgecloud.Image, *edgecloud.Response, error)
+	MetadataCreateFunc        func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc       func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc          func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc        func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	UpdateFunc                func(ctx context.Context, arg1 string, arg2 *edgecloud.ImageUpdateRequest) (*edgecloud.Image, *edgecloud.Response, error)
+	UploadFunc                func(ctx context.Context, arg1 *edgecloud.ImageUploadRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *ImagesService) Create(ctx context.Context, arg1 *edgecloud.ImageCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *ImagesService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *ImagesService) Get(ctx context.Context, arg1 string) (*edgecloud.Image, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// ImagesBaremetalCreate calls ImagesBaremetalCreateFunc.
+func (m *ImagesService) ImagesBaremetalCreate(ctx context.Context, arg1 *edgecloud.ImageCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ImagesBaremetalCreate", ctx, arg1)
+	if m.ImagesBaremetalCreateFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "ImagesBaremetalCreate")
+	}
+
+	return m.ImagesBaremetalCreateFunc(ctx, arg1)
+}
+
+// ImagesBaremetalList calls ImagesBaremetalListFunc.
+func (m *ImagesService) ImagesBaremetalList(ctx context.Context, arg1 *edgecloud.ImageListOptions) ([]edgecloud.Image, *edgecloud.Response, error) {
+	m.record("ImagesBaremetalList", ctx, arg1)
+	if m.ImagesBaremetalListFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "ImagesBaremetalList")
+	}
+
+	return m.ImagesBaremetalListFunc(ctx, arg1)
+}
+
+// ImagesProjectList calls ImagesProjectListFunc.
+func (m *ImagesService) ImagesProjectList(ctx context.Context) ([]edgecloud.Image, *edgecloud.Response, error) {
+	m.record("ImagesProjectList", ctx)
+	if m.ImagesProjectListFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "ImagesProjectList")
+	}
+
+	return m.ImagesProjectListFunc(ctx)
+}
+
+// List calls ListFunc.
+func (m *ImagesService) List(ctx context.Context, arg1 *edgecloud.ImageListOptions) ([]edgecloud.Image, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *ImagesService) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("ImagesService", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *ImagesService) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("ImagesService", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *ImagesService) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *ImagesService) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *ImagesService) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("ImagesService", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+// Update calls UpdateFunc.
+func (m *ImagesService) Update(ctx context.Context, arg1 string, arg2 *edgecloud.ImageUpdateRequest) (*edgecloud.Image, *edgecloud.Response, error) {
+	m.record("Update", ctx, arg1, arg2)
+	if m.UpdateFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, arg1, arg2)
+}
+
+// Upload calls UploadFunc.
+func (m *ImagesService) Upload(ctx context.Context, arg1 *edgecloud.ImageUploadRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Upload", ctx, arg1)
+	if m.UploadFunc == nil {
+		return nil, nil, unexpectedCall("ImagesService", "Upload")
+	}
+
+	return m.UploadFunc(ctx, arg1)
+}
+
+var _ edgecloud.ImagesService = (*ImagesService)(nil)
+
+// InstanceAction is a mock of the edgecloud.InstanceAction interface.
+type InstanceAction struct {
+	InstancePowercycleFunc func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceRebootFunc     func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceResumeFunc     func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceStartFunc      func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceStopFunc       func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceSuspendFunc    func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+
+	calls
+}
+
+// InstancePowercycle calls InstancePowercycleFunc.
+func (m *InstanceAction) InstancePowercycle(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstancePowercycle", ctx, arg1)
+	if m.InstancePowercycleFunc == nil {
+		return nil, nil, unexpectedCall("InstanceAction", "InstancePowercycle")
+	}
+
+	return m.InstancePowercycleFunc(ctx, arg1)
+}
+
+// InstanceReboot calls InstanceRebootFunc.
+func (m *InstanceAction) InstanceReboot(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceReboot", ctx, arg1)
+	if m.InstanceRebootFunc == nil {
+		return nil, nil, unexpectedCall("InstanceAction", "InstanceReboot")
+	}
+
+	return m.InstanceRebootFunc(ctx, arg1)
+}
+
+// InstanceResume calls InstanceResumeFunc.
+func (m *InstanceAction) InstanceResume(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceResume", ctx, arg1)
+	if m.InstanceResumeFunc == nil {
+		return nil, nil, unexpectedCall("InstanceAction", "InstanceResume")
+	}
+
+	return m.InstanceResumeFunc(ctx, arg1)
+}
+
+// InstanceStart calls InstanceStartFunc.
+func (m *InstanceAction) InstanceStart(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceStart", ctx, arg1)
+	if m.InstanceStartFunc == nil {
+		return nil, nil, unexpectedCall("InstanceAction", "InstanceStart")
+	}
+
+	return m.InstanceStartFunc(ctx, arg1)
+}
+
+// InstanceStop calls InstanceStopFunc.
+func (m *InstanceAction) InstanceStop(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceStop", ctx, arg1)
+	if m.InstanceStopFunc == nil {
+		return nil, nil, unexpectedCall("InstanceAction", "InstanceStop")
+	}
+
+	return m.InstanceStopFunc(ctx, arg1)
+}
+
+// InstanceSuspend calls InstanceSuspendFunc.
+func (m *InstanceAction) InstanceSuspend(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceSuspend", ctx, arg1)
+	if m.InstanceSuspendFunc == nil {
+		return nil, nil, unexpectedCall("InstanceAction", "InstanceSuspend")
+	}
+
+	return m.InstanceSuspendFunc(ctx, arg1)
+}
+
+var _ edgecloud.InstanceAction = (*InstanceAction)(nil)
+
+// InstanceFlavor is a mock of the edgecloud.InstanceFlavor interface.
+type InstanceFlavor struct {
+	AvailableFlavorsFunc         func(ctx context.Context, arg1 *edgecloud.InstanceCheckFlavorVolumeRequest, arg2 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error)
+	AvailableFlavorsToResizeFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error)
+	UpdateFlavorFunc             func(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceFlavorUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// AvailableFlavors calls AvailableFlavorsFunc.
+func (m *InstanceFlavor) AvailableFlavors(ctx context.Context, arg1 *edgecloud.InstanceCheckFlavorVolumeRequest, arg2 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error) {
+	m.record("AvailableFlavors", ctx, arg1, arg2)
+	if m.AvailableFlavorsFunc == nil {
+		return nil, nil, unexpectedCall("InstanceFlavor", "AvailableFlavors")
+	}
+
+	return m.AvailableFlavorsFunc(ctx, arg1, arg2)
+}
+
+// AvailableFlavorsToResize calls AvailableFlavorsToResizeFunc.
+func (m *InstanceFlavor) AvailableFlavorsToResize(ctx context.Context, arg1 string, arg2 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error) {
+	m.record("AvailableFlavorsToResize", ctx, arg1, arg2)
+	if m.AvailableFlavorsToResizeFunc == nil {
+		return nil, nil, unexpectedCall("InstanceFlavor", "AvailableFlavorsToResize")
+	}
+
+	return m.AvailableFlavorsToResizeFunc(ctx, arg1, arg2)
+}
+
+// UpdateFlavor calls UpdateFlavorFunc.
+func (m *InstanceFlavor) UpdateFlavor(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceFlavorUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("UpdateFlavor", ctx, arg1, arg2)
+	if m.UpdateFlavorFunc == nil {
+		return nil, nil, unexpectedCall("InstanceFlavor", "UpdateFlavor")
+	}
+
+	return m.UpdateFlavorFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.InstanceFlavor = (*InstanceFlavor)(nil)
+
+// InstanceMetadata is a mock of the edgecloud.InstanceMetadata interface.
+type InstanceMetadata struct {
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetFunc        func(ctx context.Context, arg1 string) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+
+	calls
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *InstanceMetadata) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("InstanceMetadata", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *InstanceMetadata) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("InstanceMetadata", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGet calls MetadataGetFunc.
+func (m *InstanceMetadata) MetadataGet(ctx context.Context, arg1 string) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGet", ctx, arg1)
+	if m.MetadataGetFunc == nil {
+		return nil, nil, unexpectedCall("InstanceMetadata", "MetadataGet")
+	}
+
+	return m.MetadataGetFunc(ctx, arg1)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *InstanceMetadata) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("InstanceMetadata", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *InstanceMetadata) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("InstanceMetadata", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *InstanceMetadata) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("InstanceMetadata", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.InstanceMetadata = (*InstanceMetadata)(nil)
+
+// InstanceSecurityGroup is a mock of the edgecloud.InstanceSecurityGroup interface.
+type InstanceSecurityGroup struct {
+	FilterBySecurityGroupFunc func(ctx context.Context, arg1 string) ([]edgecloud.Instance, *edgecloud.Response, error)
+	SecurityGroupAssignFunc   func(ctx context.Context, arg1 string, arg2 *edgecloud.AssignSecurityGroupRequest) (*edgecloud.Response, error)
+	SecurityGroupListFunc     func(ctx context.Context, arg1 string) ([]edgecloud.IDName, *edgecloud.Response, error)
+	SecurityGroupUnAssignFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.AssignSecurityGroupRequest) (*edgecloud.Response, error)
+
+	calls
+}
+
+// FilterBySecurityGroup calls FilterBySecurityGroupFunc.
+func (m *InstanceSecurityGroup) FilterBySecurityGroup(ctx context.Context, arg1 string) ([]edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("FilterBySecurityGroup", ctx, arg1)
+	if m.FilterBySecurityGroupFunc == nil {
+		return nil, nil, unexpectedCall("InstanceSecurityGroup", "FilterBySecurityGroup")
+	}
+
+	return m.FilterBySecurityGroupFunc(ctx, arg1)
+}
+
+// SecurityGroupAssign calls SecurityGroupAssignFunc.
+func (m *InstanceSecurityGroup) SecurityGroupAssign(ctx context.Context, arg1 string, arg2 *edgecloud.AssignSecurityGroupRequest) (*edgecloud.Response, error) {
+	m.record("SecurityGroupAssign", ctx, arg1, arg2)
+	if m.SecurityGroupAssignFunc == nil {
+		return nil, unexpectedCall("InstanceSecurityGroup", "SecurityGroupAssign")
+	}
+
+	return m.SecurityGroupAssignFunc(ctx, arg1, arg2)
+}
+
+// SecurityGroupList calls SecurityGroupListFunc.
+func (m *InstanceSecurityGroup) SecurityGroupList(ctx context.Context, arg1 string) ([]edgecloud.IDName, *edgecloud.Response, error) {
+	m.record("SecurityGroupList", ctx, arg1)
+	if m.SecurityGroupListFunc == nil {
+		return nil, nil, unexpectedCall("InstanceSecurityGroup", "SecurityGroupList")
+	}
+
+	return m.SecurityGroupListFunc(ctx, arg1)
+}
+
+// SecurityGroupUnAssign calls SecurityGroupUnAssignFunc.
+func (m *InstanceSecurityGroup) SecurityGroupUnAssign(ctx context.Context, arg1 string, arg2 *edgecloud.AssignSecurityGroupRequest) (*edgecloud.Response, error) {
+	m.record("SecurityGroupUnAssign", ctx, arg1, arg2)
+	if m.SecurityGroupUnAssignFunc == nil {
+		return nil, unexpectedCall("InstanceSecurityGroup", "SecurityGroupUnAssign")
+	}
+
+	return m.SecurityGroupUnAssignFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.InstanceSecurityGroup = (*InstanceSecurityGroup)(nil)
+
+// InstancesService is a mock of the edgecloud.InstancesService interface.
+type InstancesService struct {
+	AttachInterfaceFunc                         func(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceAttachInterfaceRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	AvailableFlavorsFunc                        func(ctx context.Context, arg1 *edgecloud.InstanceCheckFlavorVolumeRequest, arg2 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error)
+	AvailableFlavorsToResizeFunc                func(ctx context.Context, arg1 string, arg2 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error)
+	AvailableNamesFunc                          func(ctx context.Context) (*edgecloud.InstanceAvailableNames, *edgecloud.Response, error)
+	BareMetalCheckQuotasForInstanceCreationFunc func(ctx context.Context, arg1 *edgecloud.BareMetalQuotaCheckRequest) (edgecloud.Quota, *edgecloud.Response, error)
+	BareMetalCreateInstanceFunc                 func(ctx context.Context, arg1 *edgecloud.BareMetalServerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	BareMetalGetCountAvailableNodesFunc         func(ctx context.Context) (*edgecloud.BareMetalCapacity, *edgecloud.Response, error)
+	BareMetalListFlavorsFunc                    func(ctx context.Context, arg1 *edgecloud.BareMetalFlavorsOpts, arg2 *edgecloud.BareMetalFlavorsRequest) ([]edgecloud.BareMetalFlavor, *edgecloud.Response, error)
+	BareMetalListInstancesFunc                  func(ctx context.Context, arg1 *edgecloud.BareMetalInstancesListOpts) ([]edgecloud.Instance, *edgecloud.Response, error)
+	BareMetalRebuildInstanceFunc                func(ctx context.Context, arg1 string, arg2 *edgecloud.BareMetalRebuildRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	CheckLimitsFunc                             func(ctx context.Context, arg1 *edgecloud.InstanceCheckLimitsRequest) (*map[string]int, *edgecloud.Response, error)
+	CreateFunc                                  func(ctx context.Context, arg1 *edgecloud.InstanceCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc                                  func(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceDeleteOptions) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DetachInterfaceFunc                         func(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceDetachInterfaceRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	FilterBySecurityGroupFunc                   func(ctx context.Context, arg1 string) ([]edgecloud.Instance, *edgecloud.Response, error)
+	GetFunc                                     func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	GetConsoleFunc                              func(ctx context.Context, arg1 string) (*edgecloud.RemoteConsole, *edgecloud.Response, error)
+	InstancePowercycleFunc                      func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceRebootFunc                          func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceResumeFunc                          func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceStartFunc                           func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceStopFunc                            func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InstanceSuspendFunc                         func(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error)
+	InterfaceListFunc                           func(ctx context.Context, arg1 string) ([]edgecloud.InstancePortInterface, *edgecloud.Response, error)
+	ListFunc                                    func(ctx context.Context, arg1 *edgecloud.InstanceListOptions) ([]edgecloud.Instance, *edgecloud.Response, error)
+	MetadataCreateFunc                          func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc                      func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetFunc                             func(ctx context.Context, arg1 string) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataGetItemFunc                         func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc                            func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc                          func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetricsListFunc                             func(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceMetricsListRequest) ([]edgecloud.InstanceMetrics, *edgecloud.Response, error)
+	MigrateFunc                                 func(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceMigrateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PortsListFunc                               func(ctx context.Context, arg1 string) ([]edgecloud.InstancePort, *edgecloud.Response, error)
+	PutIntoServerGroupFunc                      func(ctx context.Context, arg1 string, arg2 *edgecloud.InstancePutIntoServerGroupRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	RemoveFromServerGroupFunc                   func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	RenameFunc                                  func(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Instance, *edgecloud.Response, error)
+	SecurityGroupAssignFunc                     func(ctx context.Context, arg1 string, arg2 *edgecloud.AssignSecurityGroupRequest) (*edgecloud.Response, error)
+	SecurityGroupListFunc                       func(ctx context.Context, arg1 string) ([]edgecloud.IDName, *edgecloud.Response, error)
+	SecurityGroupUnAssignFunc                   func(ctx context.Context, arg1 string, arg2 *edgecloud.AssignSecurityGroupRequest) (*edgecloud.Response, error)
+	UpdateFlavorFunc                            func(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceFlavorUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// AttachInterface calls AttachInterfaceFunc.
+func (m *InstancesService) AttachInterface(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceAttachInterfaceRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("AttachInterface", ctx, arg1, arg2)
+	if m.AttachInterfaceFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "AttachInterface")
+	}
+
+	return m.AttachInterfaceFunc(ctx, arg1, arg2)
+}
+
+// AvailableFlavors calls AvailableFlavorsFunc.
+func (m *InstancesService) AvailableFlavors(ctx context.Context, arg1 *edgecloud.InstanceCheckFlavorVolumeRequest, arg2 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error) {
+	m.record("AvailableFlavors", ctx, arg1, arg2)
+	if m.AvailableFlavorsFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "AvailableFlavors")
+	}
+
+	return m.AvailableFlavorsFunc(ctx, arg1, arg2)
+}
+
+// AvailableFlavorsToResize calls AvailableFlavorsToResizeFunc.
+func (m *InstancesService) AvailableFlavorsToResize(ctx context.Context, arg1 string, arg2 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error) {
+	m.record("AvailableFlavorsToResize", ctx, arg1, arg2)
+	if m.AvailableFlavorsToResizeFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "AvailableFlavorsToResize")
+	}
+
+	return m.AvailableFlavorsToResizeFunc(ctx, arg1, arg2)
+}
+
+// AvailableNames calls AvailableNamesFunc.
+func (m *InstancesService) AvailableNames(ctx context.Context) (*edgecloud.InstanceAvailableNames, *edgecloud.Response, error) {
+	m.record("AvailableNames", ctx)
+	if m.AvailableNamesFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "AvailableNames")
+	}
+
+	return m.AvailableNamesFunc(ctx)
+}
+
+// BareMetalCheckQuotasForInstanceCreation calls BareMetalCheckQuotasForInstanceCreationFunc.
+func (m *InstancesService) BareMetalCheckQuotasForInstanceCreation(ctx context.Context, arg1 *edgecloud.BareMetalQuotaCheckRequest) (edgecloud.Quota, *edgecloud.Response, error) {
+	m.record("BareMetalCheckQuotasForInstanceCreation", ctx, arg1)
+	if m.BareMetalCheckQuotasForInstanceCreationFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "BareMetalCheckQuotasForInstanceCreation")
+	}
+
+	return m.BareMetalCheckQuotasForInstanceCreationFunc(ctx, arg1)
+}
+
+// BareMetalCreateInstance calls BareMetalCreateInstanceFunc.
+func (m *InstancesService) BareMetalCreateInstance(ctx context.Context, arg1 *edgecloud.BareMetalServerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("BareMetalCreateInstance", ctx, arg1)
+	if m.BareMetalCreateInstanceFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "BareMetalCreateInstance")
+	}
+
+	return m.BareMetalCreateInstanceFunc(ctx, arg1)
+}
+
+// BareMetalGetCountAvailableNodes calls BareMetalGetCountAvailableNodesFunc.
+func (m *InstancesService) BareMetalGetCountAvailableNodes(ctx context.Context) (*edgecloud.BareMetalCapacity, *edgecloud.Response, error) {
+	m.record("BareMetalGetCountAvailableNodes", ctx)
+	if m.BareMetalGetCountAvailableNodesFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "BareMetalGetCountAvailableNodes")
+	}
+
+	return m.BareMetalGetCountAvailableNodesFunc(ctx)
+}
+
+// BareMetalListFlavors calls BareMetalListFlavorsFunc.
+func (m *InstancesService) BareMetalListFlavors(ctx context.Context, arg1 *edgecloud.BareMetalFlavorsOpts, arg2 *edgecloud.BareMetalFlavorsRequest) ([]edgecloud.BareMetalFlavor, *edgecloud.Response, error) {
+	m.record("BareMetalListFlavors", ctx, arg1, arg2)
+	if m.BareMetalListFlavorsFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "BareMetalListFlavors")
+	}
+
+	return m.BareMetalListFlavorsFunc(ctx, arg1, arg2)
+}
+
+// BareMetalListInstances calls BareMetalListInstancesFunc.
+func (m *InstancesService) BareMetalListInstances(ctx context.Context, arg1 *edgecloud.BareMetalInstancesListOpts) ([]edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("BareMetalListInstances", ctx, arg1)
+	if m.BareMetalListInstancesFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "BareMetalListInstances")
+	}
+
+	return m.BareMetalListInstancesFunc(ctx, arg1)
+}
+
+// BareMetalRebuildInstance calls BareMetalRebuildInstanceFunc.
+func (m *InstancesService) BareMetalRebuildInstance(ctx context.Context, arg1 string, arg2 *edgecloud.BareMetalRebuildRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("BareMetalRebuildInstance", ctx, arg1, arg2)
+	if m.BareMetalRebuildInstanceFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "BareMetalRebuildInstance")
+	}
+
+	return m.BareMetalRebuildInstanceFunc(ctx, arg1, arg2)
+}
+
+// CheckLimits calls CheckLimitsFunc.
+func (m *InstancesService) CheckLimits(ctx context.Context, arg1 *edgecloud.InstanceCheckLimitsRequest) (*map[string]int, *edgecloud.Response, error) {
+	m.record("CheckLimits", ctx, arg1)
+	if m.CheckLimitsFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "CheckLimits")
+	}
+
+	return m.CheckLimitsFunc(ctx, arg1)
+}
+
+// Create calls CreateFunc.
+func (m *InstancesService) Create(ctx context.Context, arg1 *edgecloud.InstanceCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *InstancesService) Delete(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceDeleteOptions) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1, arg2)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1, arg2)
+}
+
+// DetachInterface calls DetachInterfaceFunc.
+func (m *InstancesService) DetachInterface(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceDetachInterfaceRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("DetachInterface", ctx, arg1, arg2)
+	if m.DetachInterfaceFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "DetachInterface")
+	}
+
+	return m.DetachInterfaceFunc(ctx, arg1, arg2)
+}
+
+// FilterBySecurityGroup calls FilterBySecurityGroupFunc.
+func (m *InstancesService) FilterBySecurityGroup(ctx context.Context, arg1 string) ([]edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("FilterBySecurityGroup", ctx, arg1)
+	if m.FilterBySecurityGroupFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "FilterBySecurityGroup")
+	}
+
+	return m.FilterBySecurityGroupFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *InstancesService) Get(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// GetConsole calls GetConsoleFunc.
+func (m *InstancesService) GetConsole(ctx context.Context, arg1 string) (*edgecloud.RemoteConsole, *edgecloud.Response, error) {
+	m.record("GetConsole", ctx, arg1)
+	if m.GetConsoleFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "GetConsole")
+	}
+
+	return m.GetConsoleFunc(ctx, arg1)
+}
+
+// InstancePowercycle calls InstancePowercycleFunc.
+func (m *InstancesService) InstancePowercycle(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstancePowercycle", ctx, arg1)
+	if m.InstancePowercycleFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "InstancePowercycle")
+	}
+
+	return m.InstancePowercycleFunc(ctx, arg1)
+}
+
+// InstanceReboot calls InstanceRebootFunc.
+func (m *InstancesService) InstanceReboot(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceReboot", ctx, arg1)
+	if m.InstanceRebootFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "InstanceReboot")
+	}
+
+	return m.InstanceRebootFunc(ctx, arg1)
+}
+
+// InstanceResume calls InstanceResumeFunc.
+func (m *InstancesService) InstanceResume(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceResume", ctx, arg1)
+	if m.InstanceResumeFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "InstanceResume")
+	}
+
+	return m.InstanceResumeFunc(ctx, arg1)
+}
+
+// InstanceStart calls InstanceStartFunc.
+func (m *InstancesService) InstanceStart(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceStart", ctx, arg1)
+	if m.InstanceStartFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "InstanceStart")
+	}
+
+	return m.InstanceStartFunc(ctx, arg1)
+}
+
+// InstanceStop calls InstanceStopFunc.
+func (m *InstancesService) InstanceStop(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceStop", ctx, arg1)
+	if m.InstanceStopFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "InstanceStop")
+	}
+
+	return m.InstanceStopFunc(ctx, arg1)
+}
+
+// InstanceSuspend calls InstanceSuspendFunc.
+func (m *InstancesService) InstanceSuspend(ctx context.Context, arg1 string) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("InstanceSuspend", ctx, arg1)
+	if m.InstanceSuspendFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "InstanceSuspend")
+	}
+
+	return m.InstanceSuspendFunc(ctx, arg1)
+}
+
+// InterfaceList calls InterfaceListFunc.
+func (m *InstancesService) InterfaceList(ctx context.Context, arg1 string) ([]edgecloud.InstancePortInterface, *edgecloud.Response, error) {
+	m.record("InterfaceList", ctx, arg1)
+	if m.InterfaceListFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "InterfaceList")
+	}
+
+	return m.InterfaceListFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *InstancesService) List(ctx context.Context, arg1 *edgecloud.InstanceListOptions) ([]edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *InstancesService) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("InstancesService", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *InstancesService) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("InstancesService", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGet calls MetadataGetFunc.
+func (m *InstancesService) MetadataGet(ctx context.Context, arg1 string) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGet", ctx, arg1)
+	if m.MetadataGetFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "MetadataGet")
+	}
+
+	return m.MetadataGetFunc(ctx, arg1)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *InstancesService) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *InstancesService) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *InstancesService) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("InstancesService", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+// MetricsList calls MetricsListFunc.
+func (m *InstancesService) MetricsList(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceMetricsListRequest) ([]edgecloud.InstanceMetrics, *edgecloud.Response, error) {
+	m.record("MetricsList", ctx, arg1, arg2)
+	if m.MetricsListFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "MetricsList")
+	}
+
+	return m.MetricsListFunc(ctx, arg1, arg2)
+}
+
+// Migrate calls MigrateFunc.
+func (m *InstancesService) Migrate(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceMigrateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Migrate", ctx, arg1, arg2)
+	if m.MigrateFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "Migrate")
+	}
+
+	return m.MigrateFunc(ctx, arg1, arg2)
+}
+
+// PortsList calls PortsListFunc.
+func (m *InstancesService) PortsList(ctx context.Context, arg1 string) ([]edgecloud.InstancePort, *edgecloud.Response, error) {
+	m.record("PortsList", ctx, arg1)
+	if m.PortsListFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "PortsList")
+	}
+
+	return m.PortsListFunc(ctx, arg1)
+}
+
+// PutIntoServerGroup calls PutIntoServerGroupFunc.
+func (m *InstancesService) PutIntoServerGroup(ctx context.Context, arg1 string, arg2 *edgecloud.InstancePutIntoServerGroupRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PutIntoServerGroup", ctx, arg1, arg2)
+	if m.PutIntoServerGroupFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "PutIntoServerGroup")
+	}
+
+	return m.PutIntoServerGroupFunc(ctx, arg1, arg2)
+}
+
+// RemoveFromServerGroup calls RemoveFromServerGroupFunc.
+func (m *InstancesService) RemoveFromServerGroup(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("RemoveFromServerGroup", ctx, arg1)
+	if m.RemoveFromServerGroupFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "RemoveFromServerGroup")
+	}
+
+	return m.RemoveFromServerGroupFunc(ctx, arg1)
+}
+
+// Rename calls RenameFunc.
+func (m *InstancesService) Rename(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Instance, *edgecloud.Response, error) {
+	m.record("Rename", ctx, arg1, arg2)
+	if m.RenameFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "Rename")
+	}
+
+	return m.RenameFunc(ctx, arg1, arg2)
+}
+
+// SecurityGroupAssign calls SecurityGroupAssignFunc.
+func (m *InstancesService) SecurityGroupAssign(ctx context.Context, arg1 string, arg2 *edgecloud.AssignSecurityGroupRequest) (*edgecloud.Response, error) {
+	m.record("SecurityGroupAssign", ctx, arg1, arg2)
+	if m.SecurityGroupAssignFunc == nil {
+		return nil, unexpectedCall("InstancesService", "SecurityGroupAssign")
+	}
+
+	return m.SecurityGroupAssignFunc(ctx, arg1, arg2)
+}
+
+// SecurityGroupList calls SecurityGroupListFunc.
+func (m *InstancesService) SecurityGroupList(ctx context.Context, arg1 string) ([]edgecloud.IDName, *edgecloud.Response, error) {
+	m.record("SecurityGroupList", ctx, arg1)
+	if m.SecurityGroupListFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "SecurityGroupList")
+	}
+
+	return m.SecurityGroupListFunc(ctx, arg1)
+}
+
+// SecurityGroupUnAssign calls SecurityGroupUnAssignFunc.
+func (m *InstancesService) SecurityGroupUnAssign(ctx context.Context, arg1 string, arg2 *edgecloud.AssignSecurityGroupRequest) (*edgecloud.Response, error) {
+	m.record("SecurityGroupUnAssign", ctx, arg1, arg2)
+	if m.SecurityGroupUnAssignFunc == nil {
+		return nil, unexpectedCall("InstancesService", "SecurityGroupUnAssign")
+	}
+
+	return m.SecurityGroupUnAssignFunc(ctx, arg1, arg2)
+}
+
+// UpdateFlavor calls UpdateFlavorFunc.
+func (m *InstancesService) UpdateFlavor(ctx context.Context, arg1 string, arg2 *edgecloud.InstanceFlavorUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("UpdateFlavor", ctx, arg1, arg2)
+	if m.UpdateFlavorFunc == nil {
+		return nil, nil, unexpectedCall("InstancesService", "UpdateFlavor")
+	}
+
+	return m.UpdateFlavorFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.InstancesService = (*InstancesService)(nil)
+
+// KeyPairsService is a mock of the edgecloud.KeyPairsService interface.
+type KeyPairsService struct {
+	CreateFunc   func(ctx context.Context, arg1 *edgecloud.KeyPairCreateRequest) (*edgecloud.KeyPair, *edgecloud.Response, error)
+	CreateV2Func func(ctx context.Context, arg1 *edgecloud.KeyPairCreateRequestV2) (*edgecloud.KeyPairV2, *edgecloud.Response, error)
+	DeleteFunc   func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteV2Func func(ctx context.Context, arg1 string) (*edgecloud.Response, error)
+	GetFunc      func(ctx context.Context, arg1 string) (*edgecloud.KeyPair, *edgecloud.Response, error)
+	GetV2Func    func(ctx context.Context, arg1 string) (*edgecloud.KeyPairV2, *edgecloud.Response, error)
+	ListFunc     func(ctx context.Context) ([]edgecloud.KeyPair, *edgecloud.Response, error)
+	ListV2Func   func(ctx context.Context, opts *edgecloud.KeyPairsListOptionsV2) ([]edgecloud.KeyPairV2, *edgecloud.Response, error)
+	ShareFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.KeyPairShareRequest) (*edgecloud.KeyPair, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *KeyPairsService) Create(ctx context.Context, arg1 *edgecloud.KeyPairCreateRequest) (*edgecloud.KeyPair, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("KeyPairsService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// CreateV2 calls CreateV2Func.
+func (m *KeyPairsService) CreateV2(ctx context.Context, arg1 *edgecloud.KeyPairCreateRequestV2) (*edgecloud.KeyPairV2, *edgecloud.Response, error) {
+	m.record("CreateV2", ctx, arg1)
+	if m.CreateV2Func == nil {
+		return nil, nil, unexpectedCall("KeyPairsService", "CreateV2")
+	}
+
+	return m.CreateV2Func(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *KeyPairsService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("KeyPairsService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// DeleteV2 calls DeleteV2Func.
+func (m *KeyPairsService) DeleteV2(ctx context.Context, arg1 string) (*edgecloud.Response, error) {
+	m.record("DeleteV2", ctx, arg1)
+	if m.DeleteV2Func == nil {
+		return nil, unexpectedCall("KeyPairsService", "DeleteV2")
+	}
+
+	return m.DeleteV2Func(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *KeyPairsService) Get(ctx context.Context, arg1 string) (*edgecloud.KeyPair, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("KeyPairsService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// GetV2 calls GetV2Func.
+func (m *KeyPairsService) GetV2(ctx context.Context, arg1 string) (*edgecloud.KeyPairV2, *edgecloud.Response, error) {
+	m.record("GetV2", ctx, arg1)
+	if m.GetV2Func == nil {
+		return nil, nil, unexpectedCall("KeyPairsService", "GetV2")
+	}
+
+	return m.GetV2Func(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *KeyPairsService) List(ctx context.Context) ([]edgecloud.KeyPair, *edgecloud.Response, error) {
+	m.record("List", ctx)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("KeyPairsService", "List")
+	}
+
+	return m.ListFunc(ctx)
+}
+
+// ListV2 calls ListV2Func.
+func (m *KeyPairsService) ListV2(ctx context.Context, opts *edgecloud.KeyPairsListOptionsV2) ([]edgecloud.KeyPairV2, *edgecloud.Response, error) {
+	m.record("ListV2", ctx, opts)
+	if m.ListV2Func == nil {
+		return nil, nil, unexpectedCall("KeyPairsService", "ListV2")
+	}
+
+	return m.ListV2Func(ctx, opts)
+}
+
+// Share calls ShareFunc.
+func (m *KeyPairsService) Share(ctx context.Context, arg1 string, arg2 *edgecloud.KeyPairShareRequest) (*edgecloud.KeyPair, *edgecloud.Response, error) {
+	m.record("Share", ctx, arg1, arg2)
+	if m.ShareFunc == nil {
+		return nil, nil, unexpectedCall("KeyPairsService", "Share")
+	}
+
+	return m.ShareFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.KeyPairsService = (*KeyPairsService)(nil)
+
+// L7PoliciesService is a mock of the edgecloud.L7PoliciesService interface.
+type L7PoliciesService struct {
+	CreateFunc func(ctx context.Context, arg1 *edgecloud.L7PolicyCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc    func(ctx context.Context, arg1 string) (*edgecloud.L7Policy, *edgecloud.Response, error)
+	ListFunc   func(ctx context.Context) ([]edgecloud.L7Policy, *edgecloud.Response, error)
+	UpdateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.L7PolicyUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *L7PoliciesService) Create(ctx context.Context, arg1 *edgecloud.L7PolicyCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("L7PoliciesService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *L7PoliciesService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("L7PoliciesService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *L7PoliciesService) Get(ctx context.Context, arg1 string) (*edgecloud.L7Policy, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("L7PoliciesService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *L7PoliciesService) List(ctx context.Context) ([]edgecloud.L7Policy, *edgecloud.Response, error) {
+	m.record("List", ctx)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("L7PoliciesService", "List")
+	}
+
+	return m.ListFunc(ctx)
+}
+
+// Update calls UpdateFunc.
+func (m *L7PoliciesService) Update(ctx context.Context, arg1 string, arg2 *edgecloud.L7PolicyUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Update", ctx, arg1, arg2)
+	if m.UpdateFunc == nil {
+		return nil, nil, unexpectedCall("L7PoliciesService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.L7PoliciesService = (*L7PoliciesService)(nil)
+
+// L7RulesService is a mock of the edgecloud.L7RulesService interface.
+type L7RulesService struct {
+	CreateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.L7RuleCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc func(ctx context.Context, arg1 string, arg2 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc    func(ctx context.Context, arg1 string, arg2 string) (*edgecloud.L7Rule, *edgecloud.Response, error)
+	ListFunc   func(ctx context.Context, arg1 string) ([]edgecloud.L7Rule, *edgecloud.Response, error)
+	UpdateFunc func(ctx context.Context, arg1 string, arg2 string, arg3 *edgecloud.L7RuleUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *L7RulesService) Create(ctx context.Context, arg1 string, arg2 *edgecloud.L7RuleCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1, arg2)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("L7RulesService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1, arg2)
+}
+
+// Delete calls DeleteFunc.
+func (m *L7RulesService) Delete(ctx context.Context, arg1 string, arg2 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1, arg2)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("L7RulesService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1, arg2)
+}
+
+// Get calls GetFunc.
+func (m *L7RulesService) Get(ctx context.Context, arg1 string, arg2 string) (*edgecloud.L7Rule, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1, arg2)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("L7RulesService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1, arg2)
+}
+
+// List calls ListFunc.
+func (m *L7RulesService) List(ctx context.Context, arg1 string) ([]edgecloud.L7Rule, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("L7RulesService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// Update calls UpdateFunc.
+func (m *L7RulesService) Update(ctx context.Context, arg1 string, arg2 string, arg3 *edgecloud.L7RuleUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Update", ctx, arg1, arg2, arg3)
+	if m.UpdateFunc == nil {
+		return nil, nil, unexpectedCall("L7RulesService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, arg1, arg2, arg3)
+}
+
+var _ edgecloud.L7RulesService = (*L7RulesService)(nil)
+
+// LifeCyclePoliciesService is a mock of the edgecloud.LifeCyclePoliciesService interface.
+type LifeCyclePoliciesService struct {
+	AddSchedulesFunc                   func(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyAddSchedulesRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error)
+	AddVolumesFunc                     func(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyAddVolumesRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error)
+	CreateFunc                         func(ctx context.Context, arg1 *edgecloud.LifeCyclePolicyCreateRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error)
+	DeleteFunc                         func(ctx context.Context, arg1 int) (*edgecloud.Response, error)
+	EstimateCronMaxPolicyUsageFunc     func(ctx context.Context, arg1 *edgecloud.LifeCyclePolicyEstimateCronRequest) (*edgecloud.LifeCyclePolicyMaxPolicyUsage, *edgecloud.Response, error)
+	EstimateIntervalMaxPolicyUsageFunc func(ctx context.Context, arg1 *edgecloud.LifeCyclePolicyEstimateIntervalRequest) (*edgecloud.LifeCyclePolicyMaxPolicyUsage, *edgecloud.Response, error)
+	GetFunc                            func(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyGetOptions) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error)
+	ListFunc                           func(ctx context.Context, arg1 *edgecloud.LifeCyclePolicyListOptions) ([]edgecloud.LifeCyclePolicy, *edgecloud.Response, error)
+	RemoveSchedulesFunc                func(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyRemoveSchedulesRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error)
+	RemoveVolumesFunc                  func(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyRemoveVolumesRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error)
+	UpdateFunc                         func(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyUpdateRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error)
+
+	calls
+}
+
+// AddSchedules calls AddSchedulesFunc.
+func (m *LifeCyclePoliciesService) AddSchedules(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyAddSchedulesRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error) {
+	m.record("AddSchedules", ctx, arg1, arg2)
+	if m.AddSchedulesFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "AddSchedules")
+	}
+
+	return m.AddSchedulesFunc(ctx, arg1, arg2)
+}
+
+// AddVolumes calls AddVolumesFunc.
+func (m *LifeCyclePoliciesService) AddVolumes(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyAddVolumesRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error) {
+	m.record("AddVolumes", ctx, arg1, arg2)
+	if m.AddVolumesFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "AddVolumes")
+	}
+
+	return m.AddVolumesFunc(ctx, arg1, arg2)
+}
+
+// Create calls CreateFunc.
+func (m *LifeCyclePoliciesService) Create(ctx context.Context, arg1 *edgecloud.LifeCyclePolicyCreateRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *LifeCyclePoliciesService) Delete(ctx context.Context, arg1 int) (*edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, unexpectedCall("LifeCyclePoliciesService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// EstimateCronMaxPolicyUsage calls EstimateCronMaxPolicyUsageFunc.
+func (m *LifeCyclePoliciesService) EstimateCronMaxPolicyUsage(ctx context.Context, arg1 *edgecloud.LifeCyclePolicyEstimateCronRequest) (*edgecloud.LifeCyclePolicyMaxPolicyUsage, *edgecloud.Response, error) {
+	m.record("EstimateCronMaxPolicyUsage", ctx, arg1)
+	if m.EstimateCronMaxPolicyUsageFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "EstimateCronMaxPolicyUsage")
+	}
+
+	return m.EstimateCronMaxPolicyUsageFunc(ctx, arg1)
+}
+
+// EstimateIntervalMaxPolicyUsage calls EstimateIntervalMaxPolicyUsageFunc.
+func (m *LifeCyclePoliciesService) EstimateIntervalMaxPolicyUsage(ctx context.Context, arg1 *edgecloud.LifeCyclePolicyEstimateIntervalRequest) (*edgecloud.LifeCyclePolicyMaxPolicyUsage, *edgecloud.Response, error) {
+	m.record("EstimateIntervalMaxPolicyUsage", ctx, arg1)
+	if m.EstimateIntervalMaxPolicyUsageFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "EstimateIntervalMaxPolicyUsage")
+	}
+
+	return m.EstimateIntervalMaxPolicyUsageFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *LifeCyclePoliciesService) Get(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyGetOptions) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1, arg2)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1, arg2)
+}
+
+// List calls ListFunc.
+func (m *LifeCyclePoliciesService) List(ctx context.Context, arg1 *edgecloud.LifeCyclePolicyListOptions) ([]edgecloud.LifeCyclePolicy, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// RemoveSchedules calls RemoveSchedulesFunc.
+func (m *LifeCyclePoliciesService) RemoveSchedules(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyRemoveSchedulesRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error) {
+	m.record("RemoveSchedules", ctx, arg1, arg2)
+	if m.RemoveSchedulesFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "RemoveSchedules")
+	}
+
+	return m.RemoveSchedulesFunc(ctx, arg1, arg2)
+}
+
+// RemoveVolumes calls RemoveVolumesFunc.
+func (m *LifeCyclePoliciesService) RemoveVolumes(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyRemoveVolumesRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error) {
+	m.record("RemoveVolumes", ctx, arg1, arg2)
+	if m.RemoveVolumesFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "RemoveVolumes")
+	}
+
+	return m.RemoveVolumesFunc(ctx, arg1, arg2)
+}
+
+// Update calls UpdateFunc.
+func (m *LifeCyclePoliciesService) Update(ctx context.Context, arg1 int, arg2 *edgecloud.LifeCyclePolicyUpdateRequest) (*edgecloud.LifeCyclePolicy, *edgecloud.Response, error) {
+	m.record("Update", ctx, arg1, arg2)
+	if m.UpdateFunc == nil {
+		return nil, nil, unexpectedCall("LifeCyclePoliciesService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.LifeCyclePoliciesService = (*LifeCyclePoliciesService)(nil)
+
+// LoadbalancerHealthMonitor is a mock of the edgecloud.LoadbalancerHealthMonitor interface.
+type LoadbalancerHealthMonitor struct {
+	HealthMonitorCreateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.HealthMonitorCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	HealthMonitorDeleteFunc func(ctx context.Context, arg1 string) (*edgecloud.Response, error)
+
+	calls
+}
+
+// HealthMonitorCreate calls HealthMonitorCreateFunc.
+func (m *LoadbalancerHealthMonitor) HealthMonitorCreate(ctx context.Context, arg1 string, arg2 *edgecloud.HealthMonitorCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("HealthMonitorCreate", ctx, arg1, arg2)
+	if m.HealthMonitorCreateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerHealthMonitor", "HealthMonitorCreate")
+	}
+
+	return m.HealthMonitorCreateFunc(ctx, arg1, arg2)
+}
+
+// HealthMonitorDelete calls HealthMonitorDeleteFunc.
+func (m *LoadbalancerHealthMonitor) HealthMonitorDelete(ctx context.Context, arg1 string) (*edgecloud.Response, error) {
+	m.record("HealthMonitorDelete", ctx, arg1)
+	if m.HealthMonitorDeleteFunc == nil {
+		return nil, unexpectedCall("LoadbalancerHealthMonitor", "HealthMonitorDelete")
+	}
+
+	return m.HealthMonitorDeleteFunc(ctx, arg1)
+}
+
+var _ edgecloud.LoadbalancerHealthMonitor = (*LoadbalancerHealthMonitor)(nil)
+
+// LoadbalancerListeners is a mock of the edgecloud.LoadbalancerListeners interface.
+type LoadbalancerListeners struct {
+	ListenerCreateFunc func(ctx context.Context, arg1 *edgecloud.ListenerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ListenerDeleteFunc func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ListenerGetFunc    func(ctx context.Context, arg1 string) (*edgecloud.Listener, *edgecloud.Response, error)
+	ListenerListFunc   func(ctx context.Context, arg1 *edgecloud.ListenerListOptions) ([]edgecloud.Listener, *edgecloud.Response, error)
+	ListenerRenameFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Listener, *edgecloud.Response, error)
+	ListenerUpdateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.ListenerUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// ListenerCreate calls ListenerCreateFunc.
+func (m *LoadbalancerListeners) ListenerCreate(ctx context.Context, arg1 *edgecloud.ListenerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ListenerCreate", ctx, arg1)
+	if m.ListenerCreateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerListeners", "ListenerCreate")
+	}
+
+	return m.ListenerCreateFunc(ctx, arg1)
+}
+
+// ListenerDelete calls ListenerDeleteFunc.
+func (m *LoadbalancerListeners) ListenerDelete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ListenerDelete", ctx, arg1)
+	if m.ListenerDeleteFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerListeners", "ListenerDelete")
+	}
+
+	return m.ListenerDeleteFunc(ctx, arg1)
+}
+
+// ListenerGet calls ListenerGetFunc.
+func (m *LoadbalancerListeners) ListenerGet(ctx context.Context, arg1 string) (*edgecloud.Listener, *edgecloud.Response, error) {
+	m.record("ListenerGet", ctx, arg1)
+	if m.ListenerGetFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerListeners", "ListenerGet")
+	}
+
+	return m.ListenerGetFunc(ctx, arg1)
+}
+
+// ListenerList calls ListenerListFunc.
+func (m *LoadbalancerListeners) ListenerList(ctx context.Context, arg1 *edgecloud.ListenerListOptions) ([]edgecloud.Listener, *edgecloud.Response, error) {
+	m.record("ListenerList", ctx, arg1)
+	if m.ListenerListFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerListeners", "ListenerList")
+	}
+
+	return m.ListenerListFunc(ctx, arg1)
+}
+
+// ListenerRename calls ListenerRenameFunc.
+func (m *LoadbalancerListeners) ListenerRename(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Listener, *edgecloud.Response, error) {
+	m.record("ListenerRename", ctx, arg1, arg2)
+	if m.ListenerRenameFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerListeners", "ListenerRename")
+	}
+
+	return m.ListenerRenameFunc(ctx, arg1, arg2)
+}
+
+// ListenerUpdate calls ListenerUpdateFunc.
+func (m *LoadbalancerListeners) ListenerUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.ListenerUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ListenerUpdate", ctx, arg1, arg2)
+	if m.ListenerUpdateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerListeners", "ListenerUpdate")
+	}
+
+	return m.ListenerUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.LoadbalancerListeners = (*LoadbalancerListeners)(nil)
+
+// LoadbalancerMetadata is a mock of the edgecloud.LoadbalancerMetadata interface.
+type LoadbalancerMetadata struct {
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+
+	calls
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *LoadbalancerMetadata) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("LoadbalancerMetadata", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *LoadbalancerMetadata) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("LoadbalancerMetadata", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *LoadbalancerMetadata) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerMetadata", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *LoadbalancerMetadata) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerMetadata", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *LoadbalancerMetadata) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("LoadbalancerMetadata", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.LoadbalancerMetadata = (*LoadbalancerMetadata)(nil)
+
+// LoadbalancerPools is a mock of the edgecloud.LoadbalancerPools interface.
+type LoadbalancerPools struct {
+	PoolCreateFunc func(ctx context.Context, arg1 *edgecloud.PoolCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolDeleteFunc func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolGetFunc    func(ctx context.Context, arg1 string) (*edgecloud.Pool, *edgecloud.Response, error)
+	PoolListFunc   func(ctx context.Context, arg1 *edgecloud.PoolListOptions) ([]edgecloud.Pool, *edgecloud.Response, error)
+	PoolUpdateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.PoolUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// PoolCreate calls PoolCreateFunc.
+func (m *LoadbalancerPools) PoolCreate(ctx context.Context, arg1 *edgecloud.PoolCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolCreate", ctx, arg1)
+	if m.PoolCreateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerPools", "PoolCreate")
+	}
+
+	return m.PoolCreateFunc(ctx, arg1)
+}
+
+// PoolDelete calls PoolDeleteFunc.
+func (m *LoadbalancerPools) PoolDelete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolDelete", ctx, arg1)
+	if m.PoolDeleteFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerPools", "PoolDelete")
+	}
+
+	return m.PoolDeleteFunc(ctx, arg1)
+}
+
+// PoolGet calls PoolGetFunc.
+func (m *LoadbalancerPools) PoolGet(ctx context.Context, arg1 string) (*edgecloud.Pool, *edgecloud.Response, error) {
+	m.record("PoolGet", ctx, arg1)
+	if m.PoolGetFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerPools", "PoolGet")
+	}
+
+	return m.PoolGetFunc(ctx, arg1)
+}
+
+// PoolList calls PoolListFunc.
+func (m *LoadbalancerPools) PoolList(ctx context.Context, arg1 *edgecloud.PoolListOptions) ([]edgecloud.Pool, *edgecloud.Response, error) {
+	m.record("PoolList", ctx, arg1)
+	if m.PoolListFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerPools", "PoolList")
+	}
+
+	return m.PoolListFunc(ctx, arg1)
+}
+
+// PoolUpdate calls PoolUpdateFunc.
+func (m *LoadbalancerPools) PoolUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.PoolUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdate", ctx, arg1, arg2)
+	if m.PoolUpdateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerPools", "PoolUpdate")
+	}
+
+	return m.PoolUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.LoadbalancerPools = (*LoadbalancerPools)(nil)
+
+// LoadbalancerPoolsMember is a mock of the edgecloud.LoadbalancerPoolsMember interface.
+type LoadbalancerPoolsMember struct {
+	PoolMemberCreateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.PoolMemberCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolMemberDeleteFunc func(ctx context.Context, arg1 string, arg2 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// PoolMemberCreate calls PoolMemberCreateFunc.
+func (m *LoadbalancerPoolsMember) PoolMemberCreate(ctx context.Context, arg1 string, arg2 *edgecloud.PoolMemberCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolMemberCreate", ctx, arg1, arg2)
+	if m.PoolMemberCreateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerPoolsMember", "PoolMemberCreate")
+	}
+
+	return m.PoolMemberCreateFunc(ctx, arg1, arg2)
+}
+
+// PoolMemberDelete calls PoolMemberDeleteFunc.
+func (m *LoadbalancerPoolsMember) PoolMemberDelete(ctx context.Context, arg1 string, arg2 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolMemberDelete", ctx, arg1, arg2)
+	if m.PoolMemberDeleteFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancerPoolsMember", "PoolMemberDelete")
+	}
+
+	return m.PoolMemberDeleteFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.LoadbalancerPoolsMember = (*LoadbalancerPoolsMember)(nil)
+
+// LoadbalancersService is a mock of the edgecloud.LoadbalancersService interface.
+type LoadbalancersService struct {
+	ChangeFlavorFunc        func(ctx context.Context, arg1 string, arg2 *edgecloud.LoadbalancerChangeFlavorRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	CheckLimitsFunc         func(ctx context.Context, arg1 *edgecloud.LoadbalancerCheckLimitsRequest) (*map[string]int, *edgecloud.Response, error)
+	CreateFunc              func(ctx context.Context, arg1 *edgecloud.LoadbalancerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc              func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	FlavorListFunc          func(ctx context.Context, arg1 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error)
+	GetFunc                 func(ctx context.Context, arg1 string) (*edgecloud.Loadbalancer, *edgecloud.Response, error)
+	HealthMonitorCreateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.HealthMonitorCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	HealthMonitorDeleteFunc func(ctx context.Context, arg1 string) (*edgecloud.Response, error)
+	ListFunc                func(ctx context.Context, arg1 *edgecloud.LoadbalancerListOptions) ([]edgecloud.Loadbalancer, *edgecloud.Response, error)
+	ListenerCreateFunc      func(ctx context.Context, arg1 *edgecloud.ListenerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ListenerDeleteFunc      func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ListenerGetFunc         func(ctx context.Context, arg1 string) (*edgecloud.Listener, *edgecloud.Response, error)
+	ListenerListFunc        func(ctx context.Context, arg1 *edgecloud.ListenerListOptions) ([]edgecloud.Listener, *edgecloud.Response, error)
+	ListenerRenameFunc      func(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Listener, *edgecloud.Response, error)
+	ListenerUpdateFunc      func(ctx context.Context, arg1 string, arg2 *edgecloud.ListenerUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	MetadataCreateFunc      func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc  func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc        func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc      func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetricsListFunc         func(ctx context.Context, arg1 string, arg2 *edgecloud.LoadbalancerMetricsListRequest) ([]edgecloud.LoadbalancerMetrics, *edgecloud.Response, error)
+	PoolCreateFunc          func(ctx context.Context, arg1 *edgecloud.PoolCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolDeleteFunc          func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolGetFunc             func(ctx context.Context, arg1 string) (*edgecloud.Pool, *edgecloud.Response, error)
+	PoolListFunc            func(ctx context.Context, arg1 *edgecloud.PoolListOptions) ([]edgecloud.Pool, *edgecloud.Response, error)
+	PoolMemberCreateFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.PoolMemberCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolMemberDeleteFunc    func(ctx context.Context, arg1 string, arg2 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateFunc          func(ctx context.Context, arg1 string, arg2 *edgecloud.PoolUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	RenameFunc              func(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Loadbalancer, *edgecloud.Response, error)
+
+	calls
+}
+
+// ChangeFlavor calls ChangeFlavorFunc.
+func (m *LoadbalancersService) ChangeFlavor(ctx context.Context, arg1 string, arg2 *edgecloud.LoadbalancerChangeFlavorRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ChangeFlavor", ctx, arg1, arg2)
+	if m.ChangeFlavorFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "ChangeFlavor")
+	}
+
+	return m.ChangeFlavorFunc(ctx, arg1, arg2)
+}
+
+// CheckLimits calls CheckLimitsFunc.
+func (m *LoadbalancersService) CheckLimits(ctx context.Context, arg1 *edgecloud.LoadbalancerCheckLimitsRequest) (*map[string]int, *edgecloud.Response, error) {
+	m.record("CheckLimits", ctx, arg1)
+	if m.CheckLimitsFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "CheckLimits")
+	}
+
+	return m.CheckLimitsFunc(ctx, arg1)
+}
+
+// Create calls CreateFunc.
+func (m *LoadbalancersService) Create(ctx context.Context, arg1 *edgecloud.LoadbalancerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *LoadbalancersService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// FlavorList calls FlavorListFunc.
+func (m *LoadbalancersService) FlavorList(ctx context.Context, arg1 *edgecloud.FlavorsOptions) ([]edgecloud.Flavor, *edgecloud.Response, error) {
+	m.record("FlavorList", ctx, arg1)
+	if m.FlavorListFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "FlavorList")
+	}
+
+	return m.FlavorListFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *LoadbalancersService) Get(ctx context.Context, arg1 string) (*edgecloud.Loadbalancer, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// HealthMonitorCreate calls HealthMonitorCreateFunc.
+func (m *LoadbalancersService) HealthMonitorCreate(ctx context.Context, arg1 string, arg2 *edgecloud.HealthMonitorCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("HealthMonitorCreate", ctx, arg1, arg2)
+	if m.HealthMonitorCreateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "HealthMonitorCreate")
+	}
+
+	return m.HealthMonitorCreateFunc(ctx, arg1, arg2)
+}
+
+// HealthMonitorDelete calls HealthMonitorDeleteFunc.
+func (m *LoadbalancersService) HealthMonitorDelete(ctx context.Context, arg1 string) (*edgecloud.Response, error) {
+	m.record("HealthMonitorDelete", ctx, arg1)
+	if m.HealthMonitorDeleteFunc == nil {
+		return nil, unexpectedCall("LoadbalancersService", "HealthMonitorDelete")
+	}
+
+	return m.HealthMonitorDeleteFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *LoadbalancersService) List(ctx context.Context, arg1 *edgecloud.LoadbalancerListOptions) ([]edgecloud.Loadbalancer, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// ListenerCreate calls ListenerCreateFunc.
+func (m *LoadbalancersService) ListenerCreate(ctx context.Context, arg1 *edgecloud.ListenerCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ListenerCreate", ctx, arg1)
+	if m.ListenerCreateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "ListenerCreate")
+	}
+
+	return m.ListenerCreateFunc(ctx, arg1)
+}
+
+// ListenerDelete calls ListenerDeleteFunc.
+func (m *LoadbalancersService) ListenerDelete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ListenerDelete", ctx, arg1)
+	if m.ListenerDeleteFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "ListenerDelete")
+	}
+
+	return m.ListenerDeleteFunc(ctx, arg1)
+}
+
+// ListenerGet calls ListenerGetFunc.
+func (m *LoadbalancersService) ListenerGet(ctx context.Context, arg1 string) (*edgecloud.Listener, *edgecloud.Response, error) {
+	m.record("ListenerGet", ctx, arg1)
+	if m.ListenerGetFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "ListenerGet")
+	}
+
+	return m.ListenerGetFunc(ctx, arg1)
+}
+
+// ListenerList calls ListenerListFunc.
+func (m *LoadbalancersService) ListenerList(ctx context.Context, arg1 *edgecloud.ListenerListOptions) ([]edgecloud.Listener, *edgecloud.Response, error) {
+	m.record("ListenerList", ctx, arg1)
+	if m.ListenerListFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "ListenerList")
+	}
+
+	return m.ListenerListFunc(ctx, arg1)
+}
+
+// ListenerRename calls ListenerRenameFunc.
+func (m *LoadbalancersService) ListenerRename(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Listener, *edgecloud.Response, error) {
+	m.record("ListenerRename", ctx, arg1, arg2)
+	if m.ListenerRenameFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "ListenerRename")
+	}
+
+	return m.ListenerRenameFunc(ctx, arg1, arg2)
+}
+
+// ListenerUpdate calls ListenerUpdateFunc.
+func (m *LoadbalancersService) ListenerUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.ListenerUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ListenerUpdate", ctx, arg1, arg2)
+	if m.ListenerUpdateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "ListenerUpdate")
+	}
+
+	return m.ListenerUpdateFunc(ctx, arg1, arg2)
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *LoadbalancersService) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("LoadbalancersService", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *LoadbalancersService) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("LoadbalancersService", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *LoadbalancersService) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *LoadbalancersService) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *LoadbalancersService) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("LoadbalancersService", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+// MetricsList calls MetricsListFunc.
+func (m *LoadbalancersService) MetricsList(ctx context.Context, arg1 string, arg2 *edgecloud.LoadbalancerMetricsListRequest) ([]edgecloud.LoadbalancerMetrics, *edgecloud.Response, error) {
+	m.record("MetricsList", ctx, arg1, arg2)
+	if m.MetricsListFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "MetricsList")
+	}
+
+	return m.MetricsListFunc(ctx, arg1, arg2)
+}
+
+// PoolCreate calls PoolCreateFunc.
+func (m *LoadbalancersService) PoolCreate(ctx context.Context, arg1 *edgecloud.PoolCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolCreate", ctx, arg1)
+	if m.PoolCreateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "PoolCreate")
+	}
+
+	return m.PoolCreateFunc(ctx, arg1)
+}
+
+// PoolDelete calls PoolDeleteFunc.
+func (m *LoadbalancersService) PoolDelete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolDelete", ctx, arg1)
+	if m.PoolDeleteFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "PoolDelete")
+	}
+
+	return m.PoolDeleteFunc(ctx, arg1)
+}
+
+// PoolGet calls PoolGetFunc.
+func (m *LoadbalancersService) PoolGet(ctx context.Context, arg1 string) (*edgecloud.Pool, *edgecloud.Response, error) {
+	m.record("PoolGet", ctx, arg1)
+	if m.PoolGetFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "PoolGet")
+	}
+
+	return m.PoolGetFunc(ctx, arg1)
+}
+
+// PoolList calls PoolListFunc.
+func (m *LoadbalancersService) PoolList(ctx context.Context, arg1 *edgecloud.PoolListOptions) ([]edgecloud.Pool, *edgecloud.Response, error) {
+	m.record("PoolList", ctx, arg1)
+	if m.PoolListFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "PoolList")
+	}
+
+	return m.PoolListFunc(ctx, arg1)
+}
+
+// PoolMemberCreate calls PoolMemberCreateFunc.
+func (m *LoadbalancersService) PoolMemberCreate(ctx context.Context, arg1 string, arg2 *edgecloud.PoolMemberCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolMemberCreate", ctx, arg1, arg2)
+	if m.PoolMemberCreateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "PoolMemberCreate")
+	}
+
+	return m.PoolMemberCreateFunc(ctx, arg1, arg2)
+}
+
+// PoolMemberDelete calls PoolMemberDeleteFunc.
+func (m *LoadbalancersService) PoolMemberDelete(ctx context.Context, arg1 string, arg2 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolMemberDelete", ctx, arg1, arg2)
+	if m.PoolMemberDeleteFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "PoolMemberDelete")
+	}
+
+	return m.PoolMemberDeleteFunc(ctx, arg1, arg2)
+}
+
+// PoolUpdate calls PoolUpdateFunc.
+func (m *LoadbalancersService) PoolUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.PoolUpdateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdate", ctx, arg1, arg2)
+	if m.PoolUpdateFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "PoolUpdate")
+	}
+
+	return m.PoolUpdateFunc(ctx, arg1, arg2)
+}
+
+// Rename calls RenameFunc.
+func (m *LoadbalancersService) Rename(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Loadbalancer, *edgecloud.Response, error) {
+	m.record("Rename", ctx, arg1, arg2)
+	if m.RenameFunc == nil {
+		return nil, nil, unexpectedCall("LoadbalancersService", "Rename")
+	}
+
+	return m.RenameFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.LoadbalancersService = (*LoadbalancersService)(nil)
+
+// MKaaSClusters is a mock of the edgecloud.MKaaSClusters interface.
+type MKaaSClusters struct {
+	ClusterCreateFunc                func(ctx context.Context, arg1 edgecloud.MKaaSClusterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterDeleteFunc                func(ctx context.Context, arg1 int) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterGetFunc                   func(ctx context.Context, arg1 int) (*edgecloud.MKaaSCluster, *edgecloud.Response, error)
+	ClusterUpdateMasterNodeCountFunc func(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpdateMasterNodeCountRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterUpdateNameFunc            func(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpdateNameRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterUpgradeVersionFunc        func(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpgradeVersionRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClustersListFunc                 func(ctx context.Context, arg1 *edgecloud.MKaaSClusterListOptions) ([]edgecloud.MKaaSCluster, *edgecloud.Response, error)
+
+	calls
+}
+
+// ClusterCreate calls ClusterCreateFunc.
+func (m *MKaaSClusters) ClusterCreate(ctx context.Context, arg1 edgecloud.MKaaSClusterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterCreate", ctx, arg1)
+	if m.ClusterCreateFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSClusters", "ClusterCreate")
+	}
+
+	return m.ClusterCreateFunc(ctx, arg1)
+}
+
+// ClusterDelete calls ClusterDeleteFunc.
+func (m *MKaaSClusters) ClusterDelete(ctx context.Context, arg1 int) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterDelete", ctx, arg1)
+	if m.ClusterDeleteFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSClusters", "ClusterDelete")
+	}
+
+	return m.ClusterDeleteFunc(ctx, arg1)
+}
+
+// ClusterGet calls ClusterGetFunc.
+func (m *MKaaSClusters) ClusterGet(ctx context.Context, arg1 int) (*edgecloud.MKaaSCluster, *edgecloud.Response, error) {
+	m.record("ClusterGet", ctx, arg1)
+	if m.ClusterGetFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSClusters", "ClusterGet")
+	}
+
+	return m.ClusterGetFunc(ctx, arg1)
+}
+
+// ClusterUpdateMasterNodeCount calls ClusterUpdateMasterNodeCountFunc.
+func (m *MKaaSClusters) ClusterUpdateMasterNodeCount(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpdateMasterNodeCountRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpdateMasterNodeCount", ctx, clusterID, reqBody)
+	if m.ClusterUpdateMasterNodeCountFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSClusters", "ClusterUpdateMasterNodeCount")
+	}
+
+	return m.ClusterUpdateMasterNodeCountFunc(ctx, clusterID, reqBody)
+}
+
+// ClusterUpdateName calls ClusterUpdateNameFunc.
+func (m *MKaaSClusters) ClusterUpdateName(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpdateNameRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpdateName", ctx, clusterID, reqBody)
+	if m.ClusterUpdateNameFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSClusters", "ClusterUpdateName")
+	}
+
+	return m.ClusterUpdateNameFunc(ctx, clusterID, reqBody)
+}
+
+// ClusterUpgradeVersion calls ClusterUpgradeVersionFunc.
+func (m *MKaaSClusters) ClusterUpgradeVersion(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpgradeVersionRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpgradeVersion", ctx, clusterID, reqBody)
+	if m.ClusterUpgradeVersionFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSClusters", "ClusterUpgradeVersion")
+	}
+
+	return m.ClusterUpgradeVersionFunc(ctx, clusterID, reqBody)
+}
+
+// ClustersList calls ClustersListFunc.
+func (m *MKaaSClusters) ClustersList(ctx context.Context, arg1 *edgecloud.MKaaSClusterListOptions) ([]edgecloud.MKaaSCluster, *edgecloud.Response, error) {
+	m.record("ClustersList", ctx, arg1)
+	if m.ClustersListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSClusters", "ClustersList")
+	}
+
+	return m.ClustersListFunc(ctx, arg1)
+}
+
+var _ edgecloud.MKaaSClusters = (*MKaaSClusters)(nil)
+
+// MKaaSFlavors is a mock of the edgecloud.MKaaSFlavors interface.
+type MKaaSFlavors struct {
+	FlavorsListFunc func(ctx context.Context, opts *edgecloud.MKaaSFlavorListOptions) (*edgecloud.MKaaSFlavorsList, *edgecloud.Response, error)
+
+	calls
+}
+
+// FlavorsList calls FlavorsListFunc.
+func (m *MKaaSFlavors) FlavorsList(ctx context.Context, opts *edgecloud.MKaaSFlavorListOptions) (*edgecloud.MKaaSFlavorsList, *edgecloud.Response, error) {
+	m.record("FlavorsList", ctx, opts)
+	if m.FlavorsListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSFlavors", "FlavorsList")
+	}
+
+	return m.FlavorsListFunc(ctx, opts)
+}
+
+var _ edgecloud.MKaaSFlavors = (*MKaaSFlavors)(nil)
+
+// MKaaSNodes is a mock of the edgecloud.MKaaSNodes interface.
+type MKaaSNodes struct {
+	NodesDeleteFunc func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSNodesDeleteRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	NodesListFunc   func(ctx context.Context, clusterID int, poolID int, opts *edgecloud.MKaaSNodeListOptions) ([]edgecloud.MKaaSNode, *edgecloud.Response, error)
+
+	calls
+}
+
+// NodesDelete calls NodesDeleteFunc.
+func (m *MKaaSNodes) NodesDelete(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSNodesDeleteRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("NodesDelete", ctx, clusterID, poolID, reqBody)
+	if m.NodesDeleteFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSNodes", "NodesDelete")
+	}
+
+	return m.NodesDeleteFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// NodesList calls NodesListFunc.
+func (m *MKaaSNodes) NodesList(ctx context.Context, clusterID int, poolID int, opts *edgecloud.MKaaSNodeListOptions) ([]edgecloud.MKaaSNode, *edgecloud.Response, error) {
+	m.record("NodesList", ctx, clusterID, poolID, opts)
+	if m.NodesListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSNodes", "NodesList")
+	}
+
+	return m.NodesListFunc(ctx, clusterID, poolID, opts)
+}
+
+var _ edgecloud.MKaaSNodes = (*MKaaSNodes)(nil)
+
+// MKaaSPools is a mock of the edgecloud.MKaaSPools interface.
+type MKaaSPools struct {
+	PoolCreateFunc               func(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSPoolCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolDeleteFunc               func(ctx context.Context, clusterID int, poolID int) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolGetFunc                  func(ctx context.Context, clusterID int, poolID int) (*edgecloud.MKaaSPool, *edgecloud.Response, error)
+	PoolUpdateAutoscalingFunc    func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateAutoscalingRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateLabelsFunc         func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateLabelsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateNameFunc           func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateNameRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateNodeCountFunc      func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateScaleRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateSecurityGroupsFunc func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateSecurityGroupsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateTaintsFunc         func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateTaintsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolsListFunc                func(ctx context.Context, clusterID int, opts *edgecloud.MKaaSPoolListOptions) ([]edgecloud.MKaaSPool, *edgecloud.Response, error)
+
+	calls
+}
+
+// PoolCreate calls PoolCreateFunc.
+func (m *MKaaSPools) PoolCreate(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSPoolCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolCreate", ctx, clusterID, reqBody)
+	if m.PoolCreateFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolCreate")
+	}
+
+	return m.PoolCreateFunc(ctx, clusterID, reqBody)
+}
+
+// PoolDelete calls PoolDeleteFunc.
+func (m *MKaaSPools) PoolDelete(ctx context.Context, clusterID int, poolID int) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolDelete", ctx, clusterID, poolID)
+	if m.PoolDeleteFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolDelete")
+	}
+
+	return m.PoolDeleteFunc(ctx, clusterID, poolID)
+}
+
+// PoolGet calls PoolGetFunc.
+func (m *MKaaSPools) PoolGet(ctx context.Context, clusterID int, poolID int) (*edgecloud.MKaaSPool, *edgecloud.Response, error) {
+	m.record("PoolGet", ctx, clusterID, poolID)
+	if m.PoolGetFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolGet")
+	}
+
+	return m.PoolGetFunc(ctx, clusterID, poolID)
+}
+
+// PoolUpdateAutoscaling calls PoolUpdateAutoscalingFunc.
+func (m *MKaaSPools) PoolUpdateAutoscaling(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateAutoscalingRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateAutoscaling", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateAutoscalingFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolUpdateAutoscaling")
+	}
+
+	return m.PoolUpdateAutoscalingFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateLabels calls PoolUpdateLabelsFunc.
+func (m *MKaaSPools) PoolUpdateLabels(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateLabelsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateLabels", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateLabelsFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolUpdateLabels")
+	}
+
+	return m.PoolUpdateLabelsFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateName calls PoolUpdateNameFunc.
+func (m *MKaaSPools) PoolUpdateName(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateNameRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateName", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateNameFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolUpdateName")
+	}
+
+	return m.PoolUpdateNameFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateNodeCount calls PoolUpdateNodeCountFunc.
+func (m *MKaaSPools) PoolUpdateNodeCount(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateScaleRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateNodeCount", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateNodeCountFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolUpdateNodeCount")
+	}
+
+	return m.PoolUpdateNodeCountFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateSecurityGroups calls PoolUpdateSecurityGroupsFunc.
+func (m *MKaaSPools) PoolUpdateSecurityGroups(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateSecurityGroupsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateSecurityGroups", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateSecurityGroupsFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolUpdateSecurityGroups")
+	}
+
+	return m.PoolUpdateSecurityGroupsFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateTaints calls PoolUpdateTaintsFunc.
+func (m *MKaaSPools) PoolUpdateTaints(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateTaintsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateTaints", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateTaintsFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolUpdateTaints")
+	}
+
+	return m.PoolUpdateTaintsFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolsList calls PoolsListFunc.
+func (m *MKaaSPools) PoolsList(ctx context.Context, clusterID int, opts *edgecloud.MKaaSPoolListOptions) ([]edgecloud.MKaaSPool, *edgecloud.Response, error) {
+	m.record("PoolsList", ctx, clusterID, opts)
+	if m.PoolsListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSPools", "PoolsList")
+	}
+
+	return m.PoolsListFunc(ctx, clusterID, opts)
+}
+
+var _ edgecloud.MKaaSPools = (*MKaaSPools)(nil)
+
+// MKaaSRegions is a mock of the edgecloud.MKaaSRegions interface.
+type MKaaSRegions struct {
+	VersionsListFunc func(ctx context.Context, regionID int) (*edgecloud.MKaaSKubernetesVersionsResult, *edgecloud.Response, error)
+
+	calls
+}
+
+// VersionsList calls VersionsListFunc.
+func (m *MKaaSRegions) VersionsList(ctx context.Context, regionID int) (*edgecloud.MKaaSKubernetesVersionsResult, *edgecloud.Response, error) {
+	m.record("VersionsList", ctx, regionID)
+	if m.VersionsListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSRegions", "VersionsList")
+	}
+
+	return m.VersionsListFunc(ctx, regionID)
+}
+
+var _ edgecloud.MKaaSRegions = (*MKaaSRegions)(nil)
+
+// MKaaSService is a mock of the edgecloud.MKaaSService interface.
+type MKaaSService struct {
+	ClusterCreateFunc                func(ctx context.Context, arg1 edgecloud.MKaaSClusterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterDeleteFunc                func(ctx context.Context, arg1 int) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterGetFunc                   func(ctx context.Context, arg1 int) (*edgecloud.MKaaSCluster, *edgecloud.Response, error)
+	ClusterUpdateMasterNodeCountFunc func(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpdateMasterNodeCountRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterUpdateNameFunc            func(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpdateNameRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClusterUpgradeVersionFunc        func(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpgradeVersionRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	ClustersListFunc                 func(ctx context.Context, arg1 *edgecloud.MKaaSClusterListOptions) ([]edgecloud.MKaaSCluster, *edgecloud.Response, error)
+	FlavorsListFunc                  func(ctx context.Context, opts *edgecloud.MKaaSFlavorListOptions) (*edgecloud.MKaaSFlavorsList, *edgecloud.Response, error)
+	NodesDeleteFunc                  func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSNodesDeleteRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	NodesListFunc                    func(ctx context.Context, clusterID int, poolID int, opts *edgecloud.MKaaSNodeListOptions) ([]edgecloud.MKaaSNode, *edgecloud.Response, error)
+	PoolCreateFunc                   func(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSPoolCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolDeleteFunc                   func(ctx context.Context, clusterID int, poolID int) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolGetFunc                      func(ctx context.Context, clusterID int, poolID int) (*edgecloud.MKaaSPool, *edgecloud.Response, error)
+	PoolUpdateAutoscalingFunc        func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateAutoscalingRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateLabelsFunc             func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateLabelsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateNameFunc               func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateNameRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateNodeCountFunc          func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateScaleRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateSecurityGroupsFunc     func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateSecurityGroupsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolUpdateTaintsFunc             func(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateTaintsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	PoolsListFunc                    func(ctx context.Context, clusterID int, opts *edgecloud.MKaaSPoolListOptions) ([]edgecloud.MKaaSPool, *edgecloud.Response, error)
+	VersionsListFunc                 func(ctx context.Context, regionID int) (*edgecloud.MKaaSKubernetesVersionsResult, *edgecloud.Response, error)
+
+	calls
+}
+
+// ClusterCreate calls ClusterCreateFunc.
+func (m *MKaaSService) ClusterCreate(ctx context.Context, arg1 edgecloud.MKaaSClusterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterCreate", ctx, arg1)
+	if m.ClusterCreateFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "ClusterCreate")
+	}
+
+	return m.ClusterCreateFunc(ctx, arg1)
+}
+
+// ClusterDelete calls ClusterDeleteFunc.
+func (m *MKaaSService) ClusterDelete(ctx context.Context, arg1 int) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterDelete", ctx, arg1)
+	if m.ClusterDeleteFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "ClusterDelete")
+	}
+
+	return m.ClusterDeleteFunc(ctx, arg1)
+}
+
+// ClusterGet calls ClusterGetFunc.
+func (m *MKaaSService) ClusterGet(ctx context.Context, arg1 int) (*edgecloud.MKaaSCluster, *edgecloud.Response, error) {
+	m.record("ClusterGet", ctx, arg1)
+	if m.ClusterGetFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "ClusterGet")
+	}
+
+	return m.ClusterGetFunc(ctx, arg1)
+}
+
+// ClusterUpdateMasterNodeCount calls ClusterUpdateMasterNodeCountFunc.
+func (m *MKaaSService) ClusterUpdateMasterNodeCount(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpdateMasterNodeCountRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpdateMasterNodeCount", ctx, clusterID, reqBody)
+	if m.ClusterUpdateMasterNodeCountFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "ClusterUpdateMasterNodeCount")
+	}
+
+	return m.ClusterUpdateMasterNodeCountFunc(ctx, clusterID, reqBody)
+}
+
+// ClusterUpdateName calls ClusterUpdateNameFunc.
+func (m *MKaaSService) ClusterUpdateName(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpdateNameRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpdateName", ctx, clusterID, reqBody)
+	if m.ClusterUpdateNameFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "ClusterUpdateName")
+	}
+
+	return m.ClusterUpdateNameFunc(ctx, clusterID, reqBody)
+}
+
+// ClusterUpgradeVersion calls ClusterUpgradeVersionFunc.
+func (m *MKaaSService) ClusterUpgradeVersion(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSClusterUpgradeVersionRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("ClusterUpgradeVersion", ctx, clusterID, reqBody)
+	if m.ClusterUpgradeVersionFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "ClusterUpgradeVersion")
+	}
+
+	return m.ClusterUpgradeVersionFunc(ctx, clusterID, reqBody)
+}
+
+// ClustersList calls ClustersListFunc.
+func (m *MKaaSService) ClustersList(ctx context.Context, arg1 *edgecloud.MKaaSClusterListOptions) ([]edgecloud.MKaaSCluster, *edgecloud.Response, error) {
+	m.record("ClustersList", ctx, arg1)
+	if m.ClustersListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "ClustersList")
+	}
+
+	return m.ClustersListFunc(ctx, arg1)
+}
+
+// FlavorsList calls FlavorsListFunc.
+func (m *MKaaSService) FlavorsList(ctx context.Context, opts *edgecloud.MKaaSFlavorListOptions) (*edgecloud.MKaaSFlavorsList, *edgecloud.Response, error) {
+	m.record("FlavorsList", ctx, opts)
+	if m.FlavorsListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "FlavorsList")
+	}
+
+	return m.FlavorsListFunc(ctx, opts)
+}
+
+// NodesDelete calls NodesDeleteFunc.
+func (m *MKaaSService) NodesDelete(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSNodesDeleteRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("NodesDelete", ctx, clusterID, poolID, reqBody)
+	if m.NodesDeleteFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "NodesDelete")
+	}
+
+	return m.NodesDeleteFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// NodesList calls NodesListFunc.
+func (m *MKaaSService) NodesList(ctx context.Context, clusterID int, poolID int, opts *edgecloud.MKaaSNodeListOptions) ([]edgecloud.MKaaSNode, *edgecloud.Response, error) {
+	m.record("NodesList", ctx, clusterID, poolID, opts)
+	if m.NodesListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "NodesList")
+	}
+
+	return m.NodesListFunc(ctx, clusterID, poolID, opts)
+}
+
+// PoolCreate calls PoolCreateFunc.
+func (m *MKaaSService) PoolCreate(ctx context.Context, clusterID int, reqBody edgecloud.MKaaSPoolCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolCreate", ctx, clusterID, reqBody)
+	if m.PoolCreateFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolCreate")
+	}
+
+	return m.PoolCreateFunc(ctx, clusterID, reqBody)
+}
+
+// PoolDelete calls PoolDeleteFunc.
+func (m *MKaaSService) PoolDelete(ctx context.Context, clusterID int, poolID int) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolDelete", ctx, clusterID, poolID)
+	if m.PoolDeleteFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolDelete")
+	}
+
+	return m.PoolDeleteFunc(ctx, clusterID, poolID)
+}
+
+// PoolGet calls PoolGetFunc.
+func (m *MKaaSService) PoolGet(ctx context.Context, clusterID int, poolID int) (*edgecloud.MKaaSPool, *edgecloud.Response, error) {
+	m.record("PoolGet", ctx, clusterID, poolID)
+	if m.PoolGetFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolGet")
+	}
+
+	return m.PoolGetFunc(ctx, clusterID, poolID)
+}
+
+// PoolUpdateAutoscaling calls PoolUpdateAutoscalingFunc.
+func (m *MKaaSService) PoolUpdateAutoscaling(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateAutoscalingRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateAutoscaling", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateAutoscalingFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolUpdateAutoscaling")
+	}
+
+	return m.PoolUpdateAutoscalingFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateLabels calls PoolUpdateLabelsFunc.
+func (m *MKaaSService) PoolUpdateLabels(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateLabelsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateLabels", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateLabelsFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolUpdateLabels")
+	}
+
+	return m.PoolUpdateLabelsFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateName calls PoolUpdateNameFunc.
+func (m *MKaaSService) PoolUpdateName(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateNameRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateName", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateNameFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolUpdateName")
+	}
+
+	return m.PoolUpdateNameFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateNodeCount calls PoolUpdateNodeCountFunc.
+func (m *MKaaSService) PoolUpdateNodeCount(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateScaleRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateNodeCount", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateNodeCountFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolUpdateNodeCount")
+	}
+
+	return m.PoolUpdateNodeCountFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateSecurityGroups calls PoolUpdateSecurityGroupsFunc.
+func (m *MKaaSService) PoolUpdateSecurityGroups(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateSecurityGroupsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateSecurityGroups", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateSecurityGroupsFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolUpdateSecurityGroups")
+	}
+
+	return m.PoolUpdateSecurityGroupsFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolUpdateTaints calls PoolUpdateTaintsFunc.
+func (m *MKaaSService) PoolUpdateTaints(ctx context.Context, clusterID int, poolID int, reqBody edgecloud.MKaaSPoolUpdateTaintsRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("PoolUpdateTaints", ctx, clusterID, poolID, reqBody)
+	if m.PoolUpdateTaintsFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolUpdateTaints")
+	}
+
+	return m.PoolUpdateTaintsFunc(ctx, clusterID, poolID, reqBody)
+}
+
+// PoolsList calls PoolsListFunc.
+func (m *MKaaSService) PoolsList(ctx context.Context, clusterID int, opts *edgecloud.MKaaSPoolListOptions) ([]edgecloud.MKaaSPool, *edgecloud.Response, error) {
+	m.record("PoolsList", ctx, clusterID, opts)
+	if m.PoolsListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "PoolsList")
+	}
+
+	return m.PoolsListFunc(ctx, clusterID, opts)
+}
+
+// VersionsList calls VersionsListFunc.
+func (m *MKaaSService) VersionsList(ctx context.Context, regionID int) (*edgecloud.MKaaSKubernetesVersionsResult, *edgecloud.Response, error) {
+	m.record("VersionsList", ctx, regionID)
+	if m.VersionsListFunc == nil {
+		return nil, nil, unexpectedCall("MKaaSService", "VersionsList")
+	}
+
+	return m.VersionsListFunc(ctx, regionID)
+}
+
+var _ edgecloud.MKaaSService = (*MKaaSService)(nil)
+
+// NetworksMetadata is a mock of the edgecloud.NetworksMetadata interface.
+type NetworksMetadata struct {
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+
+	calls
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *NetworksMetadata) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("NetworksMetadata", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *NetworksMetadata) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("NetworksMetadata", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *NetworksMetadata) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("NetworksMetadata", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *NetworksMetadata) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("NetworksMetadata", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *NetworksMetadata) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("NetworksMetadata", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.NetworksMetadata = (*NetworksMetadata)(nil)
+
+// NetworksService is a mock of the edgecloud.NetworksService interface.
+type NetworksService struct {
+	CreateFunc                  func(ctx context.Context, arg1 *edgecloud.NetworkCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc                  func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc                     func(ctx context.Context, arg1 string) (*edgecloud.Network, *edgecloud.Response, error)
+	ListFunc                    func(ctx context.Context, arg1 *edgecloud.NetworkListOptions) ([]edgecloud.Network, *edgecloud.Response, error)
+	ListNetworksWithSubnetsFunc func(ctx context.Context, arg1 *edgecloud.NetworksWithSubnetsOptions) ([]edgecloud.NetworkSubnetwork, *edgecloud.Response, error)
+	MetadataCreateFunc          func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc      func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc         func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc            func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc          func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	PortListFunc                func(ctx context.Context, arg1 string) ([]edgecloud.PortsInstance, *edgecloud.Response, error)
+	UpdateNameFunc              func(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Network, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *NetworksService) Create(ctx context.Context, arg1 *edgecloud.NetworkCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("NetworksService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *NetworksService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("NetworksService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *NetworksService) Get(ctx context.Context, arg1 string) (*edgecloud.Network, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("NetworksService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *NetworksService) List(ctx context.Context, arg1 *edgecloud.NetworkListOptions) ([]edgecloud.Network, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("NetworksService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// ListNetworksWithSubnets calls ListNetworksWithSubnetsFunc.
+func (m *NetworksService) ListNetworksWithSubnets(ctx context.Context, arg1 *edgecloud.NetworksWithSubnetsOptions) ([]edgecloud.NetworkSubnetwork, *edgecloud.Response, error) {
+	m.record("ListNetworksWithSubnets", ctx, arg1)
+	if m.ListNetworksWithSubnetsFunc == nil {
+		return nil, nil, unexpectedCall("NetworksService", "ListNetworksWithSubnets")
+	}
+
+	return m.ListNetworksWithSubnetsFunc(ctx, arg1)
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *NetworksService) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("NetworksService", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *NetworksService) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("NetworksService", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *NetworksService) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("NetworksService", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *NetworksService) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("NetworksService", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *NetworksService) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("NetworksService", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+// PortList calls PortListFunc.
+func (m *NetworksService) PortList(ctx context.Context, arg1 string) ([]edgecloud.PortsInstance, *edgecloud.Response, error) {
+	m.record("PortList", ctx, arg1)
+	if m.PortListFunc == nil {
+		return nil, nil, unexpectedCall("NetworksService", "PortList")
+	}
+
+	return m.PortListFunc(ctx, arg1)
+}
+
+// UpdateName calls UpdateNameFunc.
+func (m *NetworksService) UpdateName(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Network, *edgecloud.Response, error) {
+	m.record("UpdateName", ctx, arg1, arg2)
+	if m.UpdateNameFunc == nil {
+		return nil, nil, unexpectedCall("NetworksService", "UpdateName")
+	}
+
+	return m.UpdateNameFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.NetworksService = (*NetworksService)(nil)
+
+// PortsService is a mock of the edgecloud.PortsService interface.
+type PortsService struct {
+	AssignFunc               func(ctx context.Context, arg1 string, arg2 *edgecloud.PortsAllowedAddressPairsRequest) (*edgecloud.Port, *edgecloud.Response, error)
+	DisablePortSecurityFunc  func(ctx context.Context, arg1 string) (*edgecloud.InstancePortInterface, *edgecloud.Response, error)
+	EnablePortSecurityFunc   func(ctx context.Context, arg1 string) (*edgecloud.InstancePortInterface, *edgecloud.Response, error)
+	GetAllowAddressPairsFunc func(ctx context.Context, arg1 string) (*edgecloud.Port, *edgecloud.Response, error)
+
+	calls
+}
+
+// Assign calls AssignFunc.
+func (m *PortsService) Assign(ctx context.Context, arg1 string, arg2 *edgecloud.PortsAllowedAddressPairsRequest) (*edgecloud.Port, *edgecloud.Response, error) {
+	m.record("Assign", ctx, arg1, arg2)
+	if m.AssignFunc == nil {
+		return nil, nil, unexpectedCall("PortsService", "Assign")
+	}
+
+	return m.AssignFunc(ctx, arg1, arg2)
+}
+
+// DisablePortSecurity calls DisablePortSecurityFunc.
+func (m *PortsService) DisablePortSecurity(ctx context.Context, arg1 string) (*edgecloud.InstancePortInterface, *edgecloud.Response, error) {
+	m.record("DisablePortSecurity", ctx, arg1)
+	if m.DisablePortSecurityFunc == nil {
+		return nil, nil, unexpectedCall("PortsService", "DisablePortSecurity")
+	}
+
+	return m.DisablePortSecurityFunc(ctx, arg1)
+}
+
+// EnablePortSecurity calls EnablePortSecurityFunc.
+func (m *PortsService) EnablePortSecurity(ctx context.Context, arg1 string) (*edgecloud.InstancePortInterface, *edgecloud.Response, error) {
+	m.record("EnablePortSecurity", ctx, arg1)
+	if m.EnablePortSecurityFunc == nil {
+		return nil, nil, unexpectedCall("PortsService", "EnablePortSecurity")
+	}
+
+	return m.EnablePortSecurityFunc(ctx, arg1)
+}
+
+// GetAllowAddressPairs calls GetAllowAddressPairsFunc.
+func (m *PortsService) GetAllowAddressPairs(ctx context.Context, arg1 string) (*edgecloud.Port, *edgecloud.Response, error) {
+	m.record("GetAllowAddressPairs", ctx, arg1)
+	if m.GetAllowAddressPairsFunc == nil {
+		return nil, nil, unexpectedCall("PortsService", "GetAllowAddressPairs")
+	}
+
+	return m.GetAllowAddressPairsFunc(ctx, arg1)
+}
+
+var _ edgecloud.PortsService = (*PortsService)(nil)
+
+// ProjectsService is a mock of the edgecloud.ProjectsService interface.
+type ProjectsService struct {
+	CancelScheduledDeletionFunc func(ctx context.Context, arg1 string) (*edgecloud.Project, *edgecloud.Response, error)
+	CreateFunc                  func(ctx context.Context, arg1 *edgecloud.ProjectCreateRequest) (*edgecloud.Project, *edgecloud.Response, error)
+	DeleteFunc                  func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc                     func(ctx context.Context, arg1 string) (*edgecloud.Project, *edgecloud.Response, error)
+	ListFunc                    func(ctx context.Context, arg1 *edgecloud.ProjectListOptions) ([]edgecloud.Project, *edgecloud.Response, error)
+	ScheduleDeletionFunc        func(ctx context.Context, arg1 string) (*edgecloud.Project, *edgecloud.Response, error)
+	UpdateFunc                  func(ctx context.Context, arg1 string, arg2 *edgecloud.ProjectUpdateRequest) (*edgecloud.Project, *edgecloud.Response, error)
+
+	calls
+}
+
+// CancelScheduledDeletion calls CancelScheduledDeletionFunc.
+func (m *ProjectsService) CancelScheduledDeletion(ctx context.Context, arg1 string) (*edgecloud.Project, *edgecloud.Response, error) {
+	m.record("CancelScheduledDeletion", ctx, arg1)
+	if m.CancelScheduledDeletionFunc == nil {
+		return nil, nil, unexpectedCall("ProjectsService", "CancelScheduledDeletion")
+	}
+
+	return m.CancelScheduledDeletionFunc(ctx, arg1)
+}
+
+// Create calls CreateFunc.
+func (m *ProjectsService) Create(ctx context.Context, arg1 *edgecloud.ProjectCreateRequest) (*edgecloud.Project, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("ProjectsService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *ProjectsService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("ProjectsService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *ProjectsService) Get(ctx context.Context, arg1 string) (*edgecloud.Project, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("ProjectsService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *ProjectsService) List(ctx context.Context, arg1 *edgecloud.ProjectListOptions) ([]edgecloud.Project, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("ProjectsService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// ScheduleDeletion calls ScheduleDeletionFunc.
+func (m *ProjectsService) ScheduleDeletion(ctx context.Context, arg1 string) (*edgecloud.Project, *edgecloud.Response, error) {
+	m.record("ScheduleDeletion", ctx, arg1)
+	if m.ScheduleDeletionFunc == nil {
+		return nil, nil, unexpectedCall("ProjectsService", "ScheduleDeletion")
+	}
+
+	return m.ScheduleDeletionFunc(ctx, arg1)
+}
+
+// Update calls UpdateFunc.
+func (m *ProjectsService) Update(ctx context.Context, arg1 string, arg2 *edgecloud.ProjectUpdateRequest) (*edgecloud.Project, *edgecloud.Response, error) {
+	m.record("Update", ctx, arg1, arg2)
+	if m.UpdateFunc == nil {
+		return nil, nil, unexpectedCall("ProjectsService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.ProjectsService = (*ProjectsService)(nil)
+
+// QuotasService is a mock of the edgecloud.QuotasService interface.
+type QuotasService struct {
+	DeleteNotificationThresholdFunc func(ctx context.Context, arg1 int) (*edgecloud.Response, error)
+	GetNotificationThresholdFunc    func(ctx context.Context, arg1 int) (*edgecloud.QuotaNotificationThreshold, *edgecloud.Response, error)
+	ListCombinedFunc                func(ctx context.Context, arg1 *edgecloud.ListCombinedOptions) (*edgecloud.CombinedQuota, *edgecloud.Response, error)
+	ListGlobalFunc                  func(ctx context.Context, arg1 int) (*edgecloud.Quota, *edgecloud.Response, error)
+	ListRegionalFunc                func(ctx context.Context, arg1 int, arg2 int) (*edgecloud.Quota, *edgecloud.Response, error)
+	UpdateNotificationThresholdFunc func(ctx context.Context, arg1 int, arg2 *edgecloud.NotificationThresholdUpdateRequest) (*edgecloud.QuotaNotificationThreshold, *edgecloud.Response, error)
+
+	calls
+}
+
+// DeleteNotificationThreshold calls DeleteNotificationThresholdFunc.
+func (m *QuotasService) DeleteNotificationThreshold(ctx context.Context, arg1 int) (*edgecloud.Response, error) {
+	m.record("DeleteNotificationThreshold", ctx, arg1)
+	if m.DeleteNotificationThresholdFunc == nil {
+		return nil, unexpectedCall("QuotasService", "DeleteNotificationThreshold")
+	}
+
+	return m.DeleteNotificationThresholdFunc(ctx, arg1)
+}
+
+// GetNotificationThreshold calls GetNotificationThresholdFunc.
+func (m *QuotasService) GetNotificationThreshold(ctx context.Context, arg1 int) (*edgecloud.QuotaNotificationThreshold, *edgecloud.Response, error) {
+	m.record("GetNotificationThreshold", ctx, arg1)
+	if m.GetNotificationThresholdFunc == nil {
+		return nil, nil, unexpectedCall("QuotasService", "GetNotificationThreshold")
+	}
+
+	return m.GetNotificationThresholdFunc(ctx, arg1)
+}
+
+// ListCombined calls ListCombinedFunc.
+func (m *QuotasService) ListCombined(ctx context.Context, arg1 *edgecloud.ListCombinedOptions) (*edgecloud.CombinedQuota, *edgecloud.Response, error) {
+	m.record("ListCombined", ctx, arg1)
+	if m.ListCombinedFunc == nil {
+		return nil, nil, unexpectedCall("QuotasService", "ListCombined")
+	}
+
+	return m.ListCombinedFunc(ctx, arg1)
+}
+
+// ListGlobal calls ListGlobalFunc.
+func (m *QuotasService) ListGlobal(ctx context.Context, arg1 int) (*edgecloud.Quota, *edgecloud.Response, error) {
+	m.record("ListGlobal", ctx, arg1)
+	if m.ListGlobalFunc == nil {
+		return nil, nil, unexpectedCall("QuotasService", "ListGlobal")
+	}
+
+	return m.ListGlobalFunc(ctx, arg1)
+}
+
+// ListRegional calls ListRegionalFunc.
+func (m *QuotasService) ListRegional(ctx context.Context, arg1 int, arg2 int) (*edgecloud.Quota, *edgecloud.Response, error) {
+	m.record("ListRegional", ctx, arg1, arg2)
+	if m.ListRegionalFunc == nil {
+		return nil, nil, unexpectedCall("QuotasService", "ListRegional")
+	}
+
+	return m.ListRegionalFunc(ctx, arg1, arg2)
+}
+
+// UpdateNotificationThreshold calls UpdateNotificationThresholdFunc.
+func (m *QuotasService) UpdateNotificationThreshold(ctx context.Context, arg1 int, arg2 *edgecloud.NotificationThresholdUpdateRequest) (*edgecloud.QuotaNotificationThreshold, *edgecloud.Response, error) {
+	m.record("UpdateNotificationThreshold", ctx, arg1, arg2)
+	if m.UpdateNotificationThresholdFunc == nil {
+		return nil, nil, unexpectedCall("QuotasService", "UpdateNotificationThreshold")
+	}
+
+	return m.UpdateNotificationThresholdFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.QuotasService = (*QuotasService)(nil)
+
+// RegionsService is a mock of the edgecloud.RegionsService interface.
+type RegionsService struct {
+	GetFunc  func(ctx context.Context, arg1 string, arg2 *edgecloud.RegionGetOptions) (*edgecloud.Region, *edgecloud.Response, error)
+	ListFunc func(ctx context.Context, arg1 *edgecloud.RegionListOptions) ([]edgecloud.Region, *edgecloud.Response, error)
+
+	calls
+}
+
+// Get calls GetFunc.
+func (m *RegionsService) Get(ctx context.Context, arg1 string, arg2 *edgecloud.RegionGetOptions) (*edgecloud.Region, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1, arg2)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("RegionsService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1, arg2)
+}
+
+// List calls ListFunc.
+func (m *RegionsService) List(ctx context.Context, arg1 *edgecloud.RegionListOptions) ([]edgecloud.Region, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("RegionsService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+var _ edgecloud.RegionsService = (*RegionsService)(nil)
+
+// ResellerImageV2Service is a mock of the edgecloud.ResellerImageV2Service interface.
+type ResellerImageV2Service struct {
+	DeleteFunc     func(ctx context.Context, arg1 edgecloud.EntityType, arg2 edgecloud.EntityID, arg3 *edgecloud.ResellerImageV2DeleteOptions) (*edgecloud.Response, error)
+	ListFunc       func(ctx context.Context, arg1 edgecloud.EntityType, arg2 edgecloud.EntityID) (*edgecloud.ResellerImageV2List, *edgecloud.Response, error)
+	ListByRoleFunc func(ctx context.Context) (*edgecloud.ResellerImageV2List, *edgecloud.Response, error)
+	UpdateFunc     func(ctx context.Context, arg1 *edgecloud.ResellerImageV2UpdateRequest) (*edgecloud.ResellerImageV2, *edgecloud.Response, error)
+
+	calls
+}
+
+// Delete calls DeleteFunc.
+func (m *ResellerImageV2Service) Delete(ctx context.Context, arg1 edgecloud.EntityType, arg2 edgecloud.EntityID, arg3 *edgecloud.ResellerImageV2DeleteOptions) (*edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1, arg2, arg3)
+	if m.DeleteFunc == nil {
+		return nil, unexpectedCall("ResellerImageV2Service", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1, arg2, arg3)
+}
+
+// List calls ListFunc.
+func (m *ResellerImageV2Service) List(ctx context.Context, arg1 edgecloud.EntityType, arg2 edgecloud.EntityID) (*edgecloud.ResellerImageV2List, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1, arg2)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("ResellerImageV2Service", "List")
+	}
+
+	return m.ListFunc(ctx, arg1, arg2)
+}
+
+// ListByRole calls ListByRoleFunc.
+func (m *ResellerImageV2Service) ListByRole(ctx context.Context) (*edgecloud.ResellerImageV2List, *edgecloud.Response, error) {
+	m.record("ListByRole", ctx)
+	if m.ListByRoleFunc == nil {
+		return nil, nil, unexpectedCall("ResellerImageV2Service", "ListByRole")
+	}
+
+	return m.ListByRoleFunc(ctx)
+}
+
+// Update calls UpdateFunc.
+func (m *ResellerImageV2Service) Update(ctx context.Context, arg1 *edgecloud.ResellerImageV2UpdateRequest) (*edgecloud.ResellerImageV2, *edgecloud.Response, error) {
+	m.record("Update", ctx, arg1)
+	if m.UpdateFunc == nil {
+		return nil, nil, unexpectedCall("ResellerImageV2Service", "Update")
+	}
+
+	return m.UpdateFunc(ctx, arg1)
+}
+
+var _ edgecloud.ResellerImageV2Service = (*ResellerImageV2Service)(nil)
+
+// ResellerNetworksService is a mock of the edgecloud.ResellerNetworksService interface.
+type ResellerNetworksService struct {
+	ListFunc func(ctx context.Context, arg1 *edgecloud.ResellerNetworksListRequest) (*edgecloud.ResellerNetworks, *edgecloud.Response, error)
+
+	calls
+}
+
+// List calls ListFunc.
+func (m *ResellerNetworksService) List(ctx context.Context, arg1 *edgecloud.ResellerNetworksListRequest) (*edgecloud.ResellerNetworks, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("ResellerNetworksService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+var _ edgecloud.ResellerNetworksService = (*ResellerNetworksService)(nil)
+
+// ReservedFixedIPsService is a mock of the edgecloud.ReservedFixedIPsService interface.
+type ReservedFixedIPsService struct {
+	AddInstancePortsFunc           func(ctx context.Context, arg1 string, arg2 *edgecloud.AddInstancePortsRequest) ([]edgecloud.ReservedFixedIPInstancePort, *edgecloud.Response, error)
+	CreateFunc                     func(ctx context.Context, arg1 *edgecloud.ReservedFixedIPCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc                     func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc                        func(ctx context.Context, arg1 string) (*edgecloud.ReservedFixedIP, *edgecloud.Response, error)
+	ListFunc                       func(ctx context.Context, arg1 *edgecloud.ReservedFixedIPListOptions) ([]edgecloud.ReservedFixedIP, *edgecloud.Response, error)
+	ListInstancePortsFunc          func(ctx context.Context, arg1 string) ([]edgecloud.ReservedFixedIPInstancePort, *edgecloud.Response, error)
+	ListInstancePortsAvailableFunc func(ctx context.Context, arg1 string) ([]edgecloud.ReservedFixedIPInstancePort, *edgecloud.Response, error)
+	ReplaceInstancePortsFunc       func(ctx context.Context, arg1 string, arg2 *edgecloud.AddInstancePortsRequest) ([]edgecloud.ReservedFixedIPInstancePort, *edgecloud.Response, error)
+	SwitchVIPStatusFunc            func(ctx context.Context, arg1 string, arg2 *edgecloud.SwitchVIPStatusRequest) (*edgecloud.ReservedFixedIP, *edgecloud.Response, error)
+
+	calls
+}
+
+// AddInstancePorts calls AddInstancePortsFunc.
+func (m *ReservedFixedIPsService) AddInstancePorts(ctx context.Context, arg1 string, arg2 *edgecloud.AddInstancePortsRequest) ([]edgecloud.ReservedFixedIPInstancePort, *edgecloud.Response, error) {
+	m.record("AddInstancePorts", ctx, arg1, arg2)
+	if m.AddInstancePortsFunc == nil {
+		return nil, nil, unexpectedCall("ReservedFixedIPsService", "AddInstancePorts")
+	}
+
+	return m.AddInstancePortsFunc(ctx, arg1, arg2)
+}
+
+// Create calls CreateFunc.
+func (m *ReservedFixedIPsService) Create(ctx context.Context, arg1 *edgecloud.ReservedFixedIPCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("ReservedFixedIPsService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *ReservedFixedIPsService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("ReservedFixedIPsService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *ReservedFixedIPsService) Get(ctx context.Context, arg1 string) (*edgecloud.ReservedFixedIP, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("ReservedFixedIPsService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *ReservedFixedIPsService) List(ctx context.Context, arg1 *edgecloud.ReservedFixedIPListOptions) ([]edgecloud.ReservedFixedIP, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("ReservedFixedIPsService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// ListInstancePorts calls ListInstancePortsFunc.
+func (m *ReservedFixedIPsService) ListInstancePorts(ctx context.Context, arg1 string) ([]edgecloud.ReservedFixedIPInstancePort, *edgecloud.Response, error) {
+	m.record("ListInstancePorts", ctx, arg1)
+	if m.ListInstancePortsFunc == nil {
+		return nil, nil, unexpectedCall("ReservedFixedIPsService", "ListInstancePorts")
+	}
+
+	return m.ListInstancePortsFunc(ctx, arg1)
+}
+
+// ListInstancePortsAvailable calls ListInstancePortsAvailableFunc.
+func (m *ReservedFixedIPsService) ListInstancePortsAvailable(ctx context.Context, arg1 string) ([]edgecloud.ReservedFixedIPInstancePort, *edgecloud.Response, error) {
+	m.record("ListInstancePortsAvailable", ctx, arg1)
+	if m.ListInstancePortsAvailableFunc == nil {
+		return nil, nil, unexpectedCall("ReservedFixedIPsService", "ListInstancePortsAvailable")
+	}
+
+	return m.ListInstancePortsAvailableFunc(ctx, arg1)
+}
+
+// ReplaceInstancePorts calls ReplaceInstancePortsFunc.
+func (m *ReservedFixedIPsService) ReplaceInstancePorts(ctx context.Context, arg1 string, arg2 *edgecloud.AddInstancePortsRequest) ([]edgecloud.ReservedFixedIPInstancePort, *edgecloud.Response, error) {
+	m.record("ReplaceInstancePorts", ctx, arg1, arg2)
+	if m.ReplaceInstancePortsFunc == nil {
+		return nil, nil, unexpectedCall("ReservedFixedIPsService", "ReplaceInstancePorts")
+	}
+
+	return m.ReplaceInstancePortsFunc(ctx, arg1, arg2)
+}
+
+// SwitchVIPStatus calls SwitchVIPStatusFunc.
+func (m *ReservedFixedIPsService) SwitchVIPStatus(ctx context.Context, arg1 string, arg2 *edgecloud.SwitchVIPStatusRequest) (*edgecloud.ReservedFixedIP, *edgecloud.Response, error) {
+	m.record("SwitchVIPStatus", ctx, arg1, arg2)
+	if m.SwitchVIPStatusFunc == nil {
+		return nil, nil, unexpectedCall("ReservedFixedIPsService", "SwitchVIPStatus")
+	}
+
+	return m.SwitchVIPStatusFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.ReservedFixedIPsService = (*ReservedFixedIPsService)(nil)
+
+// RoutersService is a mock of the edgecloud.RoutersService interface.
+type RoutersService struct {
+	AttachFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.RouterAttachRequest) (*edgecloud.Router, *edgecloud.Response, error)
+	CreateFunc func(ctx context.Context, arg1 *edgecloud.RouterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DetachFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.RouterDetachRequest) (*edgecloud.Router, *edgecloud.Response, error)
+	GetFunc    func(ctx context.Context, arg1 string) (*edgecloud.Router, *edgecloud.Response, error)
+	ListFunc   func(ctx context.Context) ([]edgecloud.Router, *edgecloud.Response, error)
+	UpdateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.RouterUpdateRequest) (*edgecloud.Router, *edgecloud.Response, error)
+
+	calls
+}
+
+// Attach calls AttachFunc.
+func (m *RoutersService) Attach(ctx context.Context, arg1 string, arg2 *edgecloud.RouterAttachRequest) (*edgecloud.Router, *edgecloud.Response, error) {
+	m.record("Attach", ctx, arg1, arg2)
+	if m.AttachFunc == nil {
+		return nil, nil, unexpectedCall("RoutersService", "Attach")
+	}
+
+	return m.AttachFunc(ctx, arg1, arg2)
+}
+
+// Create calls CreateFunc.
+func (m *RoutersService) Create(ctx context.Context, arg1 *edgecloud.RouterCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("RoutersService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *RoutersService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("RoutersService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Detach calls DetachFunc.
+func (m *RoutersService) Detach(ctx context.Context, arg1 string, arg2 *edgecloud.RouterDetachRequest) (*edgecloud.Router, *edgecloud.Response, error) {
+	m.record("Detach", ctx, arg1, arg2)
+	if m.DetachFunc == nil {
+		return nil, nil, unexpectedCall("RoutersService", "Detach")
+	}
+
+	return m.DetachFunc(ctx, arg1, arg2)
+}
+
+// Get calls GetFunc.
+func (m *RoutersService) Get(ctx context.Context, arg1 string) (*edgecloud.Router, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("RoutersService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *RoutersService) List(ctx context.Context) ([]edgecloud.Router, *edgecloud.Response, error) {
+	m.record("List", ctx)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("RoutersService", "List")
+	}
+
+	return m.ListFunc(ctx)
+}
+
+// Update calls UpdateFunc.
+func (m *RoutersService) Update(ctx context.Context, arg1 string, arg2 *edgecloud.RouterUpdateRequest) (*edgecloud.Router, *edgecloud.Response, error) {
+	m.record("Update", ctx, arg1, arg2)
+	if m.UpdateFunc == nil {
+		return nil, nil, unexpectedCall("RoutersService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.RoutersService = (*RoutersService)(nil)
+
+// SecretsService is a mock of the edgecloud.SecretsService interface.
+type SecretsService struct {
+	CreateFunc   func(ctx context.Context, arg1 *edgecloud.SecretCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	CreateV2Func func(ctx context.Context, arg1 *edgecloud.SecretCreateRequestV2) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc   func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc      func(ctx context.Context, arg1 string) (*edgecloud.Secret, *edgecloud.Response, error)
+	ListFunc     func(ctx context.Context) ([]edgecloud.Secret, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *SecretsService) Create(ctx context.Context, arg1 *edgecloud.SecretCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("SecretsService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// CreateV2 calls CreateV2Func.
+func (m *SecretsService) CreateV2(ctx context.Context, arg1 *edgecloud.SecretCreateRequestV2) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("CreateV2", ctx, arg1)
+	if m.CreateV2Func == nil {
+		return nil, nil, unexpectedCall("SecretsService", "CreateV2")
+	}
+
+	return m.CreateV2Func(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *SecretsService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("SecretsService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *SecretsService) Get(ctx context.Context, arg1 string) (*edgecloud.Secret, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("SecretsService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *SecretsService) List(ctx context.Context) ([]edgecloud.Secret, *edgecloud.Response, error) {
+	m.record("List", ctx)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("SecretsService", "List")
+	}
+
+	return m.ListFunc(ctx)
+}
+
+var _ edgecloud.SecretsService = (*SecretsService)(nil)
+
+// SecurityGroupsMetadata is a mock of the edgecloud.SecurityGroupsMetadata interface.
+type SecurityGroupsMetadata struct {
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+
+	calls
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *SecurityGroupsMetadata) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("SecurityGroupsMetadata", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *SecurityGroupsMetadata) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("SecurityGroupsMetadata", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *SecurityGroupsMetadata) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsMetadata", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *SecurityGroupsMetadata) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsMetadata", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *SecurityGroupsMetadata) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("SecurityGroupsMetadata", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.SecurityGroupsMetadata = (*SecurityGroupsMetadata)(nil)
+
+// SecurityGroupsRules is a mock of the edgecloud.SecurityGroupsRules interface.
+type SecurityGroupsRules struct {
+	RuleCreateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.RuleCreateRequest) (*edgecloud.SecurityGroupRule, *edgecloud.Response, error)
+	RuleDeleteFunc func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	RuleUpdateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.RuleUpdateRequest) (*edgecloud.SecurityGroupRule, *edgecloud.Response, error)
+
+	calls
+}
+
+// RuleCreate calls RuleCreateFunc.
+func (m *SecurityGroupsRules) RuleCreate(ctx context.Context, arg1 string, arg2 *edgecloud.RuleCreateRequest) (*edgecloud.SecurityGroupRule, *edgecloud.Response, error) {
+	m.record("RuleCreate", ctx, arg1, arg2)
+	if m.RuleCreateFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsRules", "RuleCreate")
+	}
+
+	return m.RuleCreateFunc(ctx, arg1, arg2)
+}
+
+// RuleDelete calls RuleDeleteFunc.
+func (m *SecurityGroupsRules) RuleDelete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("RuleDelete", ctx, arg1)
+	if m.RuleDeleteFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsRules", "RuleDelete")
+	}
+
+	return m.RuleDeleteFunc(ctx, arg1)
+}
+
+// RuleUpdate calls RuleUpdateFunc.
+func (m *SecurityGroupsRules) RuleUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.RuleUpdateRequest) (*edgecloud.SecurityGroupRule, *edgecloud.Response, error) {
+	m.record("RuleUpdate", ctx, arg1, arg2)
+	if m.RuleUpdateFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsRules", "RuleUpdate")
+	}
+
+	return m.RuleUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.SecurityGroupsRules = (*SecurityGroupsRules)(nil)
+
+// SecurityGroupsService is a mock of the edgecloud.SecurityGroupsService interface.
+type SecurityGroupsService struct {
+	CreateFunc             func(ctx context.Context, arg1 *edgecloud.SecurityGroupCreateRequest) (*edgecloud.SecurityGroup, *edgecloud.Response, error)
+	DeepCopyFunc           func(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Response, error)
+	DeleteFunc             func(ctx context.Context, arg1 string) (*edgecloud.Response, error)
+	GetFunc                func(ctx context.Context, arg1 string) (*edgecloud.SecurityGroup, *edgecloud.Response, error)
+	ListFunc               func(ctx context.Context, arg1 *edgecloud.SecurityGroupListOptions) ([]edgecloud.SecurityGroup, *edgecloud.Response, error)
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	RuleCreateFunc         func(ctx context.Context, arg1 string, arg2 *edgecloud.RuleCreateRequest) (*edgecloud.SecurityGroupRule, *edgecloud.Response, error)
+	RuleDeleteFunc         func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	RuleUpdateFunc         func(ctx context.Context, arg1 string, arg2 *edgecloud.RuleUpdateRequest) (*edgecloud.SecurityGroupRule, *edgecloud.Response, error)
+	UpdateFunc             func(ctx context.Context, arg1 string, arg2 *edgecloud.SecurityGroupUpdateRequest) (*edgecloud.SecurityGroup, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *SecurityGroupsService) Create(ctx context.Context, arg1 *edgecloud.SecurityGroupCreateRequest) (*edgecloud.SecurityGroup, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// DeepCopy calls DeepCopyFunc.
+func (m *SecurityGroupsService) DeepCopy(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Response, error) {
+	m.record("DeepCopy", ctx, arg1, arg2)
+	if m.DeepCopyFunc == nil {
+		return nil, unexpectedCall("SecurityGroupsService", "DeepCopy")
+	}
+
+	return m.DeepCopyFunc(ctx, arg1, arg2)
+}
+
+// Delete calls DeleteFunc.
+func (m *SecurityGroupsService) Delete(ctx context.Context, arg1 string) (*edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, unexpectedCall("SecurityGroupsService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *SecurityGroupsService) Get(ctx context.Context, arg1 string) (*edgecloud.SecurityGroup, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *SecurityGroupsService) List(ctx context.Context, arg1 *edgecloud.SecurityGroupListOptions) ([]edgecloud.SecurityGroup, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *SecurityGroupsService) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("SecurityGroupsService", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *SecurityGroupsService) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("SecurityGroupsService", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *SecurityGroupsService) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsService", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *SecurityGroupsService) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsService", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *SecurityGroupsService) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("SecurityGroupsService", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+// RuleCreate calls RuleCreateFunc.
+func (m *SecurityGroupsService) RuleCreate(ctx context.Context, arg1 string, arg2 *edgecloud.RuleCreateRequest) (*edgecloud.SecurityGroupRule, *edgecloud.Response, error) {
+	m.record("RuleCreate", ctx, arg1, arg2)
+	if m.RuleCreateFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsService", "RuleCreate")
+	}
+
+	return m.RuleCreateFunc(ctx, arg1, arg2)
+}
+
+// RuleDelete calls RuleDeleteFunc.
+func (m *SecurityGroupsService) RuleDelete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("RuleDelete", ctx, arg1)
+	if m.RuleDeleteFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsService", "RuleDelete")
+	}
+
+	return m.RuleDeleteFunc(ctx, arg1)
+}
+
+// RuleUpdate calls RuleUpdateFunc.
+func (m *SecurityGroupsService) RuleUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.RuleUpdateRequest) (*edgecloud.SecurityGroupRule, *edgecloud.Response, error) {
+	m.record("RuleUpdate", ctx, arg1, arg2)
+	if m.RuleUpdateFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsService", "RuleUpdate")
+	}
+
+	return m.RuleUpdateFunc(ctx, arg1, arg2)
+}
+
+// Update calls UpdateFunc.
+func (m *SecurityGroupsService) Update(ctx context.Context, arg1 string, arg2 *edgecloud.SecurityGroupUpdateRequest) (*edgecloud.SecurityGroup, *edgecloud.Response, error) {
+	m.record("Update", ctx, arg1, arg2)
+	if m.UpdateFunc == nil {
+		return nil, nil, unexpectedCall("SecurityGroupsService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.SecurityGroupsService = (*SecurityGroupsService)(nil)
+
+// ServerGroupsService is a mock of the edgecloud.ServerGroupsService interface.
+type ServerGroupsService struct {
+	CreateFunc func(ctx context.Context, arg1 *edgecloud.ServerGroupCreateRequest) (*edgecloud.ServerGroup, *edgecloud.Response, error)
+	DeleteFunc func(ctx context.Context, arg1 string) (*edgecloud.Response, error)
+	GetFunc    func(ctx context.Context, arg1 string) (*edgecloud.ServerGroup, *edgecloud.Response, error)
+	ListFunc   func(ctx context.Context) ([]edgecloud.ServerGroup, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *ServerGroupsService) Create(ctx context.Context, arg1 *edgecloud.ServerGroupCreateRequest) (*edgecloud.ServerGroup, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("ServerGroupsService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *ServerGroupsService) Delete(ctx context.Context, arg1 string) (*edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, unexpectedCall("ServerGroupsService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *ServerGroupsService) Get(ctx context.Context, arg1 string) (*edgecloud.ServerGroup, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("ServerGroupsService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *ServerGroupsService) List(ctx context.Context) ([]edgecloud.ServerGroup, *edgecloud.Response, error) {
+	m.record("List", ctx)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("ServerGroupsService", "List")
+	}
+
+	return m.ListFunc(ctx)
+}
+
+var _ edgecloud.ServerGroupsService = (*ServerGroupsService)(nil)
+
+// SnapshotsService is a mock of the edgecloud.SnapshotsService interface.
+type SnapshotsService struct {
+	CreateFunc         func(ctx context.Context, arg1 *edgecloud.SnapshotCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc         func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc            func(ctx context.Context, arg1 string) (*edgecloud.Snapshot, *edgecloud.Response, error)
+	ListFunc           func(ctx context.Context, arg1 *edgecloud.SnapshotListOptions) ([]edgecloud.Snapshot, *edgecloud.Response, error)
+	MetadataUpdateFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataCreateRequest) (*edgecloud.Snapshot, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *SnapshotsService) Create(ctx context.Context, arg1 *edgecloud.SnapshotCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("SnapshotsService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *SnapshotsService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("SnapshotsService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *SnapshotsService) Get(ctx context.Context, arg1 string) (*edgecloud.Snapshot, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("SnapshotsService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *SnapshotsService) List(ctx context.Context, arg1 *edgecloud.SnapshotListOptions) ([]edgecloud.Snapshot, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("SnapshotsService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *SnapshotsService) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataCreateRequest) (*edgecloud.Snapshot, *edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, nil, unexpectedCall("SnapshotsService", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.SnapshotsService = (*SnapshotsService)(nil)
+
+// SubnetworksMetadata is a mock of the edgecloud.SubnetworksMetadata interface.
+type SubnetworksMetadata struct {
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+
+	calls
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *SubnetworksMetadata) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("SubnetworksMetadata", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *SubnetworksMetadata) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("SubnetworksMetadata", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *SubnetworksMetadata) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("SubnetworksMetadata", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *SubnetworksMetadata) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("SubnetworksMetadata", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *SubnetworksMetadata) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("SubnetworksMetadata", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.SubnetworksMetadata = (*SubnetworksMetadata)(nil)
+
+// SubnetworksService is a mock of the edgecloud.SubnetworksService interface.
+type SubnetworksService struct {
+	CreateFunc             func(ctx context.Context, arg1 *edgecloud.SubnetworkCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc             func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc                func(ctx context.Context, arg1 string) (*edgecloud.Subnetwork, *edgecloud.Response, error)
+	ListFunc               func(ctx context.Context, arg1 *edgecloud.SubnetworkListOptions) ([]edgecloud.Subnetwork, *edgecloud.Response, error)
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	UpdateFunc             func(ctx context.Context, arg1 string, arg2 *edgecloud.SubnetworkUpdateRequest) (*edgecloud.Subnetwork, *edgecloud.Response, error)
+
+	calls
+}
+
+// Create calls CreateFunc.
+func (m *SubnetworksService) Create(ctx context.Context, arg1 *edgecloud.SubnetworkCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("SubnetworksService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *SubnetworksService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("SubnetworksService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *SubnetworksService) Get(ctx context.Context, arg1 string) (*edgecloud.Subnetwork, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("SubnetworksService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *SubnetworksService) List(ctx context.Context, arg1 *edgecloud.SubnetworkListOptions) ([]edgecloud.Subnetwork, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("SubnetworksService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *SubnetworksService) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("SubnetworksService", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *SubnetworksService) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("SubnetworksService", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *SubnetworksService) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("SubnetworksService", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *SubnetworksService) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("SubnetworksService", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *SubnetworksService) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("SubnetworksService", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+// Update calls UpdateFunc.
+func (m *SubnetworksService) Update(ctx context.Context, arg1 string, arg2 *edgecloud.SubnetworkUpdateRequest) (*edgecloud.Subnetwork, *edgecloud.Response, error) {
+	m.record("Update", ctx, arg1, arg2)
+	if m.UpdateFunc == nil {
+		return nil, nil, unexpectedCall("SubnetworksService", "Update")
+	}
+
+	return m.UpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.SubnetworksService = (*SubnetworksService)(nil)
+
+// TasksService is a mock of the edgecloud.TasksService interface.
+type TasksService struct {
+	AcknowledgeFunc    func(ctx context.Context, arg1 string) (*edgecloud.Task, *edgecloud.Response, error)
+	AcknowledgeAllFunc func(ctx context.Context, arg1 *edgecloud.TaskAcknowledgeAllOptions) (*edgecloud.Response, error)
+	GetFunc            func(ctx context.Context, arg1 string) (*edgecloud.Task, *edgecloud.Response, error)
+	ListFunc           func(ctx context.Context, arg1 *edgecloud.TaskListOptions) ([]edgecloud.Task, *edgecloud.Response, error)
+	ListActiveFunc     func(ctx context.Context) ([]edgecloud.Task, *edgecloud.Response, error)
+
+	calls
+}
+
+// Acknowledge calls AcknowledgeFunc.
+func (m *TasksService) Acknowledge(ctx context.Context, arg1 string) (*edgecloud.Task, *edgecloud.Response, error) {
+	m.record("Acknowledge", ctx, arg1)
+	if m.AcknowledgeFunc == nil {
+		return nil, nil, unexpectedCall("TasksService", "Acknowledge")
+	}
+
+	return m.AcknowledgeFunc(ctx, arg1)
+}
+
+// AcknowledgeAll calls AcknowledgeAllFunc.
+func (m *TasksService) AcknowledgeAll(ctx context.Context, arg1 *edgecloud.TaskAcknowledgeAllOptions) (*edgecloud.Response, error) {
+	m.record("AcknowledgeAll", ctx, arg1)
+	if m.AcknowledgeAllFunc == nil {
+		return nil, unexpectedCall("TasksService", "AcknowledgeAll")
+	}
+
+	return m.AcknowledgeAllFunc(ctx, arg1)
+}
+
+// Get calls GetFunc.
+func (m *TasksService) Get(ctx context.Context, arg1 string) (*edgecloud.Task, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("TasksService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *TasksService) List(ctx context.Context, arg1 *edgecloud.TaskListOptions) ([]edgecloud.Task, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("TasksService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// ListActive calls ListActiveFunc.
+func (m *TasksService) ListActive(ctx context.Context) ([]edgecloud.Task, *edgecloud.Response, error) {
+	m.record("ListActive", ctx)
+	if m.ListActiveFunc == nil {
+		return nil, nil, unexpectedCall("TasksService", "ListActive")
+	}
+
+	return m.ListActiveFunc(ctx)
+}
+
+var _ edgecloud.TasksService = (*TasksService)(nil)
+
+// UserActionsService is a mock of the edgecloud.UserActionsService interface.
+type UserActionsService struct {
+	ListAMQPSubscriptionsFunc         func(ctx context.Context) (*edgecloud.AMQPSubscriptions, *edgecloud.Response, error)
+	ListAMQPSubscriptionsWithOptsFunc func(ctx context.Context, arg1 *edgecloud.UserActionsOpts) (*edgecloud.AMQPSubscriptions, *edgecloud.Response, error)
+	ListLogSubscriptionsFunc          func(ctx context.Context) (*edgecloud.LogSubscriptions, *edgecloud.Response, error)
+	SubscribeAMQPFunc                 func(ctx context.Context, arg1 *edgecloud.AMQPSubscriptionCreateRequest) (*edgecloud.Response, error)
+	SubscribeAMQPWithOptsFunc         func(ctx context.Context, arg1 *edgecloud.UserActionsOpts, arg2 *edgecloud.AMQPSubscriptionCreateRequest) (*edgecloud.Response, error)
+	SubscribeLogFunc                  func(ctx context.Context, arg1 *edgecloud.LogSubscriptionCreateRequest) (*edgecloud.Response, error)
+	UnsubscribeAMQPFunc               func(ctx context.Context) (*edgecloud.Response, error)
+	UnsubscribeAMQPWithOptsFunc       func(ctx context.Context, arg1 *edgecloud.UserActionsOpts) (*edgecloud.Response, error)
+	UnsubscribeLogFunc                func(ctx context.Context) (*edgecloud.Response, error)
+
+	calls
+}
+
+// ListAMQPSubscriptions calls ListAMQPSubscriptionsFunc.
+func (m *UserActionsService) ListAMQPSubscriptions(ctx context.Context) (*edgecloud.AMQPSubscriptions, *edgecloud.Response, error) {
+	m.record("ListAMQPSubscriptions", ctx)
+	if m.ListAMQPSubscriptionsFunc == nil {
+		return nil, nil, unexpectedCall("UserActionsService", "ListAMQPSubscriptions")
+	}
+
+	return m.ListAMQPSubscriptionsFunc(ctx)
+}
+
+// ListAMQPSubscriptionsWithOpts calls ListAMQPSubscriptionsWithOptsFunc.
+func (m *UserActionsService) ListAMQPSubscriptionsWithOpts(ctx context.Context, arg1 *edgecloud.UserActionsOpts) (*edgecloud.AMQPSubscriptions, *edgecloud.Response, error) {
+	m.record("ListAMQPSubscriptionsWithOpts", ctx, arg1)
+	if m.ListAMQPSubscriptionsWithOptsFunc == nil {
+		return nil, nil, unexpectedCall("UserActionsService", "ListAMQPSubscriptionsWithOpts")
+	}
+
+	return m.ListAMQPSubscriptionsWithOptsFunc(ctx, arg1)
+}
+
+// ListLogSubscriptions calls ListLogSubscriptionsFunc.
+func (m *UserActionsService) ListLogSubscriptions(ctx context.Context) (*edgecloud.LogSubscriptions, *edgecloud.Response, error) {
+	m.record("ListLogSubscriptions", ctx)
+	if m.ListLogSubscriptionsFunc == nil {
+		return nil, nil, unexpectedCall("UserActionsService", "ListLogSubscriptions")
+	}
+
+	return m.ListLogSubscriptionsFunc(ctx)
+}
+
+// SubscribeAMQP calls SubscribeAMQPFunc.
+func (m *UserActionsService) SubscribeAMQP(ctx context.Context, arg1 *edgecloud.AMQPSubscriptionCreateRequest) (*edgecloud.Response, error) {
+	m.record("SubscribeAMQP", ctx, arg1)
+	if m.SubscribeAMQPFunc == nil {
+		return nil, unexpectedCall("UserActionsService", "SubscribeAMQP")
+	}
+
+	return m.SubscribeAMQPFunc(ctx, arg1)
+}
+
+// SubscribeAMQPWithOpts calls SubscribeAMQPWithOptsFunc.
+func (m *UserActionsService) SubscribeAMQPWithOpts(ctx context.Context, arg1 *edgecloud.UserActionsOpts, arg2 *edgecloud.AMQPSubscriptionCreateRequest) (*edgecloud.Response, error) {
+	m.record("SubscribeAMQPWithOpts", ctx, arg1, arg2)
+	if m.SubscribeAMQPWithOptsFunc == nil {
+		return nil, unexpectedCall("UserActionsService", "SubscribeAMQPWithOpts")
+	}
+
+	return m.SubscribeAMQPWithOptsFunc(ctx, arg1, arg2)
+}
+
+// SubscribeLog calls SubscribeLogFunc.
+func (m *UserActionsService) SubscribeLog(ctx context.Context, arg1 *edgecloud.LogSubscriptionCreateRequest) (*edgecloud.Response, error) {
+	m.record("SubscribeLog", ctx, arg1)
+	if m.SubscribeLogFunc == nil {
+		return nil, unexpectedCall("UserActionsService", "SubscribeLog")
+	}
+
+	return m.SubscribeLogFunc(ctx, arg1)
+}
+
+// UnsubscribeAMQP calls UnsubscribeAMQPFunc.
+func (m *UserActionsService) UnsubscribeAMQP(ctx context.Context) (*edgecloud.Response, error) {
+	m.record("UnsubscribeAMQP", ctx)
+	if m.UnsubscribeAMQPFunc == nil {
+		return nil, unexpectedCall("UserActionsService", "UnsubscribeAMQP")
+	}
+
+	return m.UnsubscribeAMQPFunc(ctx)
+}
+
+// UnsubscribeAMQPWithOpts calls UnsubscribeAMQPWithOptsFunc.
+func (m *UserActionsService) UnsubscribeAMQPWithOpts(ctx context.Context, arg1 *edgecloud.UserActionsOpts) (*edgecloud.Response, error) {
+	m.record("UnsubscribeAMQPWithOpts", ctx, arg1)
+	if m.UnsubscribeAMQPWithOptsFunc == nil {
+		return nil, unexpectedCall("UserActionsService", "UnsubscribeAMQPWithOpts")
+	}
+
+	return m.UnsubscribeAMQPWithOptsFunc(ctx, arg1)
+}
+
+// UnsubscribeLog calls UnsubscribeLogFunc.
+func (m *UserActionsService) UnsubscribeLog(ctx context.Context) (*edgecloud.Response, error) {
+	m.record("UnsubscribeLog", ctx)
+	if m.UnsubscribeLogFunc == nil {
+		return nil, unexpectedCall("UserActionsService", "UnsubscribeLog")
+	}
+
+	return m.UnsubscribeLogFunc(ctx)
+}
+
+var _ edgecloud.UserActionsService = (*UserActionsService)(nil)
+
+// UsersService is a mock of the edgecloud.UsersService interface.
+type UsersService struct {
+	AssignRoleFunc       func(ctx context.Context, arg1 *edgecloud.UpdateAssignmentRequest) (*edgecloud.UserRole, *edgecloud.Response, error)
+	DeleteAssignmentFunc func(ctx context.Context, arg1 int) (*edgecloud.Response, error)
+	ListFunc             func(ctx context.Context, arg1 *edgecloud.UserListOptions) ([]edgecloud.User, *edgecloud.Response, error)
+	ListAssignmentFunc   func(ctx context.Context, arg1 *edgecloud.UserRoleListOptions) ([]edgecloud.RoleAssignment, *edgecloud.Response, error)
+	ListRolesFunc        func(ctx context.Context, arg1 *edgecloud.UserRoleListOptions) ([]edgecloud.UserRole, *edgecloud.Response, error)
+	UpdateAssignmentFunc func(ctx context.Context, arg1 int, arg2 *edgecloud.UpdateAssignmentRequest) (*edgecloud.Response, error)
+
+	calls
+}
+
+// AssignRole calls AssignRoleFunc.
+func (m *UsersService) AssignRole(ctx context.Context, arg1 *edgecloud.UpdateAssignmentRequest) (*edgecloud.UserRole, *edgecloud.Response, error) {
+	m.record("AssignRole", ctx, arg1)
+	if m.AssignRoleFunc == nil {
+		return nil, nil, unexpectedCall("UsersService", "AssignRole")
+	}
+
+	return m.AssignRoleFunc(ctx, arg1)
+}
+
+// DeleteAssignment calls DeleteAssignmentFunc.
+func (m *UsersService) DeleteAssignment(ctx context.Context, arg1 int) (*edgecloud.Response, error) {
+	m.record("DeleteAssignment", ctx, arg1)
+	if m.DeleteAssignmentFunc == nil {
+		return nil, unexpectedCall("UsersService", "DeleteAssignment")
+	}
+
+	return m.DeleteAssignmentFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *UsersService) List(ctx context.Context, arg1 *edgecloud.UserListOptions) ([]edgecloud.User, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("UsersService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// ListAssignment calls ListAssignmentFunc.
+func (m *UsersService) ListAssignment(ctx context.Context, arg1 *edgecloud.UserRoleListOptions) ([]edgecloud.RoleAssignment, *edgecloud.Response, error) {
+	m.record("ListAssignment", ctx, arg1)
+	if m.ListAssignmentFunc == nil {
+		return nil, nil, unexpectedCall("UsersService", "ListAssignment")
+	}
+
+	return m.ListAssignmentFunc(ctx, arg1)
+}
+
+// ListRoles calls ListRolesFunc.
+func (m *UsersService) ListRoles(ctx context.Context, arg1 *edgecloud.UserRoleListOptions) ([]edgecloud.UserRole, *edgecloud.Response, error) {
+	m.record("ListRoles", ctx, arg1)
+	if m.ListRolesFunc == nil {
+		return nil, nil, unexpectedCall("UsersService", "ListRoles")
+	}
+
+	return m.ListRolesFunc(ctx, arg1)
+}
+
+// UpdateAssignment calls UpdateAssignmentFunc.
+func (m *UsersService) UpdateAssignment(ctx context.Context, arg1 int, arg2 *edgecloud.UpdateAssignmentRequest) (*edgecloud.Response, error) {
+	m.record("UpdateAssignment", ctx, arg1, arg2)
+	if m.UpdateAssignmentFunc == nil {
+		return nil, unexpectedCall("UsersService", "UpdateAssignment")
+	}
+
+	return m.UpdateAssignmentFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.UsersService = (*UsersService)(nil)
+
+// VolumeMetadata is a mock of the edgecloud.VolumeMetadata interface.
+type VolumeMetadata struct {
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+
+	calls
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *VolumeMetadata) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("VolumeMetadata", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *VolumeMetadata) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("VolumeMetadata", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *VolumeMetadata) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("VolumeMetadata", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *VolumeMetadata) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("VolumeMetadata", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *VolumeMetadata) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("VolumeMetadata", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+var _ edgecloud.VolumeMetadata = (*VolumeMetadata)(nil)
+
+// VolumesService is a mock of the edgecloud.VolumesService interface.
+type VolumesService struct {
+	AttachFunc             func(ctx context.Context, arg1 string, arg2 *edgecloud.VolumeAttachRequest) (*edgecloud.Volume, *edgecloud.Response, error)
+	ChangeTypeFunc         func(ctx context.Context, arg1 string, arg2 *edgecloud.VolumeChangeTypeRequest) (*edgecloud.Volume, *edgecloud.Response, error)
+	CreateFunc             func(ctx context.Context, arg1 *edgecloud.VolumeCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DeleteFunc             func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	DetachFunc             func(ctx context.Context, arg1 string, arg2 *edgecloud.VolumeDetachRequest) (*edgecloud.Volume, *edgecloud.Response, error)
+	ExtendFunc             func(ctx context.Context, arg1 string, arg2 *edgecloud.VolumeExtendSizeRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+	GetFunc                func(ctx context.Context, arg1 string) (*edgecloud.Volume, *edgecloud.Response, error)
+	ListFunc               func(ctx context.Context, arg1 *edgecloud.VolumeListOptions) ([]edgecloud.Volume, *edgecloud.Response, error)
+	MetadataCreateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	MetadataDeleteItemFunc func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error)
+	MetadataGetItemFunc    func(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataListFunc       func(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error)
+	MetadataUpdateFunc     func(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error)
+	RenameFunc             func(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Volume, *edgecloud.Response, error)
+	RevertFunc             func(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error)
+
+	calls
+}
+
+// Attach calls AttachFunc.
+func (m *VolumesService) Attach(ctx context.Context, arg1 string, arg2 *edgecloud.VolumeAttachRequest) (*edgecloud.Volume, *edgecloud.Response, error) {
+	m.record("Attach", ctx, arg1, arg2)
+	if m.AttachFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "Attach")
+	}
+
+	return m.AttachFunc(ctx, arg1, arg2)
+}
+
+// ChangeType calls ChangeTypeFunc.
+func (m *VolumesService) ChangeType(ctx context.Context, arg1 string, arg2 *edgecloud.VolumeChangeTypeRequest) (*edgecloud.Volume, *edgecloud.Response, error) {
+	m.record("ChangeType", ctx, arg1, arg2)
+	if m.ChangeTypeFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "ChangeType")
+	}
+
+	return m.ChangeTypeFunc(ctx, arg1, arg2)
+}
+
+// Create calls CreateFunc.
+func (m *VolumesService) Create(ctx context.Context, arg1 *edgecloud.VolumeCreateRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Create", ctx, arg1)
+	if m.CreateFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "Create")
+	}
+
+	return m.CreateFunc(ctx, arg1)
+}
+
+// Delete calls DeleteFunc.
+func (m *VolumesService) Delete(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Delete", ctx, arg1)
+	if m.DeleteFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "Delete")
+	}
+
+	return m.DeleteFunc(ctx, arg1)
+}
+
+// Detach calls DetachFunc.
+func (m *VolumesService) Detach(ctx context.Context, arg1 string, arg2 *edgecloud.VolumeDetachRequest) (*edgecloud.Volume, *edgecloud.Response, error) {
+	m.record("Detach", ctx, arg1, arg2)
+	if m.DetachFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "Detach")
+	}
+
+	return m.DetachFunc(ctx, arg1, arg2)
+}
+
+// Extend calls ExtendFunc.
+func (m *VolumesService) Extend(ctx context.Context, arg1 string, arg2 *edgecloud.VolumeExtendSizeRequest) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Extend", ctx, arg1, arg2)
+	if m.ExtendFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "Extend")
+	}
+
+	return m.ExtendFunc(ctx, arg1, arg2)
+}
+
+// Get calls GetFunc.
+func (m *VolumesService) Get(ctx context.Context, arg1 string) (*edgecloud.Volume, *edgecloud.Response, error) {
+	m.record("Get", ctx, arg1)
+	if m.GetFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "Get")
+	}
+
+	return m.GetFunc(ctx, arg1)
+}
+
+// List calls ListFunc.
+func (m *VolumesService) List(ctx context.Context, arg1 *edgecloud.VolumeListOptions) ([]edgecloud.Volume, *edgecloud.Response, error) {
+	m.record("List", ctx, arg1)
+	if m.ListFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "List")
+	}
+
+	return m.ListFunc(ctx, arg1)
+}
+
+// MetadataCreate calls MetadataCreateFunc.
+func (m *VolumesService) MetadataCreate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataCreate", ctx, arg1, arg2)
+	if m.MetadataCreateFunc == nil {
+		return nil, unexpectedCall("VolumesService", "MetadataCreate")
+	}
+
+	return m.MetadataCreateFunc(ctx, arg1, arg2)
+}
+
+// MetadataDeleteItem calls MetadataDeleteItemFunc.
+func (m *VolumesService) MetadataDeleteItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.Response, error) {
+	m.record("MetadataDeleteItem", ctx, arg1, arg2)
+	if m.MetadataDeleteItemFunc == nil {
+		return nil, unexpectedCall("VolumesService", "MetadataDeleteItem")
+	}
+
+	return m.MetadataDeleteItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataGetItem calls MetadataGetItemFunc.
+func (m *VolumesService) MetadataGetItem(ctx context.Context, arg1 string, arg2 *edgecloud.MetadataItemOptions) (*edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataGetItem", ctx, arg1, arg2)
+	if m.MetadataGetItemFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "MetadataGetItem")
+	}
+
+	return m.MetadataGetItemFunc(ctx, arg1, arg2)
+}
+
+// MetadataList calls MetadataListFunc.
+func (m *VolumesService) MetadataList(ctx context.Context, arg1 string) ([]edgecloud.MetadataDetailed, *edgecloud.Response, error) {
+	m.record("MetadataList", ctx, arg1)
+	if m.MetadataListFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "MetadataList")
+	}
+
+	return m.MetadataListFunc(ctx, arg1)
+}
+
+// MetadataUpdate calls MetadataUpdateFunc.
+func (m *VolumesService) MetadataUpdate(ctx context.Context, arg1 string, arg2 *edgecloud.Metadata) (*edgecloud.Response, error) {
+	m.record("MetadataUpdate", ctx, arg1, arg2)
+	if m.MetadataUpdateFunc == nil {
+		return nil, unexpectedCall("VolumesService", "MetadataUpdate")
+	}
+
+	return m.MetadataUpdateFunc(ctx, arg1, arg2)
+}
+
+// Rename calls RenameFunc.
+func (m *VolumesService) Rename(ctx context.Context, arg1 string, arg2 *edgecloud.Name) (*edgecloud.Volume, *edgecloud.Response, error) {
+	m.record("Rename", ctx, arg1, arg2)
+	if m.RenameFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "Rename")
+	}
+
+	return m.RenameFunc(ctx, arg1, arg2)
+}
+
+// Revert calls RevertFunc.
+func (m *VolumesService) Revert(ctx context.Context, arg1 string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+	m.record("Revert", ctx, arg1)
+	if m.RevertFunc == nil {
+		return nil, nil, unexpectedCall("VolumesService", "Revert")
+	}
+
+	return m.RevertFunc(ctx, arg1)
+}
+
+var _ edgecloud.VolumesService = (*VolumesService)(nil)
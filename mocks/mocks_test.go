@@ -0,0 +1,100 @@
+package mocks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+const testResourceID = "f0d19cec-5c3f-4853-886e-304915960ff6"
+
+func TestLoadbalancersService(t *testing.T) {
+	ctx := context.Background()
+	loadbalancers := &LoadbalancersService{
+		ListFunc: func(_ context.Context, _ *edgecloud.LoadbalancerListOptions) ([]edgecloud.Loadbalancer, *edgecloud.Response, error) {
+			return []edgecloud.Loadbalancer{
+				{ID: testResourceID, Name: "test-lb", ProvisioningStatus: edgecloud.ProvisioningStatusActive},
+				{ID: "deleted", Name: "test-lb", ProvisioningStatus: edgecloud.ProvisioningStatusDeleted},
+			}, nil, nil
+		},
+	}
+	client := &edgecloud.Client{Loadbalancers: loadbalancers}
+
+	lb, err := util.LoadbalancerGetByName(ctx, client, "test-lb")
+	require.NoError(t, err)
+	assert.Equal(t, testResourceID, lb.ID)
+
+	calls := loadbalancers.CallsTo("List")
+	require.Len(t, calls, 1)
+	assert.Equal(t, []interface{}{ctx, (*edgecloud.LoadbalancerListOptions)(nil)}, calls[0].Args)
+	assert.Equal(t, calls, loadbalancers.Calls())
+
+	loadbalancers.ResetCalls()
+	assert.Empty(t, loadbalancers.Calls())
+}
+
+func TestUnexpectedCall(t *testing.T) {
+	instances := &InstancesService{}
+
+	instance, resp, err := instances.Get(context.Background(), testResourceID)
+	require.ErrorIs(t, err, ErrUnexpectedCall)
+	assert.EqualError(t, err, "unexpected call of mock method InstancesService.Get")
+	assert.Nil(t, instance)
+	assert.Nil(t, resp)
+	assert.Len(t, instances.CallsTo("Get"), 1)
+}
+
+func TestEmbeddedInterfaces(t *testing.T) {
+	ctx := context.Background()
+	dbaas := &DBaaSService{
+		BackupDeleteFunc: func(_ context.Context, _ string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+			return nil, nil, edgecloud.ErrNotFound
+		},
+		BackupGetFunc: func(_ context.Context, _ string, _ bool) (*edgecloud.DBaaSBackup, *edgecloud.Response, error) {
+			return nil, nil, edgecloud.ErrNotFound
+		},
+	}
+	client := &edgecloud.Client{DBaaS: dbaas}
+
+	require.NoError(t, util.DeleteResourceIfExist(ctx, client, client.DBaaS, testResourceID))
+	calls := dbaas.Calls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "BackupDelete", calls[0].Method)
+	assert.Equal(t, "BackupGet", calls[1].Method)
+	assert.Equal(t, []interface{}{ctx, testResourceID, false}, calls[1].Args)
+}
+
+func TestDeleteResourceIfExist_SynchronousDeletion(t *testing.T) {
+	ctx := context.Background()
+	securityGroups := &SecurityGroupsService{
+		DeleteFunc: func(_ context.Context, _ string) (*edgecloud.Response, error) {
+			return nil, nil
+		},
+		GetFunc: func(_ context.Context, _ string) (*edgecloud.SecurityGroup, *edgecloud.Response, error) {
+			return nil, nil, edgecloud.ErrNotFound
+		},
+	}
+	serverGroups := &ServerGroupsService{
+		DeleteFunc: func(_ context.Context, _ string) (*edgecloud.Response, error) {
+			return nil, edgecloud.ErrNotFound
+		},
+		GetFunc: func(_ context.Context, _ string) (*edgecloud.ServerGroup, *edgecloud.Response, error) {
+			return nil, nil, edgecloud.ErrNotFound
+		},
+	}
+
+	require.NoError(t, util.DeleteResourceIfExist(ctx, nil, securityGroups, testResourceID))
+	calls := securityGroups.Calls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "Delete", calls[0].Method)
+	assert.Equal(t, "Get", calls[1].Method)
+
+	require.NoError(t, util.DeleteResourceIfExist(ctx, nil, serverGroups, testResourceID))
+	assert.Len(t, serverGroups.CallsTo("Delete"), 1)
+	assert.Len(t, serverGroups.CallsTo("Get"), 1)
+}
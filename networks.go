@@ -48,7 +48,7 @@ var _ NetworksService = &NetworksServiceOp{}
 type Network struct {
 	ID             string             `json:"id"`
 	Name           string             `json:"name"`
-	CreatedAt      string             `json:"created_at"`
+	CreatedAt      Timestamp          `json:"created_at"`
 	CreatorTaskID  string             `json:"creator_task_id"`
 	Default        bool               `json:"default"`
 	External       bool               `json:"external"`
@@ -62,7 +62,7 @@ type Network struct {
 	Subnets        []string           `json:"subnets"`
 	TaskID         string             `json:"task_id"`
 	Type           string             `json:"type"`
-	UpdatedAt      string             `json:"updated_at"`
+	UpdatedAt      Timestamp          `json:"updated_at"`
 }
 
 // NetworkCreateRequest represents a request to create a Network.
@@ -96,9 +96,9 @@ type networksRoot struct {
 // NetworkSubnetwork represents an EdgecenterCloud Network with info about Subnets.
 type NetworkSubnetwork struct {
 	Metadata       []MetadataDetailed `json:"metadata,omitempty"`
-	UpdatedAt      string             `json:"updated_at"`
+	UpdatedAt      Timestamp          `json:"updated_at"`
 	Name           string             `json:"name"`
-	CreatedAt      string             `json:"created_at"`
+	CreatedAt      Timestamp          `json:"created_at"`
 	Type           string             `json:"type"`
 	External       bool               `json:"external"`
 	TaskID         string             `json:"task_id"`
@@ -145,11 +145,13 @@ type networksSubnetworksRoot struct {
 
 // List get networks.
 func (s *NetworksServiceOp) List(ctx context.Context, opts *NetworkListOptions) ([]Network, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Networks.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(networksBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, networksBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -171,15 +173,17 @@ func (s *NetworksServiceOp) List(ctx context.Context, opts *NetworkListOptions)
 
 // Get individual Network.
 func (s *NetworksServiceOp) Get(ctx context.Context, networkID string) (*Network, *Response, error) {
+	ctx = withOperation(ctx, "Networks.Get")
+
 	if resp, err := isValidUUID(networkID, "networkID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(networksBasePathV1), networkID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, networksBasePathV1), networkID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -197,15 +201,21 @@ func (s *NetworksServiceOp) Get(ctx context.Context, networkID string) (*Network
 
 // Create a Network.
 func (s *NetworksServiceOp) Create(ctx context.Context, reqBody *NetworkCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Networks.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(networksBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, networksBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -223,15 +233,17 @@ func (s *NetworksServiceOp) Create(ctx context.Context, reqBody *NetworkCreateRe
 
 // Delete the Network.
 func (s *NetworksServiceOp) Delete(ctx context.Context, networkID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Networks.Delete")
+
 	if resp, err := isValidUUID(networkID, "networkID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(networksBasePathV1), networkID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, networksBasePathV1), networkID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -249,15 +261,17 @@ func (s *NetworksServiceOp) Delete(ctx context.Context, networkID string) (*Task
 
 // UpdateName of the network.
 func (s *NetworksServiceOp) UpdateName(ctx context.Context, networkID string, reqBody *Name) (*Network, *Response, error) {
+	ctx = withOperation(ctx, "Networks.UpdateName")
+
 	if resp, err := isValidUUID(networkID, "networkID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(networksBasePathV1), networkID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, networksBasePathV1), networkID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -275,11 +289,13 @@ func (s *NetworksServiceOp) UpdateName(ctx context.Context, networkID string, re
 
 // ListNetworksWithSubnets get networks with details of subnets.
 func (s *NetworksServiceOp) ListNetworksWithSubnets(ctx context.Context, opts *NetworksWithSubnetsOptions) ([]NetworkSubnetwork, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Networks.ListNetworksWithSubnets")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(availablenetworksBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, availablenetworksBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -301,11 +317,13 @@ func (s *NetworksServiceOp) ListNetworksWithSubnets(ctx context.Context, opts *N
 
 // PortList get instance ports by network_id.
 func (s *NetworksServiceOp) PortList(ctx context.Context, networkID string) ([]PortsInstance, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Networks.PortList")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(networksBasePathV1), networkID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, networksBasePathV1), networkID)
 	path = fmt.Sprintf("%s/%s", path, networksPorts)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -324,11 +342,13 @@ func (s *NetworksServiceOp) PortList(ctx context.Context, networkID string) ([]P
 
 // MetadataList network detailed metadata items.
 func (s *NetworksServiceOp) MetadataList(ctx context.Context, networkID string) ([]MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Networks.MetadataList")
+
 	if resp, err := isValidUUID(networkID, "networkID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -337,11 +357,13 @@ func (s *NetworksServiceOp) MetadataList(ctx context.Context, networkID string)
 
 // MetadataCreate or update network metadata.
 func (s *NetworksServiceOp) MetadataCreate(ctx context.Context, networkID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Networks.MetadataCreate")
+
 	if resp, err := isValidUUID(networkID, "networkID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -350,11 +372,13 @@ func (s *NetworksServiceOp) MetadataCreate(ctx context.Context, networkID string
 
 // MetadataUpdate network metadata.
 func (s *NetworksServiceOp) MetadataUpdate(ctx context.Context, networkID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Networks.MetadataUpdate")
+
 	if resp, err := isValidUUID(networkID, "networkID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -363,11 +387,13 @@ func (s *NetworksServiceOp) MetadataUpdate(ctx context.Context, networkID string
 
 // MetadataDeleteItem a network metadata item by key.
 func (s *NetworksServiceOp) MetadataDeleteItem(ctx context.Context, networkID string, opts *MetadataItemOptions) (*Response, error) {
+	ctx = withOperation(ctx, "Networks.MetadataDeleteItem")
+
 	if resp, err := isValidUUID(networkID, "networkID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -376,11 +402,13 @@ func (s *NetworksServiceOp) MetadataDeleteItem(ctx context.Context, networkID st
 
 // MetadataGetItem network detailed metadata.
 func (s *NetworksServiceOp) MetadataGetItem(ctx context.Context, networkID string, opts *MetadataItemOptions) (*MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Networks.MetadataGetItem")
+
 	if resp, err := isValidUUID(networkID, "networkID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
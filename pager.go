@@ -0,0 +1,121 @@
+package edgecloud
+
+import (
+	"context"
+)
+
+const defaultPageSize = 100
+
+// PageFunc fetches a single page of at most limit resources starting at offset.
+// It usually wraps a List method, passing limit and offset into its list options.
+type PageFunc[T any] func(ctx context.Context, limit, offset int) ([]T, *Response, error)
+
+// Pager lazily iterates over all resources of a paged List method, requesting the next page only
+// when the current one is exhausted.
+//
+//	pager := edgecloud.NewPager(func(ctx context.Context, limit, offset int) ([]edgecloud.Instance, *edgecloud.Response, error) {
+//		return client.Instances.List(ctx, &edgecloud.InstanceListOptions{Limit: limit, Offset: offset})
+//	}, 0)
+//	for pager.Next(ctx) {
+//		instance := pager.Item()
+//		...
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	fetch    PageFunc[T]
+	pageSize int
+
+	offset int
+	total  int
+	page   []T
+	index  int
+	done   bool
+	err    error
+}
+
+// NewPager returns a Pager that requests pages of pageSize resources using fetch.
+// If pageSize is not positive, a page size of 100 is used.
+func NewPager[T any](fetch PageFunc[T], pageSize int) *Pager[T] {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	return &Pager[T]{fetch: fetch, pageSize: pageSize, index: -1}
+}
+
+// Next advances the pager to the next resource, fetching a new page if needed. It returns false when
+// all resources have been read, the context is done or an error occurred; check Err to tell them apart.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	if p.index+1 < len(p.page) {
+		p.index++
+		return true
+	}
+
+	if p.done {
+		return false
+	}
+
+	page, resp, err := p.fetch(ctx, p.pageSize, p.offset)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	p.page = page
+	p.index = 0
+	p.offset += len(page)
+
+	counted := resp != nil && resp.Meta != nil && resp.Meta.Count > 0
+	if counted {
+		p.total = resp.Meta.Count
+	} else if p.total < p.offset {
+		p.total = p.offset
+	}
+
+	// The API may cap the limit below the page size, so a short page ends the iteration only when the
+	// total count is unknown.
+	if counted {
+		p.done = len(page) == 0 || p.offset >= p.total
+	} else {
+		p.done = len(page) < p.pageSize
+	}
+
+	return len(page) > 0
+}
+
+// Item returns the current resource. It must be called only after Next has returned true.
+func (p *Pager[T]) Item() T {
+	return p.page[p.index]
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Total returns the total number of resources reported by the API with the last fetched page.
+// If the API does not report it, Total returns the number of resources fetched so far.
+func (p *Pager[T]) Total() int {
+	return p.total
+}
+
+// All reads all remaining resources of the pager.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for p.Next(ctx) {
+		items = append(items, p.Item())
+	}
+
+	return items, p.Err()
+}
@@ -0,0 +1,165 @@
+package edgecloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func handlePagedInstances(t *testing.T, instances []Instance, withCount bool, requests *int) {
+	t.Helper()
+
+	URL := path.Join(instancesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		*requests++
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := offset + limit
+		if end > len(instances) {
+			end = len(instances)
+		}
+
+		resp, err := json.Marshal(instances[offset:end])
+		if err != nil {
+			t.Errorf("failed to marshal response: %v", err)
+		}
+		if withCount {
+			_, _ = fmt.Fprintf(w, `{"count":%d,"results":%s}`, len(instances), string(resp))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"results":%s}`, string(resp))
+	})
+}
+
+func listInstancesPage(ctx context.Context, limit, offset int) ([]Instance, *Response, error) {
+	return client.Instances.List(ctx, &InstanceListOptions{Limit: limit, Offset: offset})
+}
+
+func TestPager_All(t *testing.T) {
+	setup()
+	defer teardown()
+
+	instances := []Instance{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}, {ID: "5"}}
+	var requests int
+	handlePagedInstances(t, instances, true, &requests)
+
+	pager := NewPager(listInstancesPage, 2)
+	actual, err := pager.All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, instances, actual)
+	assert.Equal(t, 5, pager.Total())
+	assert.Equal(t, 3, requests)
+}
+
+func TestPager_All_ExactPagesWithCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	instances := []Instance{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}}
+	var requests int
+	handlePagedInstances(t, instances, true, &requests)
+
+	actual, err := NewPager(listInstancesPage, 2).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, instances, actual)
+	assert.Equal(t, 2, requests)
+}
+
+func TestPager_All_WithoutCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	instances := []Instance{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}}
+	var requests int
+	handlePagedInstances(t, instances, false, &requests)
+
+	pager := NewPager(listInstancesPage, 2)
+	actual, err := pager.All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, instances, actual)
+	assert.Equal(t, 4, pager.Total())
+	assert.Equal(t, 3, requests)
+}
+
+func TestPager_All_CappedLimitWithCount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	const maxLimit = 2
+	instances := []Instance{{ID: "1"}, {ID: "2"}, {ID: "3"}, {ID: "4"}, {ID: "5"}}
+	var requests int
+	URL := path.Join(instancesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := min(offset+min(limit, maxLimit), len(instances))
+
+		resp, err := json.Marshal(instances[offset:end])
+		if err != nil {
+			t.Errorf("failed to marshal response: %v", err)
+		}
+		_, _ = fmt.Fprintf(w, `{"count":%d,"results":%s}`, len(instances), string(resp))
+	})
+
+	actual, err := NewPager(listInstancesPage, 10).All(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, instances, actual)
+	assert.Equal(t, 3, requests)
+}
+
+func TestPager_Next_ContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	instances := []Instance{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+	var requests int
+	handlePagedInstances(t, instances, true, &requests)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	pager := NewPager(listInstancesPage, 1)
+	require.True(t, pager.Next(cancelCtx))
+	assert.Equal(t, "1", pager.Item().ID)
+
+	cancel()
+	assert.False(t, pager.Next(cancelCtx))
+	assert.ErrorIs(t, pager.Err(), context.Canceled)
+	assert.Equal(t, 1, requests)
+}
+
+func TestPager_Next_ResponseError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	URL := path.Join(instancesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprint(w, "Bad request")
+	})
+
+	pager := NewPager(listInstancesPage, 0)
+	assert.False(t, pager.Next(ctx))
+	assert.Error(t, pager.Err())
+}
+
+func TestInstances_List_Meta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	handlePagedInstances(t, []Instance{{ID: testResourceID}}, true, &requests)
+
+	_, resp, err := client.Instances.List(ctx, &InstanceListOptions{Limit: 10})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Meta)
+	assert.Equal(t, 1, resp.Meta.Count)
+}
@@ -85,15 +85,17 @@ type PortIP struct {
 
 // Assign allowed address pairs for an instance port.
 func (s *PortsServiceOp) Assign(ctx context.Context, portID string, reqBody *PortsAllowedAddressPairsRequest) (*Port, *Response, error) {
+	ctx = withOperation(ctx, "Ports.Assign")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(portsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, portsBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, portID, portsAllowAddressPairs)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody)
@@ -112,11 +114,13 @@ func (s *PortsServiceOp) Assign(ctx context.Context, portID string, reqBody *Por
 
 // EnablePortSecurity for an instance interface.
 func (s *PortsServiceOp) EnablePortSecurity(ctx context.Context, portID string) (*InstancePortInterface, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Ports.EnablePortSecurity")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(portsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, portsBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, portID, portsEnableSecurity)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
@@ -135,11 +139,13 @@ func (s *PortsServiceOp) EnablePortSecurity(ctx context.Context, portID string)
 
 // DisablePortSecurity for an instance interface.
 func (s *PortsServiceOp) DisablePortSecurity(ctx context.Context, portID string) (*InstancePortInterface, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Ports.DisablePortSecurity")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(portsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, portsBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, portID, portsDisableSecurity)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
@@ -158,11 +164,13 @@ func (s *PortsServiceOp) DisablePortSecurity(ctx context.Context, portID string)
 
 // GetAllowAddressPairs retrieves allowed address pairs for an instance port.
 func (s *PortsServiceOp) GetAllowAddressPairs(ctx context.Context, portID string) (*Port, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Ports.GetAllowAddressPairs")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(portsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, portsBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, portID, portsAllowAddressPairs)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
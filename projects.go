@@ -46,8 +46,8 @@ const (
 type Project struct {
 	ID                     int          `json:"id"`
 	ClientID               int          `json:"client_id"`
-	CreatedAt              string       `json:"created_at"`
-	ScheduledForDeletionAt *string      `json:"scheduled_for_deletion_at"`
+	CreatedAt              Timestamp    `json:"created_at"`
+	ScheduledForDeletionAt *Timestamp   `json:"scheduled_for_deletion_at"`
 	Description            string       `json:"description"`
 	IsDefault              bool         `json:"is_default"`
 	Name                   string       `json:"name"`
@@ -73,6 +73,8 @@ type ProjectListOptions struct {
 	OrderBy        string `url:"order_by,omitempty" validate:"omitempty"`
 	Name           string `url:"name,omitempty" validate:"omitempty"`
 	IncludeDeleted bool   `url:"include_deleted,omitempty" validate:"omitempty"`
+	Limit          int    `url:"limit,omitempty" validate:"omitempty"`
+	Offset         int    `url:"offset,omitempty" validate:"omitempty"`
 }
 
 // projectsRoot represents Projects root.
@@ -83,6 +85,8 @@ type projectsRoot struct {
 
 // Get retrieves a single project by its ID.
 func (s *ProjectsServiceOp) Get(ctx context.Context, projectID string) (*Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.Get")
+
 	path := fmt.Sprintf("%s/%s", projectsBasePath, projectID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -101,6 +105,8 @@ func (s *ProjectsServiceOp) Get(ctx context.Context, projectID string) (*Project
 
 // ScheduleDeletion schedule project deletion.
 func (s *ProjectsServiceOp) ScheduleDeletion(ctx context.Context, projectID string) (*Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.ScheduleDeletion")
+
 	path := fmt.Sprintf("%s/%s/%s", projectsBasePath, projectID, projectsScheduleDeletion)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, nil)
@@ -119,6 +125,8 @@ func (s *ProjectsServiceOp) ScheduleDeletion(ctx context.Context, projectID stri
 
 // CancelScheduledDeletion cancel scheduled project deletion.
 func (s *ProjectsServiceOp) CancelScheduledDeletion(ctx context.Context, projectID string) (*Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.CancelScheduledDeletion")
+
 	path := fmt.Sprintf("%s/%s/%s", projectsBasePath, projectID, projectsCancelScheduledDeletion)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, nil)
@@ -137,6 +145,8 @@ func (s *ProjectsServiceOp) CancelScheduledDeletion(ctx context.Context, project
 
 // Delete a project.
 func (s *ProjectsServiceOp) Delete(ctx context.Context, projectID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Projects.Delete")
+
 	path := fmt.Sprintf("%s/%s", projectsBasePath, projectID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
@@ -155,10 +165,16 @@ func (s *ProjectsServiceOp) Delete(ctx context.Context, projectID string) (*Task
 
 // Update a project.
 func (s *ProjectsServiceOp) Update(ctx context.Context, projectID string, reqBody *ProjectUpdateRequest) (*Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.Update")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
 	path := fmt.Sprintf("%s/%s", projectsBasePath, projectID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody)
@@ -177,6 +193,8 @@ func (s *ProjectsServiceOp) Update(ctx context.Context, projectID string, reqBod
 
 // List gets projects.
 func (s *ProjectsServiceOp) List(ctx context.Context, opts *ProjectListOptions) ([]Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.List")
+
 	path, err := addOptions(projectsBasePath, opts)
 	if err != nil {
 		return nil, nil, err
@@ -193,15 +211,23 @@ func (s *ProjectsServiceOp) List(ctx context.Context, opts *ProjectListOptions)
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: projectRoot.Count}
+
 	return projectRoot.Projects, resp, err
 }
 
 // Create a project.
 func (s *ProjectsServiceOp) Create(ctx context.Context, reqBody *ProjectCreateRequest) (*Project, *Response, error) {
+	ctx = withOperation(ctx, "Projects.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodPost, projectsBasePath, reqBody)
 	if err != nil {
 		return nil, nil, err
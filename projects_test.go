@@ -40,7 +40,8 @@ func TestProjects_ScheduleDeletion(t *testing.T) {
 	setup()
 	defer teardown()
 
-	scheduledForDeletionAt := "2024-08-06T17:40:32"
+	scheduledForDeletionAt, err := ParseTimestamp("2024-08-06T17:40:32")
+	require.NoError(t, err)
 	expectedResp := &Project{
 		ID:                     1,
 		Name:                   "project-scheduled-for-deletion",
@@ -153,13 +154,14 @@ func TestProjects_List(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to marshal response: %v", err)
 		}
-		_, _ = fmt.Fprintf(w, `{"results":%s}`, string(resp))
+		_, _ = fmt.Fprintf(w, `{"count":1,"results":%s}`, string(resp))
 	})
 
 	respActual, resp, err := client.Projects.List(ctx, nil)
 	require.NoError(t, err)
 	require.Equal(t, resp.StatusCode, 200)
 	require.Equal(t, respActual, expectedResp)
+	require.Equal(t, 1, resp.Meta.Count)
 }
 
 func TestProjects_Create(t *testing.T) {
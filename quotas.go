@@ -43,7 +43,7 @@ type CombinedQuota struct {
 
 type QuotaNotificationThreshold struct {
 	LastMessage CombinedQuota `json:"last_message"`
-	LastSending string        `json:"last_sending"`
+	LastSending Timestamp     `json:"last_sending"`
 	Threshold   int           `json:"threshold"`
 	ClientID    int           `json:"client_id"`
 }
@@ -61,6 +61,8 @@ type ListCombinedOptions struct {
 
 // ListCombined get combined client quotas, regional and global.
 func (s *QuotasServiceOp) ListCombined(ctx context.Context, opts *ListCombinedOptions) (*CombinedQuota, *Response, error) {
+	ctx = withOperation(ctx, "Quotas.ListCombined")
+
 	path, err := addOptions(quotasClientBasePathV2, opts)
 	if err != nil {
 		return nil, nil, err
@@ -82,6 +84,8 @@ func (s *QuotasServiceOp) ListCombined(ctx context.Context, opts *ListCombinedOp
 
 // ListGlobal get a global quota.
 func (s *QuotasServiceOp) ListGlobal(ctx context.Context, clientID int) (*Quota, *Response, error) {
+	ctx = withOperation(ctx, "Quotas.ListGlobal")
+
 	if clientID == 0 {
 		return nil, nil, NewArgError("clientID", "no value specified")
 	}
@@ -104,6 +108,8 @@ func (s *QuotasServiceOp) ListGlobal(ctx context.Context, clientID int) (*Quota,
 
 // ListRegional get a quota by region.
 func (s *QuotasServiceOp) ListRegional(ctx context.Context, clientID, regionID int) (*Quota, *Response, error) {
+	ctx = withOperation(ctx, "Quotas.ListRegional")
+
 	if clientID == 0 {
 		return nil, nil, NewArgError("clientID", "no value specified")
 	}
@@ -130,6 +136,8 @@ func (s *QuotasServiceOp) ListRegional(ctx context.Context, clientID, regionID i
 
 // DeleteNotificationThreshold delete a client's quota notification threshold.
 func (s *QuotasServiceOp) DeleteNotificationThreshold(ctx context.Context, clientID int) (*Response, error) {
+	ctx = withOperation(ctx, "Quotas.DeleteNotificationThreshold")
+
 	path := fmt.Sprintf("%s/%d/%s", quotasClientBasePathV2, clientID, quotasNotificationThreshold)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
@@ -142,6 +150,8 @@ func (s *QuotasServiceOp) DeleteNotificationThreshold(ctx context.Context, clien
 
 // GetNotificationThreshold get a client's quota notification threshold.
 func (s *QuotasServiceOp) GetNotificationThreshold(ctx context.Context, clientID int) (*QuotaNotificationThreshold, *Response, error) {
+	ctx = withOperation(ctx, "Quotas.GetNotificationThreshold")
+
 	path := fmt.Sprintf("%s/%d/%s", quotasClientBasePathV2, clientID, quotasNotificationThreshold)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
@@ -160,10 +170,16 @@ func (s *QuotasServiceOp) GetNotificationThreshold(ctx context.Context, clientID
 
 // UpdateNotificationThreshold update or create a client's quota notification threshold.
 func (s *QuotasServiceOp) UpdateNotificationThreshold(ctx context.Context, clientID int, reqBody *NotificationThresholdUpdateRequest) (*QuotaNotificationThreshold, *Response, error) {
+	ctx = withOperation(ctx, "Quotas.UpdateNotificationThreshold")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
 	path := fmt.Sprintf("%s/%d/%s", quotasClientBasePathV2, clientID, quotasNotificationThreshold)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody)
@@ -0,0 +1,202 @@
+package edgecloud
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
+)
+
+const (
+	headerRetryAfter         = "Retry-After"
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+
+	// rateLimitResetEpochThreshold separates X-RateLimit-Reset values given as a Unix timestamp
+	// from the ones given as a number of seconds until the reset.
+	rateLimitResetEpochThreshold = 1_000_000_000
+)
+
+// RateLimitGroup identifies a group of API endpoints that share a client-side rate limit.
+type RateLimitGroup string
+
+const (
+	// RateLimitGroupTasks contains the requests to the tasks API, e.g. polling of task states.
+	RateLimitGroupTasks RateLimitGroup = "tasks"
+	// RateLimitGroupRead contains the GET and HEAD requests to any other API.
+	RateLimitGroupRead RateLimitGroup = "read"
+	// RateLimitGroupMutation contains all other requests, i.e. the ones that create, update or delete resources.
+	RateLimitGroupMutation RateLimitGroup = "mutation"
+)
+
+// RateLimit configures a token bucket that allows RequestsPerSecond requests on average
+// with bursts of up to Burst requests.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// RateLimitConfig sets the client-side rate limits. RateLimitConfig.Default applies to every request whose group
+// is not configured in RateLimitConfig.Groups. A zero RateLimit does not limit the requests.
+type RateLimitConfig struct {
+	Default RateLimit
+	Groups  map[RateLimitGroup]RateLimit
+}
+
+// Rate contains the rate limit state reported by the API in the response headers.
+type Rate struct {
+	// The maximum number of requests the client can make in the current window.
+	Limit int
+
+	// The number of requests remaining in the current window.
+	Remaining int
+
+	// The time at which the current window resets.
+	Reset time.Time
+}
+
+// rateLimiter holds the token buckets of the client-side rate limits.
+type rateLimiter struct {
+	fallback *rate.Limiter
+	groups   map[RateLimitGroup]*rate.Limiter
+}
+
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
+	l := &rateLimiter{
+		fallback: newLimiter(config.Default),
+		groups:   make(map[RateLimitGroup]*rate.Limiter, len(config.Groups)),
+	}
+	for group, limit := range config.Groups {
+		l.groups[group] = newLimiter(limit)
+	}
+
+	return l
+}
+
+func newLimiter(limit RateLimit) *rate.Limiter {
+	if limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = 1
+	}
+
+	return rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+}
+
+// wait blocks until the rate limit of the request group allows the request or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context, req *http.Request) error {
+	if l == nil {
+		return nil
+	}
+
+	limiter, ok := l.groups[rateLimitGroupOf(req)]
+	if !ok {
+		limiter = l.fallback
+	}
+	if limiter == nil {
+		return nil
+	}
+
+	return limiter.Wait(ctx)
+}
+
+func rateLimitGroupOf(req *http.Request) RateLimitGroup {
+	switch {
+	case strings.Contains(req.URL.Path, tasksBasePathV1):
+		return RateLimitGroupTasks
+	case req.Method == http.MethodGet || req.Method == http.MethodHead:
+		return RateLimitGroupRead
+	default:
+		return RateLimitGroupMutation
+	}
+}
+
+// WithRateLimit sets client-side rate limits. Every request, including the retried ones,
+// waits until the limit of its RateLimitGroup allows it to be sent.
+func WithRateLimit(config RateLimitConfig) ClientOpt {
+	return func(c *Client) error {
+		c.rateLimiter = newRateLimiter(config)
+		return nil
+	}
+}
+
+// populateRate parses the rate limit headers of the response.
+func (r *Response) populateRate() {
+	if r.Response == nil {
+		return
+	}
+
+	if limit := r.Header.Get(headerRateLimitLimit); limit != "" {
+		r.Rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateLimitRemaining); remaining != "" {
+		r.Rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset, ok := parseRateLimitReset(r.Header); ok {
+		r.Rate.Reset = time.Now().Add(reset)
+	}
+}
+
+// parseRateLimitReset returns the time left until the rate limit window resets.
+func parseRateLimitReset(header http.Header) (time.Duration, bool) {
+	reset, err := strconv.ParseInt(header.Get(headerRateLimitReset), 10, 64)
+	if err != nil || reset < 0 {
+		return 0, false
+	}
+
+	if reset >= rateLimitResetEpochThreshold {
+		return time.Until(time.Unix(reset, 0)), true
+	}
+
+	return time.Duration(reset) * time.Second, true
+}
+
+// parseRetryAfter returns the delay requested by the Retry-After header,
+// given either in seconds or as an HTTP date.
+func parseRetryAfter(header http.Header) (time.Duration, bool) {
+	retryAfter := header.Get(headerRetryAfter)
+	if retryAfter == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(retryAfter, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(retryAfter)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(time.Until(date), 0), true
+}
+
+// retryAfterBackoff waits as long as the API asks with the Retry-After header or, for exhausted rate limits,
+// until the rate limit window resets, but no longer than maxWait. Otherwise, it falls back to the exponential
+// backoff of go-retryablehttp.
+func retryAfterBackoff(minWait, maxWait time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header); ok {
+			return min(wait, maxWait)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && resp.Header.Get(headerRateLimitRemaining) == "0" {
+			if wait, ok := parseRateLimitReset(resp.Header); ok {
+				return min(max(wait, 0), maxWait)
+			}
+		}
+	}
+
+	return retryablehttp.DefaultBackoff(minWait, maxWait, attemptNum, resp)
+}
@@ -0,0 +1,187 @@
+package edgecloud
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc(tasksBasePathV1, func(w http.ResponseWriter, r *http.Request) {})
+
+	err := WithRateLimit(RateLimitConfig{
+		Default: RateLimit{RequestsPerSecond: 10, Burst: 1},
+		Groups: map[RateLimitGroup]RateLimit{
+			RateLimitGroupTasks: {RequestsPerSecond: 1000, Burst: 10},
+		},
+	})(client)
+	require.NoError(t, err)
+
+	do := func(urlStr string) {
+		req, err := client.NewRequest(ctx, http.MethodGet, urlStr, nil)
+		require.NoError(t, err)
+		_, err = client.Do(ctx, req, nil)
+		require.NoError(t, err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		do("/foo")
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+	start = time.Now()
+	for i := 0; i < 3; i++ {
+		do(tasksBasePathV1)
+	}
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestWithRateLimit_ContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {})
+
+	err := WithRateLimit(RateLimitConfig{Default: RateLimit{RequestsPerSecond: 0.01, Burst: 1}})(client)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(ctx, http.MethodPost, "/foo", nil)
+	require.NoError(t, err)
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	cancelCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = client.Do(cancelCtx, req, nil)
+	assert.Error(t, err)
+}
+
+func TestRateLimitGroupOf(t *testing.T) {
+	tests := []struct {
+		method   string
+		path     string
+		expected RateLimitGroup
+	}{
+		{method: http.MethodGet, path: "/v1/tasks/" + taskID, expected: RateLimitGroupTasks},
+		{method: http.MethodGet, path: "/v1/instances/1/2", expected: RateLimitGroupRead},
+		{method: http.MethodHead, path: "/v1/instances/1/2", expected: RateLimitGroupRead},
+		{method: http.MethodPost, path: "/v2/instances/1/2", expected: RateLimitGroupMutation},
+		{method: http.MethodDelete, path: "/v1/instances/1/2/" + testResourceID, expected: RateLimitGroupMutation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			req := &http.Request{Method: tt.method, URL: &url.URL{Path: tt.path}}
+			assert.Equal(t, tt.expected, rateLimitGroupOf(req))
+		})
+	}
+}
+
+func TestRetryAfterBackoff(t *testing.T) {
+	const (
+		minWait = time.Second
+		maxWait = 30 * time.Second
+	)
+
+	tests := []struct {
+		name     string
+		status   int
+		header   http.Header
+		expected time.Duration
+	}{
+		{
+			name:     "retry after seconds",
+			status:   http.StatusTooManyRequests,
+			header:   http.Header{"Retry-After": []string{"7"}},
+			expected: 7 * time.Second,
+		},
+		{
+			name:     "retry after on server error",
+			status:   http.StatusBadGateway,
+			header:   http.Header{"Retry-After": []string{"3"}},
+			expected: 3 * time.Second,
+		},
+		{
+			name:   "exhausted rate limit",
+			status: http.StatusTooManyRequests,
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"12"},
+			},
+			expected: 12 * time.Second,
+		},
+		{
+			name:     "retry after beyond the maximum wait",
+			status:   http.StatusServiceUnavailable,
+			header:   http.Header{"Retry-After": []string{"3600"}},
+			expected: maxWait,
+		},
+		{
+			name:   "rate limit reset beyond the maximum wait",
+			status: http.StatusTooManyRequests,
+			header: http.Header{
+				"X-Ratelimit-Remaining": []string{"0"},
+				"X-Ratelimit-Reset":     []string{"3600"},
+			},
+			expected: maxWait,
+		},
+		{
+			name:     "exponential backoff",
+			status:   http.StatusInternalServerError,
+			header:   http.Header{},
+			expected: 4 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tt.status, Header: tt.header}
+			assert.Equal(t, tt.expected, retryAfterBackoff(minWait, maxWait, 2, resp))
+		})
+	}
+}
+
+func TestWithRetryAndBackoffs_RetryAfter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts int
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "98")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+	})
+
+	c, err := New(nil, WithRetryAndBackoffs(RetryConfig{RetryMax: 1, RetryWaitMax: PtrTo(2.0)}))
+	require.NoError(t, err)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	req, err := c.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+
+	start := time.Now()
+	resp, err := c.Do(ctx, req, nil)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 100, resp.Rate.Limit)
+	assert.Equal(t, 98, resp.Rate.Remaining)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), resp.Rate.Reset, 2*time.Second)
+}
@@ -0,0 +1,91 @@
+package recorder
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+)
+
+// Matcher reports whether a recorded request matches an actual one. The actual request is given
+// as it would have been recorded, i.e. with its headers and body redacted.
+type Matcher func(actual, recorded Request) bool
+
+// DefaultMatcher matches the requests by method and URL, with the query parameters in any order.
+var DefaultMatcher = MatchAll(MatchMethod, MatchURL)
+
+// MatchAll returns a Matcher that matches the requests matched by all the given matchers.
+func MatchAll(matchers ...Matcher) Matcher {
+	return func(actual, recorded Request) bool {
+		for _, match := range matchers {
+			if !match(actual, recorded) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// MatchMethod matches the requests by method.
+func MatchMethod(actual, recorded Request) bool {
+	return actual.Method == recorded.Method
+}
+
+// MatchURL matches the requests by scheme, host, path and query parameters, with the query parameters in any order.
+func MatchURL(actual, recorded Request) bool {
+	a, r, ok := parseURLs(actual, recorded)
+
+	return ok && a.Scheme == r.Scheme && a.Host == r.Host && a.Path == r.Path && reflect.DeepEqual(a.Query(), r.Query())
+}
+
+// MatchPath matches the requests by path only, so that the cassettes can be replayed against another host.
+func MatchPath(actual, recorded Request) bool {
+	a, r, ok := parseURLs(actual, recorded)
+
+	return ok && a.Path == r.Path
+}
+
+// MatchQuery matches the requests by query parameters, in any order.
+func MatchQuery(actual, recorded Request) bool {
+	a, r, ok := parseURLs(actual, recorded)
+
+	return ok && reflect.DeepEqual(a.Query(), r.Query())
+}
+
+// MatchBody matches the requests by body. JSON bodies are compared semantically, so the order of the fields
+// and the formatting do not matter.
+func MatchBody(actual, recorded Request) bool {
+	var a, r interface{}
+	if json.Unmarshal([]byte(actual.Body), &a) == nil && json.Unmarshal([]byte(recorded.Body), &r) == nil {
+		return reflect.DeepEqual(a, r)
+	}
+
+	return actual.Body == recorded.Body
+}
+
+// MatchHeaders returns a Matcher that matches the requests by the values of the given headers.
+func MatchHeaders(names ...string) Matcher {
+	return func(actual, recorded Request) bool {
+		for _, name := range names {
+			if !reflect.DeepEqual(actual.Headers.Values(name), recorded.Headers.Values(name)) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+func parseURLs(actual, recorded Request) (*url.URL, *url.URL, bool) {
+	a, err := url.Parse(actual.URL)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	r, err := url.Parse(recorded.URL)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	return a, r, true
+}
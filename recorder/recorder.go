@@ -0,0 +1,260 @@
+// Package recorder records the HTTP interactions with the EdgeCenter Cloud API into cassette files and replays
+// them, so that the code using the client can be tested deterministically and without network.
+//
+// A Recorder is an http.RoundTripper, so it is plugged into the client with its HTTP client:
+//
+//	rec, err := recorder.New("testdata/instances.yaml", recorder.Config{Mode: recorder.ModeReplayOrRecord})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Stop()
+//
+//	client, err := edgecloud.New(rec.Client(), edgecloud.SetAPIKey(apiKey))
+package recorder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// EnvMode is the environment variable that overrides the mode of the recorders, e.g. to record
+// the cassettes again without changing the tests. Its values are replay, record and replay-or-record.
+const EnvMode = "EDGECENTER_CLOUD_RECORDER_MODE"
+
+var (
+	ErrInteractionNotFound = errors.New("no recorded interaction matches the request")
+	ErrInvalidMode         = errors.New("invalid recorder mode")
+)
+
+// Mode defines whether a Recorder replays or records the interactions.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions of the cassette and fails the requests that match none of them.
+	// No request is sent to the API.
+	ModeReplay Mode = iota
+	// ModeRecord sends the requests to the API and records the interactions, replacing the cassette.
+	ModeRecord
+	// ModeReplayOrRecord replays the cassette if it exists, records it otherwise.
+	ModeReplayOrRecord
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeReplay:
+		return "replay"
+	case ModeRecord:
+		return "record"
+	case ModeReplayOrRecord:
+		return "replay-or-record"
+	default:
+		return fmt.Sprintf("Mode(%d)", int(m))
+	}
+}
+
+// ParseMode parses the name of a mode.
+func ParseMode(s string) (Mode, error) {
+	for _, mode := range []Mode{ModeReplay, ModeRecord, ModeReplayOrRecord} {
+		if strings.EqualFold(s, mode.String()) {
+			return mode, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrInvalidMode, s)
+}
+
+// Config is the configuration of a Recorder.
+type Config struct {
+	// Mode is the mode of the recorder, ModeReplay by default. It is overridden by EnvMode if it is set.
+	Mode Mode
+
+	// Matcher matches the requests with the recorded ones when replaying, DefaultMatcher by default.
+	// Each recorded interaction is replayed once, in the order of the cassette.
+	Matcher Matcher
+
+	// Transport sends the requests when recording, http.DefaultTransport by default.
+	Transport http.RoundTripper
+
+	// RedactHeaders are headers to redact in addition to DefaultRedactHeaders.
+	RedactHeaders []string
+
+	// RedactFields are JSON fields and query parameters to redact in addition to DefaultRedactFields.
+	RedactFields []string
+}
+
+// Recorder is an http.RoundTripper that records or replays the interactions of a cassette file.
+type Recorder struct {
+	path      string
+	mode      Mode
+	matcher   Matcher
+	transport http.RoundTripper
+	redactor  *redactor
+
+	mu       sync.Mutex
+	cassette *Cassette
+	replayed []bool
+}
+
+var _ http.RoundTripper = &Recorder{}
+
+// New creates a Recorder of the cassette at path. In the replay modes the cassette is loaded immediately.
+func New(path string, config Config) (*Recorder, error) {
+	mode := config.Mode
+	if env := os.Getenv(EnvMode); env != "" {
+		var err error
+		if mode, err = ParseMode(env); err != nil {
+			return nil, err
+		}
+	}
+
+	if mode == ModeReplayOrRecord {
+		mode = ModeReplay
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			mode = ModeRecord
+		}
+	}
+
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		matcher:   config.Matcher,
+		transport: config.Transport,
+		redactor:  newRedactor(config.RedactHeaders, config.RedactFields),
+		cassette:  &Cassette{Version: cassetteVersion},
+	}
+	if r.matcher == nil {
+		r.matcher = DefaultMatcher
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+
+	if mode == ModeReplay {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = cassette
+		r.replayed = make([]bool, len(cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// Mode returns the actual mode of the recorder, i.e. ModeReplay or ModeRecord.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an HTTP client that uses the recorder as its transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop saves the recorded cassette. It does nothing when replaying.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// RoundTrip replays the response recorded for the request or, when recording, sends the request and records it.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	recorded := Request{
+		Method:  req.Method,
+		URL:     r.redactor.url(req.URL),
+		Headers: r.redactor.header(req.Header),
+		Body:    r.redactor.body(body),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    r.redactor.header(resp.Header),
+			Body:       r.redactor.body(respBody),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, actual Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !r.matcher(actual, interaction.Request) {
+			continue
+		}
+		r.replayed[i] = true
+
+		header := interaction.Response.Headers.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		// the recorded body may be shorter or longer than the original one after the redaction
+		header.Del("Content-Length")
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s in cassette %s", ErrInteractionNotFound, actual.Method, actual.URL, r.path)
+}
+
+// readBody reads the whole body and replaces it with a copy, so that it can still be read by the caller.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return data, nil
+}
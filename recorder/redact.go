@@ -0,0 +1,135 @@
+package recorder
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces the values of the sensitive headers, query parameters and JSON fields in the cassettes.
+const Redacted = "[REDACTED]"
+
+// DefaultRedactHeaders are the headers that are always redacted.
+var DefaultRedactHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "X-Auth-Token"}
+
+// DefaultRedactFields are the JSON fields and query parameters that are always redacted, at any depth
+// of the bodies: the API keys and tokens, the secret payloads, private keys and passwords, the user data
+// of the instances and the connection strings of the user actions.
+var DefaultRedactFields = []string{
+	"password",
+	"admin_password",
+	"user_data",
+	"payload",
+	"private_key",
+	"connection_string",
+	"token",
+	"access_token",
+	"refresh_token",
+	"access",
+	"refresh",
+	"apikey",
+	"api_key",
+}
+
+type redactor struct {
+	headers []string
+	fields  map[string]struct{}
+}
+
+func newRedactor(headers, fields []string) *redactor {
+	r := &redactor{fields: make(map[string]struct{})}
+	for _, list := range [][]string{DefaultRedactHeaders, headers} {
+		r.headers = append(r.headers, list...)
+	}
+	for _, list := range [][]string{DefaultRedactFields, fields} {
+		for _, field := range list {
+			r.fields[strings.ToLower(field)] = struct{}{}
+		}
+	}
+
+	return r
+}
+
+func (r *redactor) header(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+
+	out := header.Clone()
+	for _, name := range r.headers {
+		if values := out.Values(name); len(values) > 0 {
+			redactedValues := make([]string, len(values))
+			for i := range redactedValues {
+				redactedValues[i] = Redacted
+			}
+			out[http.CanonicalHeaderKey(name)] = redactedValues
+		}
+	}
+
+	return out
+}
+
+func (r *redactor) url(u *url.URL) string {
+	query := u.Query()
+
+	var found bool
+	for name, values := range query {
+		if _, ok := r.fields[strings.ToLower(name)]; ok {
+			for i := range values {
+				values[i] = Redacted
+			}
+			found = true
+		}
+	}
+	if !found {
+		return u.String()
+	}
+
+	out := *u
+	out.RawQuery = query.Encode()
+
+	return out.String()
+}
+
+// body redacts a JSON body. Other bodies are kept as they are.
+func (r *redactor) body(data []byte) string {
+	var body interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return string(data)
+	}
+
+	if !r.value(body) {
+		return string(data)
+	}
+
+	out, err := json.Marshal(body)
+	if err != nil {
+		return string(data)
+	}
+
+	return string(out)
+}
+
+// value redacts the sensitive fields in place and reports whether any of them was found.
+func (r *redactor) value(value interface{}) bool {
+	var found bool
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if _, ok := r.fields[strings.ToLower(key)]; ok && field != nil && field != "" {
+				v[key] = Redacted
+				found = true
+
+				continue
+			}
+			found = r.value(field) || found
+		}
+	case []interface{}:
+		for _, item := range v {
+			found = r.value(item) || found
+		}
+	}
+
+	return found
+}
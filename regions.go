@@ -43,7 +43,7 @@ type Region struct {
 	TaskID               *string            `json:"task_id"`
 	ExternalNetworkID    string             `json:"external_network_id"`
 	AccessLevel          string             `json:"access_level"`
-	CreatedOn            string             `json:"created_on"`
+	CreatedOn            Timestamp          `json:"created_on"`
 	AvailableVolumeTypes []string           `json:"available_volume_types"`
 	Country              string             `json:"country"`
 	NoVNSProxyURL        string             `json:"novnc_proxy_url"`
@@ -74,7 +74,7 @@ type Keystone struct {
 	URL                       string        `json:"url"`
 	State                     KeystoneState `json:"state"`
 	KeystoneFederatedDomainID string        `json:"keystone_federated_domain_id"`
-	CreatedOn                 string        `json:"created_on"`
+	CreatedOn                 Timestamp     `json:"created_on"`
 	AdminPassword             string        `json:"admin_password"`
 }
 
@@ -106,11 +106,15 @@ type MetricsDatabase struct {
 // RegionGetOptions specifies the optional query parameters to Get method.
 type RegionGetOptions struct {
 	ShowVolumeTypes bool `url:"show_volume_types,omitempty"  validate:"omitempty"`
+	Limit           int  `url:"limit,omitempty"  validate:"omitempty"`
+	Offset          int  `url:"offset,omitempty"  validate:"omitempty"`
 }
 
 // RegionListOptions specifies the optional query parameters to List method.
 type RegionListOptions struct {
 	ShowVolumeTypes bool `url:"show_volume_types,omitempty"  validate:"omitempty"`
+	Limit           int  `url:"limit,omitempty"  validate:"omitempty"`
+	Offset          int  `url:"offset,omitempty"  validate:"omitempty"`
 }
 
 // regionsRoot represents a Region root.
@@ -121,6 +125,8 @@ type regionsRoot struct {
 
 // List get regions.
 func (s *RegionsServiceOp) List(ctx context.Context, opts *RegionListOptions) ([]Region, *Response, error) {
+	ctx = withOperation(ctx, "Regions.List")
+
 	path, err := addOptions(regionsBasePath, opts)
 	if err != nil {
 		return nil, nil, err
@@ -137,11 +143,15 @@ func (s *RegionsServiceOp) List(ctx context.Context, opts *RegionListOptions) ([
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Region, resp, err
 }
 
 // Get retrieves a single region by its ID.
 func (s *RegionsServiceOp) Get(ctx context.Context, regionID string, opts *RegionGetOptions) (*Region, *Response, error) {
+	ctx = withOperation(ctx, "Regions.Get")
+
 	path := fmt.Sprintf("%s/%s", regionsBasePath, regionID)
 	path, err := addOptions(path, opts)
 	if err != nil {
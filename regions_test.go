@@ -23,13 +23,14 @@ func TestRegions_List(t *testing.T) {
 		if err != nil {
 			t.Errorf("failed to marshal response: %v", err)
 		}
-		_, _ = fmt.Fprintf(w, `{"results":%s}`, string(resp))
+		_, _ = fmt.Fprintf(w, `{"count":1,"results":%s}`, string(resp))
 	})
 
 	respActual, resp, err := client.Regions.List(ctx, nil)
 	require.NoError(t, err)
 	require.Equal(t, resp.StatusCode, 200)
 	require.Equal(t, respActual, expectedResp)
+	require.Equal(t, 1, resp.Meta.Count)
 }
 
 func TestRegions_Get(t *testing.T) {
@@ -25,7 +25,7 @@ var _ ResellerNetworksService = &ResellerNetworksServiceOp{}
 
 // ResellerNetwork represents an EdgecenterCloud reseller network.
 type ResellerNetwork struct {
-	CreatedAt      string             `json:"created_at"`
+	CreatedAt      Timestamp          `json:"created_at"`
 	Default        bool               `json:"default"`
 	External       bool               `json:"external"`
 	Shared         bool               `json:"shared"`
@@ -39,7 +39,7 @@ type ResellerNetwork struct {
 	CreatorTaskID  string             `json:"creator_task_id"`
 	TaskID         string             `json:"task_id"`
 	SegmentationID int                `json:"segmentation_id"`
-	UpdatedAt      string             `json:"updated_at"`
+	UpdatedAt      Timestamp          `json:"updated_at"`
 	Metadata       []MetadataDetailed `json:"metadata,omitempty"`
 	ClientID       int                `json:"client_id"`
 	ProjectID      int                `json:"project_id"`
@@ -60,6 +60,8 @@ type ResellerNetworksListRequest struct {
 
 // List get reseller networks.
 func (s *ResellerNetworksServiceOp) List(ctx context.Context, opts *ResellerNetworksListRequest) (*ResellerNetworks, *Response, error) {
+	ctx = withOperation(ctx, "ResellerNetworks.List")
+
 	path, err := addOptions(resellerNetworksBasePathV1, opts)
 	if err != nil {
 		return nil, nil, err
@@ -51,8 +51,8 @@ type ResellerImageV2 struct {
 	RegionID   int        `json:"region_id"`
 	EntityID   EntityID   `json:"entity_id"`
 	EntityType EntityType `json:"entity_type"`
-	CreatedAt  string     `json:"created_at,omitempty"`
-	UpdatedAt  string     `json:"updated_at,omitempty"`
+	CreatedAt  Timestamp  `json:"created_at"`
+	UpdatedAt  *Timestamp `json:"updated_at,omitempty"`
 }
 
 // ResellerImageV2UpdateRequest represents a request to update available image list for a reseller, client, project.
@@ -64,6 +64,8 @@ type ResellerImageV2DeleteOptions struct {
 
 // ListByRole get available shared image IDs by role in APIKey.
 func (s *ResellerImageV2ServiceOp) ListByRole(ctx context.Context) (*ResellerImageV2List, *Response, error) {
+	ctx = withOperation(ctx, "ResellerImageV2.ListByRole")
+
 	req, err := s.client.NewRequest(ctx, http.MethodGet, resellerImageBasePathV2, nil)
 	if err != nil {
 		return nil, nil, err
@@ -80,6 +82,8 @@ func (s *ResellerImageV2ServiceOp) ListByRole(ctx context.Context) (*ResellerIma
 
 // List get available image IDs limits for a reseller, client, project.
 func (s *ResellerImageV2ServiceOp) List(ctx context.Context, entityType EntityType, entityID EntityID) (*ResellerImageV2List, *Response, error) {
+	ctx = withOperation(ctx, "ResellerImageV2.List")
+
 	pathReq := fmt.Sprintf("%s/%s/%d", resellerImageBasePathV2, entityType, entityID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, pathReq, nil)
@@ -98,10 +102,16 @@ func (s *ResellerImageV2ServiceOp) List(ctx context.Context, entityType EntityTy
 
 // Update set or update available image list for a reseller, client, project.
 func (s *ResellerImageV2ServiceOp) Update(ctx context.Context, reqBody *ResellerImageV2UpdateRequest) (*ResellerImageV2, *Response, error) {
+	ctx = withOperation(ctx, "ResellerImageV2.Update")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
 	req, err := s.client.NewRequest(ctx, http.MethodPut, resellerImageBasePathV2, reqBody)
 	if err != nil {
 		return nil, nil, err
@@ -118,6 +128,8 @@ func (s *ResellerImageV2ServiceOp) Update(ctx context.Context, reqBody *Reseller
 
 // Delete image limits for reseller, client, project.
 func (s *ResellerImageV2ServiceOp) Delete(ctx context.Context, entityType EntityType, entityID EntityID, opts *ResellerImageV2DeleteOptions) (*Response, error) {
+	ctx = withOperation(ctx, "ResellerImageV2.Delete")
+
 	pathReq := fmt.Sprintf("%s/%s/%d", resellerImageBasePathV2, entityType, entityID)
 
 	pathReq, err := addOptions(pathReq, opts)
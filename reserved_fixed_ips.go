@@ -2,6 +2,7 @@ package edgecloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -40,8 +41,8 @@ var _ ReservedFixedIPsService = &ReservedFixedIPsServiceOp{}
 // ReservedFixedIP represents an EdgecenterCloud ReservedFixedIP.
 type ReservedFixedIP struct {
 	Region              string                     `json:"region"`
-	CreatedAt           string                     `json:"created_at"`
-	UpdatedAt           string                     `json:"updated_at"`
+	CreatedAt           Timestamp                  `json:"created_at"`
+	UpdatedAt           Timestamp                  `json:"updated_at"`
 	Name                string                     `json:"name"`
 	RegionID            int                        `json:"region_id"`
 	PortID              string                     `json:"port_id,omitempty"`
@@ -94,6 +95,17 @@ const (
 	ReservedFixedIPTypeIPAddress = "ip_address"
 )
 
+var ErrInvalidReservedFixedIPType = errors.New("invalid ReservedFixedIPType value")
+
+// IsValid reports whether the reserved fixed IP type is one of the known values.
+func (t ReservedFixedIPType) IsValid() error {
+	switch t {
+	case ReservedFixedIPTypeExternal, ReservedFixedIPTypeSubnet, ReservedFixedIPTypeAnySubnet, ReservedFixedIPTypeIPAddress:
+		return nil
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidReservedFixedIPType, t)
+}
+
 type SwitchVIPStatusRequest struct {
 	IsVIP bool `json:"is_vip"`
 }
@@ -124,11 +136,13 @@ type instancePortRoot struct {
 
 // List get Reserved Fixed IPs.
 func (s *ReservedFixedIPsServiceOp) List(ctx context.Context, opts *ReservedFixedIPListOptions) ([]ReservedFixedIP, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "ReservedFixedIPs.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(reservedFixedIPsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, reservedFixedIPsBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -145,20 +159,28 @@ func (s *ReservedFixedIPsServiceOp) List(ctx context.Context, opts *ReservedFixe
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.ReservedFixedIPs, resp, err
 }
 
 // Create a Reserved Fixed IP.
 func (s *ReservedFixedIPsServiceOp) Create(ctx context.Context, reqBody *ReservedFixedIPCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "ReservedFixedIPs.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(reservedFixedIPsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, reservedFixedIPsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -176,15 +198,17 @@ func (s *ReservedFixedIPsServiceOp) Create(ctx context.Context, reqBody *Reserve
 
 // Delete a Reserved Fixed IP.
 func (s *ReservedFixedIPsServiceOp) Delete(ctx context.Context, reservedFixedIPID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "ReservedFixedIPs.Delete")
+
 	if resp, err := isValidUUID(reservedFixedIPID, "reservedFixedIPID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(reservedFixedIPsBasePathV1), reservedFixedIPID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, reservedFixedIPsBasePathV1), reservedFixedIPID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -202,15 +226,17 @@ func (s *ReservedFixedIPsServiceOp) Delete(ctx context.Context, reservedFixedIPI
 
 // Get a Reserved Fixed IP.
 func (s *ReservedFixedIPsServiceOp) Get(ctx context.Context, reservedFixedIPID string) (*ReservedFixedIP, *Response, error) {
+	ctx = withOperation(ctx, "ReservedFixedIPs.Get")
+
 	if resp, err := isValidUUID(reservedFixedIPID, "reservedFixedIPID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(reservedFixedIPsBasePathV1), reservedFixedIPID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, reservedFixedIPsBasePathV1), reservedFixedIPID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -228,6 +254,8 @@ func (s *ReservedFixedIPsServiceOp) Get(ctx context.Context, reservedFixedIPID s
 
 // SwitchVIPStatus of a Reserved Fixed IP.
 func (s *ReservedFixedIPsServiceOp) SwitchVIPStatus(ctx context.Context, reservedFixedIPID string, reqBody *SwitchVIPStatusRequest) (*ReservedFixedIP, *Response, error) {
+	ctx = withOperation(ctx, "ReservedFixedIPs.SwitchVIPStatus")
+
 	if resp, err := isValidUUID(reservedFixedIPID, "reservedFixedIPID"); err != nil {
 		return nil, resp, err
 	}
@@ -236,11 +264,11 @@ func (s *ReservedFixedIPsServiceOp) SwitchVIPStatus(ctx context.Context, reserve
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(reservedFixedIPsBasePathV1), reservedFixedIPID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, reservedFixedIPsBasePathV1), reservedFixedIPID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -258,15 +286,17 @@ func (s *ReservedFixedIPsServiceOp) SwitchVIPStatus(ctx context.Context, reserve
 
 // ListInstancePorts that share a VIP.
 func (s *ReservedFixedIPsServiceOp) ListInstancePorts(ctx context.Context, reservedFixedIPID string) ([]ReservedFixedIPInstancePort, *Response, error) {
+	ctx = withOperation(ctx, "ReservedFixedIPs.ListInstancePorts")
+
 	if resp, err := isValidUUID(reservedFixedIPID, "reservedFixedIPID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(reservedFixedIPsBasePathV1), reservedFixedIPID, reservedFixedIPsConnectedDevices)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, reservedFixedIPsBasePathV1), reservedFixedIPID, reservedFixedIPsConnectedDevices)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -284,6 +314,8 @@ func (s *ReservedFixedIPsServiceOp) ListInstancePorts(ctx context.Context, reser
 
 // AddInstancePorts that share a VIP.
 func (s *ReservedFixedIPsServiceOp) AddInstancePorts(ctx context.Context, reservedFixedIPID string, reqBody *AddInstancePortsRequest) ([]ReservedFixedIPInstancePort, *Response, error) {
+	ctx = withOperation(ctx, "ReservedFixedIPs.AddInstancePorts")
+
 	if resp, err := isValidUUID(reservedFixedIPID, "reservedFixedIPID"); err != nil {
 		return nil, resp, err
 	}
@@ -292,11 +324,11 @@ func (s *ReservedFixedIPsServiceOp) AddInstancePorts(ctx context.Context, reserv
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(reservedFixedIPsBasePathV1), reservedFixedIPID, reservedFixedIPsConnectedDevices)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, reservedFixedIPsBasePathV1), reservedFixedIPID, reservedFixedIPsConnectedDevices)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -314,6 +346,8 @@ func (s *ReservedFixedIPsServiceOp) AddInstancePorts(ctx context.Context, reserv
 
 // ReplaceInstancePorts that share a VIP.
 func (s *ReservedFixedIPsServiceOp) ReplaceInstancePorts(ctx context.Context, reservedFixedIPID string, reqBody *AddInstancePortsRequest) ([]ReservedFixedIPInstancePort, *Response, error) {
+	ctx = withOperation(ctx, "ReservedFixedIPs.ReplaceInstancePorts")
+
 	if resp, err := isValidUUID(reservedFixedIPID, "reservedFixedIPID"); err != nil {
 		return nil, resp, err
 	}
@@ -322,11 +356,11 @@ func (s *ReservedFixedIPsServiceOp) ReplaceInstancePorts(ctx context.Context, re
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(reservedFixedIPsBasePathV1), reservedFixedIPID, reservedFixedIPsConnectedDevices)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, reservedFixedIPsBasePathV1), reservedFixedIPID, reservedFixedIPsConnectedDevices)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody)
 	if err != nil {
@@ -344,15 +378,17 @@ func (s *ReservedFixedIPsServiceOp) ReplaceInstancePorts(ctx context.Context, re
 
 // ListInstancePortsAvailable for connecting to a VIP.
 func (s *ReservedFixedIPsServiceOp) ListInstancePortsAvailable(ctx context.Context, reservedFixedIPID string) ([]ReservedFixedIPInstancePort, *Response, error) {
+	ctx = withOperation(ctx, "ReservedFixedIPs.ListInstancePortsAvailable")
+
 	if resp, err := isValidUUID(reservedFixedIPID, "reservedFixedIPID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(reservedFixedIPsBasePathV1), reservedFixedIPID, reservedFixedIPsAvailableDevices)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, reservedFixedIPsBasePathV1), reservedFixedIPID, reservedFixedIPsAvailableDevices)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -38,7 +38,7 @@ func TestReservedFixedIPs_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &ReservedFixedIPCreateRequest{}
+	request := &ReservedFixedIPCreateRequest{Type: ReservedFixedIPTypeExternal}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(reservedFixedIPsBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
@@ -0,0 +1,521 @@
+package edgecloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// ErrResourceKindUnknown is returned by Client.Resources for a kind that is not registered.
+var ErrResourceKindUnknown = errors.New("unknown resource kind")
+
+// ResourceKind identifies a kind of resources of the API.
+type ResourceKind string
+
+const (
+	ResourceKindInstance        ResourceKind = "instance"
+	ResourceKindVolume          ResourceKind = "volume"
+	ResourceKindNetwork         ResourceKind = "network"
+	ResourceKindSubnetwork      ResourceKind = "subnetwork"
+	ResourceKindRouter          ResourceKind = "router"
+	ResourceKindLoadbalancer    ResourceKind = "loadbalancer"
+	ResourceKindListener        ResourceKind = "listener"
+	ResourceKindPool            ResourceKind = "pool"
+	ResourceKindL7Policy        ResourceKind = "l7policy"
+	ResourceKindFloatingIP      ResourceKind = "floatingip"
+	ResourceKindSecurityGroup   ResourceKind = "securitygroup"
+	ResourceKindSnapshot        ResourceKind = "snapshot"
+	ResourceKindImage           ResourceKind = "image"
+	ResourceKindKeyPair         ResourceKind = "keypair"
+	ResourceKindSecret          ResourceKind = "secret"
+	ResourceKindServerGroup     ResourceKind = "servergroup"
+	ResourceKindReservedFixedIP ResourceKind = "reservedfixedip"
+	ResourceKindMKaaSCluster    ResourceKind = "mkaas_cluster"
+	ResourceKindDBaaSCluster    ResourceKind = "dbaas_cluster"
+	ResourceKindDBaaSBackup     ResourceKind = "dbaas_backup"
+	ResourceKindProject         ResourceKind = "project"
+)
+
+// Resource is the common view of the models of the API, for the tools that work on resources of any kind.
+// The getters return the zero value when the API does not report the value for the kind, e.g. GetRegionID
+// of a keypair, which is not regional.
+type Resource interface {
+	Kind() ResourceKind
+	GetID() string
+	GetName() string
+	GetMetadata() Metadata
+	GetProjectID() int
+	GetRegionID() int
+}
+
+// ResourceAPI gives access to the resources of a kind through the Resource interface.
+type ResourceAPI struct {
+	Kind ResourceKind
+
+	Get func(ctx context.Context, id string) (Resource, *Response, error)
+	// List lists all the resources of the kind, through all the pages of the paged kinds.
+	List func(ctx context.Context) ([]Resource, *Response, error)
+
+	// Delete starts the deletion of the resource with the default options of the kind. The returned TaskResponse
+	// is nil for the kinds deleted synchronously, i.e. security groups and server groups.
+	Delete func(ctx context.Context, id string) (*TaskResponse, *Response, error)
+}
+
+// resourceAPIs is the registry of the resource kinds, building the ResourceAPI of a kind from the services
+// of a client, so that it uses the scope and any mocked service of the client.
+var resourceAPIs = map[ResourceKind]func(c *Client) *ResourceAPI{
+	ResourceKindInstance: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Instances.Get),
+			List: listResources(pageResources(func(ctx context.Context, limit, offset int) ([]Instance, *Response, error) {
+				return c.Instances.List(ctx, &InstanceListOptions{Limit: limit, Offset: offset})
+			})),
+			Delete: func(ctx context.Context, id string) (*TaskResponse, *Response, error) {
+				return c.Instances.Delete(ctx, id, nil)
+			},
+		}
+	},
+	ResourceKindVolume: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Volumes.Get),
+			List: listResources(pageResources(func(ctx context.Context, limit, offset int) ([]Volume, *Response, error) {
+				return c.Volumes.List(ctx, &VolumeListOptions{Limit: limit, Offset: offset})
+			})),
+			Delete: c.Volumes.Delete,
+		}
+	},
+	ResourceKindNetwork: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Networks.Get),
+			List: listResources(func(ctx context.Context) ([]Network, *Response, error) {
+				return c.Networks.List(ctx, nil)
+			}),
+			Delete: c.Networks.Delete,
+		}
+	},
+	ResourceKindSubnetwork: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Subnetworks.Get),
+			List: listResources(func(ctx context.Context) ([]Subnetwork, *Response, error) {
+				return c.Subnetworks.List(ctx, nil)
+			}),
+			Delete: c.Subnetworks.Delete,
+		}
+	},
+	ResourceKindRouter: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get:    getResource(c.Routers.Get),
+			List:   listResources(c.Routers.List),
+			Delete: c.Routers.Delete,
+		}
+	},
+	ResourceKindLoadbalancer: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Loadbalancers.Get),
+			List: listResources(func(ctx context.Context) ([]Loadbalancer, *Response, error) {
+				return c.Loadbalancers.List(ctx, nil)
+			}),
+			Delete: c.Loadbalancers.Delete,
+		}
+	},
+	ResourceKindListener: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Loadbalancers.ListenerGet),
+			List: listResources(func(ctx context.Context) ([]Listener, *Response, error) {
+				return c.Loadbalancers.ListenerList(ctx, nil)
+			}),
+			Delete: c.Loadbalancers.ListenerDelete,
+		}
+	},
+	ResourceKindPool: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Loadbalancers.PoolGet),
+			List: listResources(func(ctx context.Context) ([]Pool, *Response, error) {
+				return c.Loadbalancers.PoolList(ctx, &PoolListOptions{Details: true})
+			}),
+			Delete: c.Loadbalancers.PoolDelete,
+		}
+	},
+	ResourceKindL7Policy: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get:    getResource(c.L7Policies.Get),
+			List:   listResources(c.L7Policies.List),
+			Delete: c.L7Policies.Delete,
+		}
+	},
+	ResourceKindFloatingIP: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get:    getResource(c.Floatingips.Get),
+			List:   listResources(c.Floatingips.List),
+			Delete: c.Floatingips.Delete,
+		}
+	},
+	ResourceKindSecurityGroup: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.SecurityGroups.Get),
+			List: listResources(func(ctx context.Context) ([]SecurityGroup, *Response, error) {
+				return c.SecurityGroups.List(ctx, nil)
+			}),
+			Delete: deleteWithoutTask(c.SecurityGroups.Delete),
+		}
+	},
+	ResourceKindSnapshot: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Snapshots.Get),
+			List: listResources(pageResources(func(ctx context.Context, limit, offset int) ([]Snapshot, *Response, error) {
+				return c.Snapshots.List(ctx, &SnapshotListOptions{Limit: limit, Offset: offset})
+			})),
+			Delete: c.Snapshots.Delete,
+		}
+	},
+	ResourceKindImage: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Images.Get),
+			List: listResources(func(ctx context.Context) ([]Image, *Response, error) {
+				return c.Images.List(ctx, nil)
+			}),
+			Delete: c.Images.Delete,
+		}
+	},
+	ResourceKindKeyPair: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get:    getResource(c.KeyPairs.Get),
+			List:   listResources(c.KeyPairs.List),
+			Delete: c.KeyPairs.Delete,
+		}
+	},
+	ResourceKindSecret: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get:    getResource(c.Secrets.Get),
+			List:   listResources(c.Secrets.List),
+			Delete: c.Secrets.Delete,
+		}
+	},
+	ResourceKindServerGroup: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get:    getResource(c.ServerGroups.Get),
+			List:   listResources(c.ServerGroups.List),
+			Delete: deleteWithoutTask(c.ServerGroups.Delete),
+		}
+	},
+	ResourceKindReservedFixedIP: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.ReservedFixedIP.Get),
+			List: listResources(pageResources(func(ctx context.Context, limit, offset int) ([]ReservedFixedIP, *Response, error) {
+				return c.ReservedFixedIP.List(ctx, &ReservedFixedIPListOptions{Limit: limit, Offset: offset})
+			})),
+			Delete: c.ReservedFixedIP.Delete,
+		}
+	},
+	ResourceKindMKaaSCluster: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(func(ctx context.Context, id string) (*MKaaSCluster, *Response, error) {
+				clusterID, err := strconv.Atoi(id)
+				if err != nil {
+					return nil, nil, NewArgError("clusterID", fmt.Sprintf("should be an integer. current value is: %s", id))
+				}
+
+				return c.MkaaS.ClusterGet(ctx, clusterID)
+			}),
+			List: listResources(pageResources(func(ctx context.Context, limit, offset int) ([]MKaaSCluster, *Response, error) {
+				return c.MkaaS.ClustersList(ctx, &MKaaSClusterListOptions{Limit: limit, Offset: offset})
+			})),
+			Delete: func(ctx context.Context, id string) (*TaskResponse, *Response, error) {
+				clusterID, err := strconv.Atoi(id)
+				if err != nil {
+					return nil, nil, NewArgError("clusterID", fmt.Sprintf("should be an integer. current value is: %s", id))
+				}
+
+				return c.MkaaS.ClusterDelete(ctx, clusterID)
+			},
+		}
+	},
+	ResourceKindDBaaSCluster: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.DBaaS.ClusterGet),
+			List: listResources(pageResources(func(ctx context.Context, limit, offset int) ([]DBaaSCluster, *Response, error) {
+				return c.DBaaS.ClustersList(ctx, &DBaaSClusterListOptions{Limit: limit, Offset: offset})
+			})),
+			Delete: c.DBaaS.ClusterDelete,
+		}
+	},
+	ResourceKindDBaaSBackup: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(func(ctx context.Context, id string) (*DBaaSBackup, *Response, error) {
+				return c.DBaaS.BackupGet(ctx, id, false)
+			}),
+			List: listResources(pageResources(func(ctx context.Context, limit, offset int) ([]DBaaSBackup, *Response, error) {
+				return c.DBaaS.BackupsList(ctx, &DBaaSBackupListOptions{Limit: limit, Offset: offset})
+			})),
+			Delete: c.DBaaS.BackupDelete,
+		}
+	},
+	ResourceKindProject: func(c *Client) *ResourceAPI {
+		return &ResourceAPI{
+			Get: getResource(c.Projects.Get),
+			List: listResources(pageResources(func(ctx context.Context, limit, offset int) ([]Project, *Response, error) {
+				return c.Projects.List(ctx, &ProjectListOptions{Limit: limit, Offset: offset})
+			})),
+			Delete: c.Projects.Delete,
+		}
+	},
+}
+
+// ResourceKinds returns the registered resource kinds, sorted.
+func ResourceKinds() []ResourceKind {
+	kinds := make([]ResourceKind, 0, len(resourceAPIs))
+	for kind := range resourceAPIs {
+		kinds = append(kinds, kind)
+	}
+	slices.Sort(kinds)
+
+	return kinds
+}
+
+// Resources returns the ResourceAPI of the kind, using the services and the scope of the client.
+//
+//	for _, kind := range edgecloud.ResourceKinds() {
+//		api, _ := client.Resources(kind)
+//		resources, _, err := api.List(ctx)
+//		...
+//	}
+func (c *Client) Resources(kind ResourceKind) (*ResourceAPI, error) {
+	newAPI, ok := resourceAPIs[kind]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrResourceKindUnknown, kind)
+	}
+
+	api := newAPI(c)
+	api.Kind = kind
+
+	return api, nil
+}
+
+// resourcePtr is a pointer to a model implementing Resource.
+type resourcePtr[T any] interface {
+	*T
+	Resource
+}
+
+func getResource[T any, PT resourcePtr[T]](
+	get func(ctx context.Context, id string) (*T, *Response, error),
+) func(ctx context.Context, id string) (Resource, *Response, error) {
+	return func(ctx context.Context, id string) (Resource, *Response, error) {
+		resource, resp, err := get(ctx, id)
+		if err != nil || resource == nil {
+			return nil, resp, err
+		}
+
+		return PT(resource), resp, nil
+	}
+}
+
+// pageResources adapts a paged List method to list all the resources, with the response of the last page.
+func pageResources[T any](list PageFunc[T]) func(ctx context.Context) ([]T, *Response, error) {
+	return func(ctx context.Context) ([]T, *Response, error) {
+		var resp *Response
+		items, err := NewPager(func(ctx context.Context, limit, offset int) ([]T, *Response, error) {
+			var (
+				page []T
+				err  error
+			)
+			page, resp, err = list(ctx, limit, offset)
+
+			return page, resp, err
+		}, 0).All(ctx)
+
+		return items, resp, err
+	}
+}
+
+func listResources[T any, PT resourcePtr[T]](
+	list func(ctx context.Context) ([]T, *Response, error),
+) func(ctx context.Context) ([]Resource, *Response, error) {
+	return func(ctx context.Context) ([]Resource, *Response, error) {
+		items, resp, err := list(ctx)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		resources := make([]Resource, 0, len(items))
+		for i := range items {
+			resources = append(resources, PT(&items[i]))
+		}
+
+		return resources, resp, nil
+	}
+}
+
+func deleteWithoutTask(
+	del func(ctx context.Context, id string) (*Response, error),
+) func(ctx context.Context, id string) (*TaskResponse, *Response, error) {
+	return func(ctx context.Context, id string) (*TaskResponse, *Response, error) {
+		resp, err := del(ctx, id)
+		return nil, resp, err
+	}
+}
+
+// metadataOf returns the metadata, or the detailed metadata as a Metadata if the former is not set.
+func metadataOf(metadata Metadata, detailed []MetadataDetailed) Metadata {
+	if metadata != nil || detailed == nil {
+		return metadata
+	}
+
+	metadata = make(Metadata, len(detailed))
+	for _, m := range detailed {
+		metadata[m.Key] = m.Value
+	}
+
+	return metadata
+}
+
+func (i *Instance) Kind() ResourceKind    { return ResourceKindInstance }
+func (i *Instance) GetID() string         { return i.ID }
+func (i *Instance) GetName() string       { return i.Name }
+func (i *Instance) GetMetadata() Metadata { return metadataOf(i.Metadata, i.MetadataDetailed) }
+func (i *Instance) GetProjectID() int     { return i.ProjectID }
+func (i *Instance) GetRegionID() int      { return i.RegionID }
+
+func (v *Volume) Kind() ResourceKind    { return ResourceKindVolume }
+func (v *Volume) GetID() string         { return v.ID }
+func (v *Volume) GetName() string       { return v.Name }
+func (v *Volume) GetMetadata() Metadata { return metadataOf(v.Metadata, v.MetadataDetailed) }
+func (v *Volume) GetProjectID() int     { return v.ProjectID }
+func (v *Volume) GetRegionID() int      { return v.RegionID }
+
+func (n *Network) Kind() ResourceKind    { return ResourceKindNetwork }
+func (n *Network) GetID() string         { return n.ID }
+func (n *Network) GetName() string       { return n.Name }
+func (n *Network) GetMetadata() Metadata { return metadataOf(nil, n.Metadata) }
+func (n *Network) GetProjectID() int     { return n.ProjectID }
+func (n *Network) GetRegionID() int      { return n.RegionID }
+
+func (s *Subnetwork) Kind() ResourceKind    { return ResourceKindSubnetwork }
+func (s *Subnetwork) GetID() string         { return s.ID }
+func (s *Subnetwork) GetName() string       { return s.Name }
+func (s *Subnetwork) GetMetadata() Metadata { return metadataOf(nil, s.Metadata) }
+func (s *Subnetwork) GetProjectID() int     { return s.ProjectID }
+func (s *Subnetwork) GetRegionID() int      { return s.RegionID }
+
+func (r *Router) Kind() ResourceKind    { return ResourceKindRouter }
+func (r *Router) GetID() string         { return r.ID }
+func (r *Router) GetName() string       { return r.Name }
+func (r *Router) GetMetadata() Metadata { return nil }
+func (r *Router) GetProjectID() int     { return r.ProjectID }
+func (r *Router) GetRegionID() int      { return r.RegionID }
+
+func (l *Loadbalancer) Kind() ResourceKind    { return ResourceKindLoadbalancer }
+func (l *Loadbalancer) GetID() string         { return l.ID }
+func (l *Loadbalancer) GetName() string       { return l.Name }
+func (l *Loadbalancer) GetMetadata() Metadata { return metadataOf(nil, l.MetadataDetailed) }
+func (l *Loadbalancer) GetProjectID() int     { return l.ProjectID }
+func (l *Loadbalancer) GetRegionID() int      { return l.RegionID }
+
+func (l *Listener) Kind() ResourceKind    { return ResourceKindListener }
+func (l *Listener) GetID() string         { return l.ID }
+func (l *Listener) GetName() string       { return l.Name }
+func (l *Listener) GetMetadata() Metadata { return nil }
+func (l *Listener) GetProjectID() int     { return 0 }
+func (l *Listener) GetRegionID() int      { return 0 }
+
+func (p *Pool) Kind() ResourceKind    { return ResourceKindPool }
+func (p *Pool) GetID() string         { return p.ID }
+func (p *Pool) GetName() string       { return p.Name }
+func (p *Pool) GetMetadata() Metadata { return nil }
+func (p *Pool) GetProjectID() int     { return 0 }
+func (p *Pool) GetRegionID() int      { return 0 }
+
+func (p *L7Policy) Kind() ResourceKind    { return ResourceKindL7Policy }
+func (p *L7Policy) GetID() string         { return p.ID }
+func (p *L7Policy) GetName() string       { return p.Name }
+func (p *L7Policy) GetMetadata() Metadata { return nil }
+func (p *L7Policy) GetProjectID() int     { return p.ProjectID }
+func (p *L7Policy) GetRegionID() int      { return p.RegionID }
+
+// GetName returns the floating IP address, floating IPs have no name.
+func (f *FloatingIP) GetName() string       { return f.FloatingIPAddress }
+func (f *FloatingIP) Kind() ResourceKind    { return ResourceKindFloatingIP }
+func (f *FloatingIP) GetID() string         { return f.ID }
+func (f *FloatingIP) GetMetadata() Metadata { return metadataOf(nil, f.Metadata) }
+func (f *FloatingIP) GetProjectID() int     { return f.ProjectID }
+func (f *FloatingIP) GetRegionID() int      { return f.RegionID }
+
+func (s *SecurityGroup) Kind() ResourceKind    { return ResourceKindSecurityGroup }
+func (s *SecurityGroup) GetID() string         { return s.ID }
+func (s *SecurityGroup) GetName() string       { return s.Name }
+func (s *SecurityGroup) GetMetadata() Metadata { return metadataOf(nil, s.Metadata) }
+func (s *SecurityGroup) GetProjectID() int     { return s.ProjectID }
+func (s *SecurityGroup) GetRegionID() int      { return s.RegionID }
+
+func (s *Snapshot) Kind() ResourceKind    { return ResourceKindSnapshot }
+func (s *Snapshot) GetID() string         { return s.ID }
+func (s *Snapshot) GetName() string       { return s.Name }
+func (s *Snapshot) GetMetadata() Metadata { return s.Metadata }
+func (s *Snapshot) GetProjectID() int     { return s.ProjectID }
+func (s *Snapshot) GetRegionID() int      { return s.RegionID }
+
+func (i *Image) Kind() ResourceKind    { return ResourceKindImage }
+func (i *Image) GetID() string         { return i.ID }
+func (i *Image) GetName() string       { return i.Name }
+func (i *Image) GetMetadata() Metadata { return metadataOf(i.Metadata, i.MetadataDetailed) }
+func (i *Image) GetProjectID() int     { return i.ProjectID }
+func (i *Image) GetRegionID() int      { return i.RegionID }
+
+func (k *KeyPair) Kind() ResourceKind    { return ResourceKindKeyPair }
+func (k *KeyPair) GetID() string         { return k.SSHKeyID }
+func (k *KeyPair) GetName() string       { return k.SSHKeyName }
+func (k *KeyPair) GetMetadata() Metadata { return nil }
+func (k *KeyPair) GetProjectID() int     { return k.ProjectID }
+func (k *KeyPair) GetRegionID() int      { return 0 }
+
+func (s *Secret) Kind() ResourceKind    { return ResourceKindSecret }
+func (s *Secret) GetID() string         { return s.ID }
+func (s *Secret) GetName() string       { return s.Name }
+func (s *Secret) GetMetadata() Metadata { return nil }
+func (s *Secret) GetProjectID() int     { return 0 }
+func (s *Secret) GetRegionID() int      { return 0 }
+
+func (s *ServerGroup) Kind() ResourceKind    { return ResourceKindServerGroup }
+func (s *ServerGroup) GetID() string         { return s.ID }
+func (s *ServerGroup) GetName() string       { return s.Name }
+func (s *ServerGroup) GetMetadata() Metadata { return nil }
+func (s *ServerGroup) GetProjectID() int     { return s.ProjectID }
+func (s *ServerGroup) GetRegionID() int      { return s.RegionID }
+
+// GetID returns the ID of the port of the reserved fixed IP, by which the API identifies it.
+func (r *ReservedFixedIP) GetID() string         { return r.PortID }
+func (r *ReservedFixedIP) Kind() ResourceKind    { return ResourceKindReservedFixedIP }
+func (r *ReservedFixedIP) GetName() string       { return r.Name }
+func (r *ReservedFixedIP) GetMetadata() Metadata { return nil }
+func (r *ReservedFixedIP) GetProjectID() int     { return r.ProjectID }
+func (r *ReservedFixedIP) GetRegionID() int      { return r.RegionID }
+
+func (c *MKaaSCluster) Kind() ResourceKind    { return ResourceKindMKaaSCluster }
+func (c *MKaaSCluster) GetID() string         { return strconv.Itoa(c.ID) }
+func (c *MKaaSCluster) GetName() string       { return c.Name }
+func (c *MKaaSCluster) GetMetadata() Metadata { return nil }
+func (c *MKaaSCluster) GetProjectID() int     { return c.ProjectID }
+func (c *MKaaSCluster) GetRegionID() int      { return c.RegionID }
+
+func (c *DBaaSCluster) Kind() ResourceKind    { return ResourceKindDBaaSCluster }
+func (c *DBaaSCluster) GetID() string         { return c.ID }
+func (c *DBaaSCluster) GetName() string       { return c.Name }
+func (c *DBaaSCluster) GetMetadata() Metadata { return nil }
+func (c *DBaaSCluster) GetProjectID() int     { return c.ProjectID }
+func (c *DBaaSCluster) GetRegionID() int      { return c.RegionID }
+
+func (b *DBaaSBackup) Kind() ResourceKind    { return ResourceKindDBaaSBackup }
+func (b *DBaaSBackup) GetID() string         { return b.ID }
+func (b *DBaaSBackup) GetName() string       { return b.Name }
+func (b *DBaaSBackup) GetMetadata() Metadata { return nil }
+func (b *DBaaSBackup) GetProjectID() int     { return 0 }
+func (b *DBaaSBackup) GetRegionID() int      { return 0 }
+
+func (p *Project) Kind() ResourceKind    { return ResourceKindProject }
+func (p *Project) GetID() string         { return strconv.Itoa(p.ID) }
+func (p *Project) GetName() string       { return p.Name }
+func (p *Project) GetMetadata() Metadata { return nil }
+func (p *Project) GetProjectID() int     { return p.ID }
+func (p *Project) GetRegionID() int      { return 0 }
@@ -0,0 +1,126 @@
+package edgecloud
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Resources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	URL := path.Join(volumesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		_, _ = fmt.Fprintf(w, `{"results":[{"id":"%s","name":"data","project_id":%d,"region_id":%d,"metadata":{"env":"prod"}}]}`,
+			testResourceID, projectID, regionID)
+	})
+	mux.HandleFunc(path.Join(URL, testResourceID), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = fmt.Fprintf(w, `{"id":"%s","name":"data"}`, testResourceID)
+		case http.MethodDelete:
+			_, _ = fmt.Fprintf(w, `{"tasks":["%s"]}`, taskID)
+		}
+	})
+	sgURL := path.Join(securitygroupsBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID), testResourceID)
+	mux.HandleFunc(sgURL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	volumes, err := client.Resources(ResourceKindVolume)
+	require.NoError(t, err)
+	assert.Equal(t, ResourceKindVolume, volumes.Kind)
+
+	resources, _, err := volumes.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+	volume := resources[0]
+	assert.Equal(t, ResourceKindVolume, volume.Kind())
+	assert.Equal(t, testResourceID, volume.GetID())
+	assert.Equal(t, "data", volume.GetName())
+	assert.Equal(t, Metadata{"env": "prod"}, volume.GetMetadata())
+	assert.Equal(t, projectID, volume.GetProjectID())
+	assert.Equal(t, regionID, volume.GetRegionID())
+
+	resource, _, err := volumes.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	assert.IsType(t, &Volume{}, resource)
+	assert.Equal(t, testResourceID, resource.GetID())
+
+	task, _, err := volumes.Delete(ctx, testResourceID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{taskID}, task.Tasks)
+
+	securityGroups, err := client.Resources(ResourceKindSecurityGroup)
+	require.NoError(t, err)
+	task, resp, err := securityGroups.Delete(ctx, testResourceID)
+	require.NoError(t, err)
+	assert.Nil(t, task)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, err = client.Resources("unknown")
+	assert.ErrorIs(t, err, ErrResourceKindUnknown)
+}
+
+func TestResourceKinds(t *testing.T) {
+	models := map[ResourceKind]Resource{
+		ResourceKindInstance:        &Instance{},
+		ResourceKindVolume:          &Volume{},
+		ResourceKindNetwork:         &Network{},
+		ResourceKindSubnetwork:      &Subnetwork{},
+		ResourceKindRouter:          &Router{},
+		ResourceKindLoadbalancer:    &Loadbalancer{},
+		ResourceKindListener:        &Listener{},
+		ResourceKindPool:            &Pool{},
+		ResourceKindL7Policy:        &L7Policy{},
+		ResourceKindFloatingIP:      &FloatingIP{},
+		ResourceKindSecurityGroup:   &SecurityGroup{},
+		ResourceKindSnapshot:        &Snapshot{},
+		ResourceKindImage:           &Image{},
+		ResourceKindKeyPair:         &KeyPair{},
+		ResourceKindSecret:          &Secret{},
+		ResourceKindServerGroup:     &ServerGroup{},
+		ResourceKindReservedFixedIP: &ReservedFixedIP{},
+		ResourceKindMKaaSCluster:    &MKaaSCluster{},
+		ResourceKindDBaaSCluster:    &DBaaSCluster{},
+		ResourceKindDBaaSBackup:     &DBaaSBackup{},
+		ResourceKindProject:         &Project{},
+	}
+
+	kinds := ResourceKinds()
+	assert.Len(t, kinds, len(models))
+	assert.IsIncreasing(t, kinds)
+
+	for _, kind := range kinds {
+		model, ok := models[kind]
+		require.True(t, ok, kind)
+		assert.Equal(t, kind, model.Kind())
+
+		api, err := client.Resources(kind)
+		require.NoError(t, err)
+		assert.NotNil(t, api.Get, kind)
+		assert.NotNil(t, api.List, kind)
+		assert.NotNil(t, api.Delete, kind)
+	}
+}
+
+func TestResource_GetMetadata(t *testing.T) {
+	instance := &Instance{MetadataDetailed: []MetadataDetailed{{Key: "env", Value: "prod", ReadOnly: true}}}
+	assert.Equal(t, Metadata{"env": "prod"}, instance.GetMetadata())
+
+	instance.Metadata = Metadata{"env": "dev"}
+	assert.Equal(t, Metadata{"env": "dev"}, instance.GetMetadata())
+
+	assert.Nil(t, (&Network{}).GetMetadata())
+
+	cluster := &MKaaSCluster{ID: 42}
+	assert.Equal(t, "42", cluster.GetID())
+}
@@ -34,17 +34,43 @@ type RoutersServiceOp struct {
 
 var _ RoutersService = &RoutersServiceOp{}
 
+// RouterStatus is the status of a Router.
+type RouterStatus string
+
+const (
+	RouterStatusActive     RouterStatus = "ACTIVE"
+	RouterStatusAllocating RouterStatus = "ALLOCATING"
+	RouterStatusBuild      RouterStatus = "BUILD"
+	RouterStatusDown       RouterStatus = "DOWN"
+	RouterStatusError      RouterStatus = "ERROR"
+)
+
+// IsError reports whether the router is in error.
+func (s RouterStatus) IsError() bool {
+	return s == RouterStatusError
+}
+
+// IsTransitional reports whether the router is being built.
+func (s RouterStatus) IsTransitional() bool {
+	return s == RouterStatusBuild || s == RouterStatusAllocating
+}
+
+// IsTerminal reports whether the router keeps the status until it is acted on, including in error.
+func (s RouterStatus) IsTerminal() bool {
+	return s == RouterStatusActive || s == RouterStatusDown || s == RouterStatusError
+}
+
 // Router represents an EdgecenterCloud Router.
 type Router struct {
 	Region              string              `json:"region"`
-	UpdatedAt           string              `json:"updated_at"`
-	CreatedAt           string              `json:"created_at"`
+	UpdatedAt           Timestamp           `json:"updated_at"`
+	CreatedAt           Timestamp           `json:"created_at"`
 	Name                string              `json:"name"`
 	ID                  string              `json:"id"`
 	RegionID            int                 `json:"region_id"`
 	ProjectID           int                 `json:"project_id"`
 	TaskID              string              `json:"task_id"`
-	Status              string              `json:"status"`
+	Status              RouterStatus        `json:"status"`
 	CreatorTaskID       string              `json:"creator_task_id"`
 	ExternalGatewayInfo ExternalGatewayInfo `json:"external_gateway_info"`
 	Interfaces          []RouterInterface   `json:"interfaces"`
@@ -88,7 +114,7 @@ type RouterInterfaceCreate struct {
 }
 
 type ExternalGatewayInfoCreate struct {
-	Type      string `json:"type,omitempty" validate:"omitempty,enum"`
+	Type      string `json:"type,omitempty" validate:"omitempty,oneof=default manual"`
 	NetworkID string `json:"network_id,omitempty" validate:"rfe=Type:manual,omitempty,uuid4"`
 }
 
@@ -108,11 +134,13 @@ type routersRoot struct {
 
 // List get routers.
 func (s *RoutersServiceOp) List(ctx context.Context) ([]Router, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Routers.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(routersBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, routersBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -130,15 +158,21 @@ func (s *RoutersServiceOp) List(ctx context.Context) ([]Router, *Response, error
 
 // Create a Router.
 func (s *RoutersServiceOp) Create(ctx context.Context, reqBody *RouterCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Routers.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(routersBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, routersBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -156,15 +190,17 @@ func (s *RoutersServiceOp) Create(ctx context.Context, reqBody *RouterCreateRequ
 
 // Delete a Router.
 func (s *RoutersServiceOp) Delete(ctx context.Context, routerID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Routers.Delete")
+
 	if resp, err := isValidUUID(routerID, "routerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(routersBasePathV1), routerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, routersBasePathV1), routerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -182,15 +218,17 @@ func (s *RoutersServiceOp) Delete(ctx context.Context, routerID string) (*TaskRe
 
 // Get a Router.
 func (s *RoutersServiceOp) Get(ctx context.Context, routerID string) (*Router, *Response, error) {
+	ctx = withOperation(ctx, "Routers.Get")
+
 	if resp, err := isValidUUID(routerID, "routerID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(routersBasePathV1), routerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, routersBasePathV1), routerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -208,6 +246,8 @@ func (s *RoutersServiceOp) Get(ctx context.Context, routerID string) (*Router, *
 
 // Update a Router.
 func (s *RoutersServiceOp) Update(ctx context.Context, routerID string, reqBody *RouterUpdateRequest) (*Router, *Response, error) {
+	ctx = withOperation(ctx, "Routers.Update")
+
 	if resp, err := isValidUUID(routerID, "routerID"); err != nil {
 		return nil, resp, err
 	}
@@ -216,11 +256,15 @@ func (s *RoutersServiceOp) Update(ctx context.Context, routerID string, reqBody
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(routersBasePathV1), routerID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, routersBasePathV1), routerID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -238,6 +282,8 @@ func (s *RoutersServiceOp) Update(ctx context.Context, routerID string, reqBody
 
 // Attach a subnet to a Router.
 func (s *RoutersServiceOp) Attach(ctx context.Context, routerID string, reqBody *RouterAttachRequest) (*Router, *Response, error) {
+	ctx = withOperation(ctx, "Routers.Attach")
+
 	if resp, err := isValidUUID(routerID, "routerID"); err != nil {
 		return nil, resp, err
 	}
@@ -246,11 +292,11 @@ func (s *RoutersServiceOp) Attach(ctx context.Context, routerID string, reqBody
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(routersBasePathV1), routerID, routersAttach)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, routersBasePathV1), routerID, routersAttach)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -268,6 +314,8 @@ func (s *RoutersServiceOp) Attach(ctx context.Context, routerID string, reqBody
 
 // Detach a subnet from a Router.
 func (s *RoutersServiceOp) Detach(ctx context.Context, routerID string, reqBody *RouterDetachRequest) (*Router, *Response, error) {
+	ctx = withOperation(ctx, "Routers.Detach")
+
 	if resp, err := isValidUUID(routerID, "routerID"); err != nil {
 		return nil, resp, err
 	}
@@ -276,11 +324,11 @@ func (s *RoutersServiceOp) Detach(ctx context.Context, routerID string, reqBody
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(routersBasePathV1), routerID, routersDetach)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, routersBasePathV1), routerID, routersDetach)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -38,7 +38,7 @@ func TestRouters_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &RouterCreateRequest{}
+	request := &RouterCreateRequest{Name: "test-router"}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(routersBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
@@ -110,7 +110,7 @@ func TestRouters_Update(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &RouterUpdateRequest{}
+	request := &RouterUpdateRequest{Name: "test-router"}
 	expectedResp := &Router{ID: testResourceID}
 	URL := path.Join(routersBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID), testResourceID)
 
@@ -0,0 +1,55 @@
+package edgecloud
+
+import "context"
+
+// scopeContextKey is the context key under which ContextWithScope stores a Scope.
+type scopeContextKey struct{}
+
+// Scope identifies the project and region requests are made in.
+type Scope struct {
+	Project int
+	Region  int
+}
+
+// ContextWithScope returns a copy of ctx that makes requests using it target the given project and region
+// instead of Client.Project and Client.Region. A zero projectID or regionID keeps the value of the client.
+func ContextWithScope(ctx context.Context, projectID, regionID int) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, Scope{Project: projectID, Region: regionID})
+}
+
+// ScopeFromContext returns the Scope stored in ctx with ContextWithScope, if any.
+func ScopeFromContext(ctx context.Context) (Scope, bool) {
+	if ctx == nil {
+		return Scope{}, false
+	}
+	scope, ok := ctx.Value(scopeContextKey{}).(Scope)
+
+	return scope, ok
+}
+
+// scope returns the project and region of a request made with ctx.
+func (c *Client) scope(ctx context.Context) (int, int) {
+	project, region := c.Project, c.Region
+	if scope, ok := ScopeFromContext(ctx); ok {
+		if scope.Project != 0 {
+			project = scope.Project
+		}
+		if scope.Region != 0 {
+			region = scope.Region
+		}
+	}
+
+	return project, region
+}
+
+// WithScope returns a copy of the client bound to the given project and region. The copy shares the HTTP client,
+// base URL, request headers and retry settings with the original one, so it is cheap to create and safe to use
+// from other goroutines while the original client keeps serving its own scope.
+func (c *Client) WithScope(projectID, regionID int) *Client {
+	scoped := *c
+	scoped.Project = projectID
+	scoped.Region = regionID
+	scoped.initServices()
+
+	return &scoped
+}
@@ -0,0 +1,121 @@
+package edgecloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	otherProjectID = 11111
+	otherRegionID  = 22
+)
+
+func handleInstanceGet(t *testing.T, project, region int) {
+	t.Helper()
+
+	URL := path.Join(instancesBasePathV1, strconv.Itoa(project), strconv.Itoa(region), testResourceID)
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		resp, _ := json.Marshal(&Instance{ID: testResourceID, ProjectID: project, RegionID: region})
+		_, _ = fmt.Fprint(w, string(resp))
+	})
+}
+
+func TestClient_WithScope(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleInstanceGet(t, projectID, regionID)
+	handleInstanceGet(t, otherProjectID, otherRegionID)
+
+	client.headers["X-Test"] = "test"
+	scoped := client.WithScope(otherProjectID, otherRegionID)
+
+	assert.Equal(t, otherProjectID, scoped.Project)
+	assert.Equal(t, otherRegionID, scoped.Region)
+	assert.Equal(t, projectID, client.Project)
+	assert.Equal(t, regionID, client.Region)
+	assert.Same(t, client.HTTPClient, scoped.HTTPClient)
+	assert.Equal(t, client.headers, scoped.headers)
+
+	instance, _, err := scoped.Instances.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	assert.Equal(t, otherProjectID, instance.ProjectID)
+	assert.Equal(t, otherRegionID, instance.RegionID)
+
+	instance, _, err = client.Instances.Get(ctx, testResourceID)
+	require.NoError(t, err)
+	assert.Equal(t, projectID, instance.ProjectID)
+	assert.Equal(t, regionID, instance.RegionID)
+}
+
+func TestClient_WithScope_Concurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleInstanceGet(t, projectID, regionID)
+	handleInstanceGet(t, otherProjectID, otherRegionID)
+
+	scopes := []Scope{{Project: projectID, Region: regionID}, {Project: otherProjectID, Region: otherRegionID}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		scope := scopes[i%len(scopes)]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			instance, _, err := client.WithScope(scope.Project, scope.Region).Instances.Get(ctx, testResourceID)
+			if assert.NoError(t, err) {
+				assert.Equal(t, scope.Project, instance.ProjectID)
+				assert.Equal(t, scope.Region, instance.RegionID)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestContextWithScope(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleInstanceGet(t, otherProjectID, otherRegionID)
+
+	instance, _, err := client.Instances.Get(ContextWithScope(ctx, otherProjectID, otherRegionID), testResourceID)
+	require.NoError(t, err)
+	assert.Equal(t, otherProjectID, instance.ProjectID)
+	assert.Equal(t, otherRegionID, instance.RegionID)
+}
+
+func TestContextWithScope_Validate(t *testing.T) {
+	c := NewClient(nil)
+
+	_, err := c.ValidateContext(ctx)
+	assert.EqualError(t, err, NewArgError("Client.Project", "is not set").Error())
+
+	_, err = c.ValidateContext(ContextWithScope(ctx, projectID, 0))
+	assert.EqualError(t, err, NewArgError("Client.Region", "is not set").Error())
+
+	_, err = c.ValidateRegionContext(ContextWithScope(ctx, 0, regionID))
+	assert.NoError(t, err)
+
+	resp, err := c.ValidateContext(ContextWithScope(ctx, projectID, regionID))
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestScopeFromContext(t *testing.T) {
+	_, ok := ScopeFromContext(ctx)
+	assert.False(t, ok)
+
+	scope, ok := ScopeFromContext(ContextWithScope(ctx, projectID, regionID))
+	assert.True(t, ok)
+	assert.Equal(t, Scope{Project: projectID, Region: regionID}, scope)
+}
@@ -30,13 +30,13 @@ var _ SecretsService = &SecretsServiceOp{}
 
 // Secret represents an EdgecenterCloud Secret.
 type Secret struct {
-	Expiration   string            `json:"expiration"`
+	Expiration   Timestamp         `json:"expiration"`
 	Algorithm    string            `json:"algorithm"`
 	Name         string            `json:"name"`
 	Mode         string            `json:"mode"`
 	ID           string            `json:"id"`
 	BitLength    int               `json:"bit_length"`
-	Created      string            `json:"created"`
+	Created      Timestamp         `json:"created"`
 	Status       string            `json:"status"`
 	SecretType   string            `json:"secret_type"`
 	ContentTypes map[string]string `json:"content_types"`
@@ -85,11 +85,13 @@ type secretsRoot struct {
 
 // List get secrets.
 func (s *SecretsServiceOp) List(ctx context.Context) ([]Secret, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Secrets.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(secretsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, secretsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -107,15 +109,21 @@ func (s *SecretsServiceOp) List(ctx context.Context) ([]Secret, *Response, error
 
 // Create a Secret.
 func (s *SecretsServiceOp) Create(ctx context.Context, reqBody *SecretCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Secrets.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(secretsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, secretsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -133,15 +141,21 @@ func (s *SecretsServiceOp) Create(ctx context.Context, reqBody *SecretCreateRequ
 
 // CreateV2 a Secret V2.
 func (s *SecretsServiceOp) CreateV2(ctx context.Context, reqBody *SecretCreateRequestV2) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Secrets.CreateV2")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(secretsBasePathV2)
+	path := s.client.addProjectRegionPath(ctx, secretsBasePathV2)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -159,15 +173,17 @@ func (s *SecretsServiceOp) CreateV2(ctx context.Context, reqBody *SecretCreateRe
 
 // Get a Secret.
 func (s *SecretsServiceOp) Get(ctx context.Context, secretID string) (*Secret, *Response, error) {
+	ctx = withOperation(ctx, "Secrets.Get")
+
 	if resp, err := isValidUUID(secretID, "secretID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(secretsBasePathV1), secretID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, secretsBasePathV1), secretID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -185,15 +201,17 @@ func (s *SecretsServiceOp) Get(ctx context.Context, secretID string) (*Secret, *
 
 // Delete a Secret.
 func (s *SecretsServiceOp) Delete(ctx context.Context, secretID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Secrets.Delete")
+
 	if resp, err := isValidUUID(secretID, "secretID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(secretsBasePathV1), secretID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, secretsBasePathV1), secretID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
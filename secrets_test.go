@@ -38,7 +38,13 @@ func TestSecrets_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &SecretCreateRequest{}
+	request := &SecretCreateRequest{
+		Name:                   "test-secret",
+		PayloadContentType:     "application/octet-stream",
+		PayloadContentEncoding: "base64",
+		Payload:                "c2VjcmV0",
+		SecretType:             SecretTypeOpaque,
+	}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(secretsBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
@@ -66,7 +72,14 @@ func TestSecrets_CreateV2(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &SecretCreateRequestV2{}
+	request := &SecretCreateRequestV2{
+		Name: "test-secret",
+		Payload: Payload{
+			CertificateChain: "certificate-chain",
+			PrivateKey:       "private-key",
+			Certificate:      "certificate",
+		},
+	}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(secretsBasePathV2, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
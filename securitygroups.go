@@ -61,8 +61,8 @@ var _ SecurityGroupsService = &SecurityGroupsServiceOp{}
 // SecurityGroup represents a EdgecenterCloud Security Group.
 type SecurityGroup struct {
 	ID                 string              `json:"id"`
-	CreatedAt          string              `json:"created_at"`
-	UpdatedAt          string              `json:"updated_at"`
+	CreatedAt          Timestamp           `json:"created_at"`
+	UpdatedAt          Timestamp           `json:"updated_at"`
 	RevisionNumber     int                 `json:"revision_number"`
 	Name               string              `json:"name"`
 	Description        string              `json:"description"`
@@ -86,8 +86,8 @@ type SecurityGroupRule struct {
 	PortRangeMin    *int                       `json:"port_range_min"`
 	Description     *string                    `json:"description"`
 	RemoteIPPrefix  *string                    `json:"remote_ip_prefix"`
-	CreatedAt       string                     `json:"created_at"`
-	UpdatedAt       string                     `json:"updated_at"`
+	CreatedAt       Timestamp                  `json:"created_at"`
+	UpdatedAt       Timestamp                  `json:"updated_at"`
 	RevisionNumber  int                        `json:"revision_number"`
 }
 
@@ -432,11 +432,13 @@ type RuleUpdateRequest struct {
 
 // List get security groups.
 func (s *SecurityGroupsServiceOp) List(ctx context.Context, opts *SecurityGroupListOptions) ([]SecurityGroup, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "SecurityGroups.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(securitygroupsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, securitygroupsBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -458,15 +460,17 @@ func (s *SecurityGroupsServiceOp) List(ctx context.Context, opts *SecurityGroupL
 
 // Get individual Security Group.
 func (s *SecurityGroupsServiceOp) Get(ctx context.Context, securityGroupID string) (*SecurityGroup, *Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.Get")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(securitygroupsBasePathV1), securityGroupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, securitygroupsBasePathV1), securityGroupID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -484,15 +488,21 @@ func (s *SecurityGroupsServiceOp) Get(ctx context.Context, securityGroupID strin
 
 // Create a Security Group.
 func (s *SecurityGroupsServiceOp) Create(ctx context.Context, reqBody *SecurityGroupCreateRequest) (*SecurityGroup, *Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(securitygroupsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, securitygroupsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -510,15 +520,17 @@ func (s *SecurityGroupsServiceOp) Create(ctx context.Context, reqBody *SecurityG
 
 // Delete the Security Group.
 func (s *SecurityGroupsServiceOp) Delete(ctx context.Context, securityGroupID string) (*Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.Delete")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(securitygroupsBasePathV1), securityGroupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, securitygroupsBasePathV1), securityGroupID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -530,6 +542,8 @@ func (s *SecurityGroupsServiceOp) Delete(ctx context.Context, securityGroupID st
 
 // Update a Security Group.
 func (s *SecurityGroupsServiceOp) Update(ctx context.Context, securityGroupID string, reqBody *SecurityGroupUpdateRequest) (*SecurityGroup, *Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.Update")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return nil, resp, err
 	}
@@ -538,11 +552,15 @@ func (s *SecurityGroupsServiceOp) Update(ctx context.Context, securityGroupID st
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(securitygroupsBasePathV1), securityGroupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, securitygroupsBasePathV1), securityGroupID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -560,6 +578,8 @@ func (s *SecurityGroupsServiceOp) Update(ctx context.Context, securityGroupID st
 
 // DeepCopy creates a deep copy of a security group.
 func (s *SecurityGroupsServiceOp) DeepCopy(ctx context.Context, securityGroupID string, reqBody *Name) (*Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.DeepCopy")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return resp, err
 	}
@@ -568,11 +588,11 @@ func (s *SecurityGroupsServiceOp) DeepCopy(ctx context.Context, securityGroupID
 		return nil, NewArgError("deepCopyRequest", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := s.client.addProjectRegionPath(securitygroupsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, securitygroupsBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, securityGroupID, securitygroupsCopy)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -585,6 +605,8 @@ func (s *SecurityGroupsServiceOp) DeepCopy(ctx context.Context, securityGroupID
 
 // RuleCreate to a security group.
 func (s *SecurityGroupsServiceOp) RuleCreate(ctx context.Context, securityGroupID string, reqBody *RuleCreateRequest) (*SecurityGroupRule, *Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.RuleCreate")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return nil, resp, err
 	}
@@ -593,11 +615,15 @@ func (s *SecurityGroupsServiceOp) RuleCreate(ctx context.Context, securityGroupI
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(securitygroupsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, securitygroupsBasePathV1)
 	path = fmt.Sprintf("%s/%s/%s", path, securityGroupID, securitygroupsRules)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
@@ -617,15 +643,17 @@ func (s *SecurityGroupsServiceOp) RuleCreate(ctx context.Context, securityGroupI
 // RuleDelete a security group rule.
 // todo cloud-api deletes rule without tash.
 func (s *SecurityGroupsServiceOp) RuleDelete(ctx context.Context, securityGroupID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.RuleDelete")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(securitygroupsRulesBasePathV1), securityGroupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, securitygroupsRulesBasePathV1), securityGroupID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -643,6 +671,8 @@ func (s *SecurityGroupsServiceOp) RuleDelete(ctx context.Context, securityGroupI
 
 // RuleUpdate a security group rule.
 func (s *SecurityGroupsServiceOp) RuleUpdate(ctx context.Context, securityGroupID string, reqBody *RuleUpdateRequest) (*SecurityGroupRule, *Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.RuleUpdate")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return nil, resp, err
 	}
@@ -651,11 +681,15 @@ func (s *SecurityGroupsServiceOp) RuleUpdate(ctx context.Context, securityGroupI
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(securitygroupsRulesBasePathV1), securityGroupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, securitygroupsRulesBasePathV1), securityGroupID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody)
 	if err != nil {
@@ -673,11 +707,13 @@ func (s *SecurityGroupsServiceOp) RuleUpdate(ctx context.Context, securityGroupI
 
 // MetadataList security group detailed metadata items.
 func (s *SecurityGroupsServiceOp) MetadataList(ctx context.Context, securityGroupID string) ([]MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.MetadataList")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -686,11 +722,13 @@ func (s *SecurityGroupsServiceOp) MetadataList(ctx context.Context, securityGrou
 
 // MetadataCreate or update security group metadata.
 func (s *SecurityGroupsServiceOp) MetadataCreate(ctx context.Context, securityGroupID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.MetadataCreate")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -699,11 +737,13 @@ func (s *SecurityGroupsServiceOp) MetadataCreate(ctx context.Context, securityGr
 
 // MetadataUpdate security group metadata.
 func (s *SecurityGroupsServiceOp) MetadataUpdate(ctx context.Context, securityGroupID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.MetadataUpdate")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -712,11 +752,13 @@ func (s *SecurityGroupsServiceOp) MetadataUpdate(ctx context.Context, securityGr
 
 // MetadataDeleteItem a security group metadata item by key.
 func (s *SecurityGroupsServiceOp) MetadataDeleteItem(ctx context.Context, securityGroupID string, opts *MetadataItemOptions) (*Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.MetadataDeleteItem")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -725,11 +767,13 @@ func (s *SecurityGroupsServiceOp) MetadataDeleteItem(ctx context.Context, securi
 
 // MetadataGetItem security group detailed metadata.
 func (s *SecurityGroupsServiceOp) MetadataGetItem(ctx context.Context, securityGroupID string, opts *MetadataItemOptions) (*MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "SecurityGroups.MetadataGetItem")
+
 	if resp, err := isValidUUID(securityGroupID, "securityGroupID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
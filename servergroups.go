@@ -2,6 +2,7 @@ package edgecloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -50,6 +51,17 @@ const (
 	ServerGroupPolicyAntiAffinity ServerGroupPolicy = "anti-affinity"
 )
 
+var ErrInvalidServerGroupPolicy = errors.New("invalid ServerGroupPolicy value")
+
+// IsValid reports whether the server group policy is one of the known values.
+func (p ServerGroupPolicy) IsValid() error {
+	switch p {
+	case ServerGroupPolicyAffinity, ServerGroupPolicyAntiAffinity:
+		return nil
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidServerGroupPolicy, p)
+}
+
 // ServerGroupCreateRequest represents a request to create a Server Group.
 type ServerGroupCreateRequest struct {
 	Name   string            `json:"name" required:"true"`
@@ -64,11 +76,13 @@ type serverGroupsRoot struct {
 
 // List get Server Groups.
 func (s *ServerGroupsServiceOp) List(ctx context.Context) ([]ServerGroup, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "ServerGroups.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(servergroupsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, servergroupsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -86,15 +100,17 @@ func (s *ServerGroupsServiceOp) List(ctx context.Context) ([]ServerGroup, *Respo
 
 // Get individual Server Group.
 func (s *ServerGroupsServiceOp) Get(ctx context.Context, serverGroupID string) (*ServerGroup, *Response, error) {
+	ctx = withOperation(ctx, "ServerGroups.Get")
+
 	if resp, err := isValidUUID(serverGroupID, "serverGroupID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(servergroupsBasePathV1), serverGroupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, servergroupsBasePathV1), serverGroupID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -112,15 +128,21 @@ func (s *ServerGroupsServiceOp) Get(ctx context.Context, serverGroupID string) (
 
 // Create a Server Group.
 func (s *ServerGroupsServiceOp) Create(ctx context.Context, reqBody *ServerGroupCreateRequest) (*ServerGroup, *Response, error) {
+	ctx = withOperation(ctx, "ServerGroups.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(servergroupsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, servergroupsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -138,15 +160,17 @@ func (s *ServerGroupsServiceOp) Create(ctx context.Context, reqBody *ServerGroup
 
 // Delete the Server Group.
 func (s *ServerGroupsServiceOp) Delete(ctx context.Context, serverGroupID string) (*Response, error) {
+	ctx = withOperation(ctx, "ServerGroups.Delete")
+
 	if resp, err := isValidUUID(serverGroupID, "serverGroupID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(servergroupsBasePathV1), serverGroupID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, servergroupsBasePathV1), serverGroupID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
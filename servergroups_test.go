@@ -60,7 +60,7 @@ func TestServerGroups_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &ServerGroupCreateRequest{Name: "test-subnet"}
+	request := &ServerGroupCreateRequest{Name: "test-subnet", Policy: ServerGroupPolicyAffinity}
 	expectedResp := &ServerGroup{ID: testResourceID}
 	URL := path.Join(servergroupsBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
@@ -27,22 +27,58 @@ type SnapshotsServiceOp struct {
 
 var _ SnapshotsService = &SnapshotsServiceOp{}
 
+// SnapshotStatus is the status of a Snapshot.
+type SnapshotStatus string
+
+const (
+	SnapshotStatusAvailable     SnapshotStatus = "available"
+	SnapshotStatusBackingUp     SnapshotStatus = "backing-up"
+	SnapshotStatusCreating      SnapshotStatus = "creating"
+	SnapshotStatusDeleted       SnapshotStatus = "deleted"
+	SnapshotStatusDeleting      SnapshotStatus = "deleting"
+	SnapshotStatusError         SnapshotStatus = "error"
+	SnapshotStatusErrorDeleting SnapshotStatus = "error_deleting"
+	SnapshotStatusRestoring     SnapshotStatus = "restoring"
+	SnapshotStatusUnmanaging    SnapshotStatus = "unmanaging"
+)
+
+// IsError reports whether the snapshot is in one of the error statuses.
+func (s SnapshotStatus) IsError() bool {
+	return s == SnapshotStatusError || s == SnapshotStatusErrorDeleting
+}
+
+// IsTransitional reports whether the snapshot is changing, e.g. being created or deleted.
+func (s SnapshotStatus) IsTransitional() bool {
+	switch s {
+	case SnapshotStatusBackingUp, SnapshotStatusCreating, SnapshotStatusDeleting, SnapshotStatusRestoring,
+		SnapshotStatusUnmanaging:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTerminal reports whether the snapshot keeps the status until it is acted on, including in error.
+func (s SnapshotStatus) IsTerminal() bool {
+	return s == SnapshotStatusAvailable || s == SnapshotStatusDeleted || s.IsError()
+}
+
 // Snapshot represents an EdgecenterCloud Snapshot.
 type Snapshot struct {
-	Region        string   `json:"region"`
-	UpdatedAt     *string  `json:"updated_at"`
-	CreatedAt     string   `json:"created_at"`
-	Name          string   `json:"name"`
-	ID            string   `json:"id"`
-	RegionID      int      `json:"region_id"`
-	ProjectID     int      `json:"project_id"`
-	TaskID        *string  `json:"task_id"`
-	Status        string   `json:"status"`
-	CreatorTaskID *string  `json:"creator_task_id"`
-	Size          int      `json:"size"`
-	VolumeID      string   `json:"volume_id"`
-	Description   string   `json:"description"`
-	Metadata      Metadata `json:"metadata"`
+	Region        string         `json:"region"`
+	UpdatedAt     *Timestamp     `json:"updated_at"`
+	CreatedAt     Timestamp      `json:"created_at"`
+	Name          string         `json:"name"`
+	ID            string         `json:"id"`
+	RegionID      int            `json:"region_id"`
+	ProjectID     int            `json:"project_id"`
+	TaskID        *string        `json:"task_id"`
+	Status        SnapshotStatus `json:"status"`
+	CreatorTaskID *string        `json:"creator_task_id"`
+	Size          int            `json:"size"`
+	VolumeID      string         `json:"volume_id"`
+	Description   string         `json:"description"`
+	Metadata      Metadata       `json:"metadata"`
 }
 
 // SnapshotListOptions specifies the optional query parameters to List method.
@@ -70,11 +106,13 @@ type snapshotsRoot struct {
 
 // List get Snapshots.
 func (s *SnapshotsServiceOp) List(ctx context.Context, opts *SnapshotListOptions) ([]Snapshot, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Snapshots.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(snapshotsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, snapshotsBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -91,20 +129,28 @@ func (s *SnapshotsServiceOp) List(ctx context.Context, opts *SnapshotListOptions
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Snapshots, resp, err
 }
 
 // Create a Snapshot.
 func (s *SnapshotsServiceOp) Create(ctx context.Context, reqBody *SnapshotCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Snapshots.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(snapshotsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, snapshotsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -122,15 +168,17 @@ func (s *SnapshotsServiceOp) Create(ctx context.Context, reqBody *SnapshotCreate
 
 // Delete a Snapshot.
 func (s *SnapshotsServiceOp) Delete(ctx context.Context, snapshotID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Snapshots.Delete")
+
 	if resp, err := isValidUUID(snapshotID, "snapshotID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(snapshotsBasePathV1), snapshotID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, snapshotsBasePathV1), snapshotID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -148,15 +196,17 @@ func (s *SnapshotsServiceOp) Delete(ctx context.Context, snapshotID string) (*Ta
 
 // Get a Snapshot.
 func (s *SnapshotsServiceOp) Get(ctx context.Context, snapshotID string) (*Snapshot, *Response, error) {
+	ctx = withOperation(ctx, "Snapshots.Get")
+
 	if resp, err := isValidUUID(snapshotID, "snapshotID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(snapshotsBasePathV1), snapshotID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, snapshotsBasePathV1), snapshotID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -174,15 +224,17 @@ func (s *SnapshotsServiceOp) Get(ctx context.Context, snapshotID string) (*Snaps
 
 // MetadataUpdate updates snapshot metadata.
 func (s *SnapshotsServiceOp) MetadataUpdate(ctx context.Context, snapshotID string, reqBody *MetadataCreateRequest) (*Snapshot, *Response, error) {
+	ctx = withOperation(ctx, "Snapshots.MetadataUpdate")
+
 	if resp, err := isValidUUID(snapshotID, "snapshotID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(snapshotsBasePathV1), snapshotID, metadataPath)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, snapshotsBasePathV1), snapshotID, metadataPath)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, path, reqBody.Metadata)
 	if err != nil {
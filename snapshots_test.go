@@ -38,7 +38,7 @@ func TestSnapshots_Create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &SnapshotCreateRequest{Name: "test-snapshot"}
+	request := &SnapshotCreateRequest{Name: "test-snapshot", VolumeID: testResourceID}
 	expectedResp := &TaskResponse{Tasks: []string{taskID}}
 	URL := path.Join(snapshotsBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
@@ -56,8 +56,8 @@ type Subnetwork struct {
 	EnableDHCP             bool               `json:"enable_dhcp"`
 	ConnectToNetworkRouter bool               `json:"connect_to_network_router"`
 	CIDR                   string             `json:"cidr"` // TODO add cidr parsing.
-	CreatedAt              string             `json:"created_at"`
-	UpdatedAt              string             `json:"updated_at"`
+	CreatedAt              Timestamp          `json:"created_at"`
+	UpdatedAt              Timestamp          `json:"updated_at"`
 	CreatorTaskID          string             `json:"creator_task_id"`
 	TaskID                 string             `json:"task_id"`
 	AvailableIps           int                `json:"available_ips"`
@@ -198,11 +198,13 @@ type subnetworkRoot struct {
 
 // List get subnetworks.
 func (s *SubnetworksServiceOp) List(ctx context.Context, opts *SubnetworkListOptions) ([]Subnetwork, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Subnetworks.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(subnetsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, subnetsBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -224,15 +226,17 @@ func (s *SubnetworksServiceOp) List(ctx context.Context, opts *SubnetworkListOpt
 
 // Get individual Subnetwork.
 func (s *SubnetworksServiceOp) Get(ctx context.Context, subnetworkID string) (*Subnetwork, *Response, error) {
+	ctx = withOperation(ctx, "Subnetworks.Get")
+
 	if resp, err := isValidUUID(subnetworkID, "subnetworkID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(subnetsBasePathV1), subnetworkID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, subnetsBasePathV1), subnetworkID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -250,11 +254,17 @@ func (s *SubnetworksServiceOp) Get(ctx context.Context, subnetworkID string) (*S
 
 // Create a Subnetwork.
 func (s *SubnetworksServiceOp) Create(ctx context.Context, reqBody *SubnetworkCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Subnetworks.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -262,7 +272,7 @@ func (s *SubnetworksServiceOp) Create(ctx context.Context, reqBody *SubnetworkCr
 		return nil, nil, err
 	}
 
-	path := s.client.addProjectRegionPath(subnetsBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, subnetsBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -280,15 +290,17 @@ func (s *SubnetworksServiceOp) Create(ctx context.Context, reqBody *SubnetworkCr
 
 // Delete the Subnetwork.
 func (s *SubnetworksServiceOp) Delete(ctx context.Context, subnetworkID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Subnetworks.Delete")
+
 	if resp, err := isValidUUID(subnetworkID, "subnetworkID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(subnetsBasePathV1), subnetworkID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, subnetsBasePathV1), subnetworkID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -306,6 +318,8 @@ func (s *SubnetworksServiceOp) Delete(ctx context.Context, subnetworkID string)
 
 // Update the Subnetwork properties.
 func (s *SubnetworksServiceOp) Update(ctx context.Context, subnetworkID string, reqBody *SubnetworkUpdateRequest) (*Subnetwork, *Response, error) {
+	ctx = withOperation(ctx, "Subnetworks.Update")
+
 	if resp, err := isValidUUID(subnetworkID, "subnetworkID"); err != nil {
 		return nil, resp, err
 	}
@@ -314,11 +328,15 @@ func (s *SubnetworksServiceOp) Update(ctx context.Context, subnetworkID string,
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(subnetsBasePathV1), subnetworkID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, subnetsBasePathV1), subnetworkID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -336,11 +354,13 @@ func (s *SubnetworksServiceOp) Update(ctx context.Context, subnetworkID string,
 
 // MetadataList subnetwork detailed metadata items.
 func (s *SubnetworksServiceOp) MetadataList(ctx context.Context, subnetworkID string) ([]MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Subnetworks.MetadataList")
+
 	if resp, err := isValidUUID(subnetworkID, "subnetworkID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -349,11 +369,13 @@ func (s *SubnetworksServiceOp) MetadataList(ctx context.Context, subnetworkID st
 
 // MetadataCreate or update subnetwork metadata.
 func (s *SubnetworksServiceOp) MetadataCreate(ctx context.Context, subnetworkID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Subnetworks.MetadataCreate")
+
 	if resp, err := isValidUUID(subnetworkID, "subnetworkID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -362,11 +384,13 @@ func (s *SubnetworksServiceOp) MetadataCreate(ctx context.Context, subnetworkID
 
 // MetadataUpdate subnetwork metadata.
 func (s *SubnetworksServiceOp) MetadataUpdate(ctx context.Context, subnetworkID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Subnetworks.MetadataUpdate")
+
 	if resp, err := isValidUUID(subnetworkID, "subnetworkID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -375,11 +399,13 @@ func (s *SubnetworksServiceOp) MetadataUpdate(ctx context.Context, subnetworkID
 
 // MetadataDeleteItem a subnetwork metadata item by key.
 func (s *SubnetworksServiceOp) MetadataDeleteItem(ctx context.Context, subnetworkID string, opts *MetadataItemOptions) (*Response, error) {
+	ctx = withOperation(ctx, "Subnetworks.MetadataDeleteItem")
+
 	if resp, err := isValidUUID(subnetworkID, "subnetworkID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -388,11 +414,13 @@ func (s *SubnetworksServiceOp) MetadataDeleteItem(ctx context.Context, subnetwor
 
 // MetadataGetItem subnetwork detailed metadata.
 func (s *SubnetworksServiceOp) MetadataGetItem(ctx context.Context, subnetworkID string, opts *MetadataItemOptions) (*MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Subnetworks.MetadataGetItem")
+
 	if resp, err := isValidUUID(subnetworkID, "subnetworkID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -46,10 +46,10 @@ type Task struct {
 	CreatedResources  map[string]interface{}  `json:"created_resources"`
 	RequestID         string                  `json:"request_id"`
 	Error             *string                 `json:"error"`
-	CreatedOn         string                  `json:"created_on"`
-	UpdatedOn         *string                 `json:"updated_on,omitempty"`
-	FinishedOn        *string                 `json:"finished_on,omitempty"`
-	AcknowledgedAt    string                  `json:"acknowledged_at,omitempty"`
+	CreatedOn         Timestamp               `json:"created_on"`
+	UpdatedOn         *Timestamp              `json:"updated_on,omitempty"`
+	FinishedOn        *Timestamp              `json:"finished_on,omitempty"`
+	AcknowledgedAt    *Timestamp              `json:"acknowledged_at,omitempty"`
 	AcknowledgedBy    int                     `json:"acknowledged_by,omitempty"`
 	JobID             string                  `json:"job_id"`
 	ScheduleID        string                  `json:"schedule_id"`
@@ -106,11 +106,13 @@ type tasksRoot struct {
 
 // ListActive get active tasks.
 func (s *TasksServiceOp) ListActive(ctx context.Context) ([]Task, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Tasks.ListActive")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(tasksBasePathV1), tasksActive)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, tasksBasePathV1), tasksActive)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -128,6 +130,8 @@ func (s *TasksServiceOp) ListActive(ctx context.Context) ([]Task, *Response, err
 
 // Acknowledge one task on project scope.
 func (s *TasksServiceOp) Acknowledge(ctx context.Context, taskID string) (*Task, *Response, error) {
+	ctx = withOperation(ctx, "Tasks.Acknowledge")
+
 	if resp, err := isValidUUID(taskID, "taskID"); err != nil {
 		return nil, resp, err
 	}
@@ -150,6 +154,8 @@ func (s *TasksServiceOp) Acknowledge(ctx context.Context, taskID string) (*Task,
 
 // AcknowledgeAll client tasks in project or region.
 func (s *TasksServiceOp) AcknowledgeAll(ctx context.Context, opts *TaskAcknowledgeAllOptions) (*Response, error) {
+	ctx = withOperation(ctx, "Tasks.AcknowledgeAll")
+
 	path := fmt.Sprintf("%s/%s", tasksBasePathV1, tasksAcknowledge)
 
 	path, err := addOptions(path, opts)
@@ -167,6 +173,8 @@ func (s *TasksServiceOp) AcknowledgeAll(ctx context.Context, opts *TaskAcknowled
 
 // Get individual Task.
 func (s *TasksServiceOp) Get(ctx context.Context, taskID string) (*Task, *Response, error) {
+	ctx = withOperation(ctx, "Tasks.Get")
+
 	if resp, err := isValidUUID(taskID, "taskID"); err != nil {
 		return nil, resp, err
 	}
@@ -189,6 +197,8 @@ func (s *TasksServiceOp) Get(ctx context.Context, taskID string) (*Task, *Respon
 
 // List gets tasks.
 func (s *TasksServiceOp) List(ctx context.Context, opts *TaskListOptions) ([]Task, *Response, error) {
+	ctx = withOperation(ctx, "Tasks.List")
+
 	path, err := addOptions(tasksBasePathV1, opts)
 	if err != nil {
 		return nil, nil, err
@@ -205,5 +215,7 @@ func (s *TasksServiceOp) List(ctx context.Context, opts *TaskListOptions) ([]Tas
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: tasks.Count}
+
 	return tasks.Tasks, resp, err
 }
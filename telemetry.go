@@ -0,0 +1,192 @@
+package edgecloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	instrumentationName = "github.com/Edge-Center/edgecentercloud-go/v2"
+
+	metricRequestDuration = "edgecloud.client.request.duration"
+	metricRequestErrors   = "edgecloud.client.request.errors"
+)
+
+// Attribute keys set on the spans and metrics of the API calls.
+const (
+	AttributeOperation     = attribute.Key("edgecloud.operation")
+	AttributeProjectID     = attribute.Key("edgecloud.project_id")
+	AttributeRegionID      = attribute.Key("edgecloud.region_id")
+	AttributeRetryAttempts = attribute.Key("edgecloud.retry_attempts")
+	AttributeTaskIDs       = attribute.Key("edgecloud.task_ids")
+	AttributeRequestID     = attribute.Key("edgecloud.request_id")
+)
+
+// operationContextKey is the context key under which a service method stores its name for the requests it makes.
+type operationContextKey struct{}
+
+// retryCounterContextKey is the context key under which the number of retries of a request is counted.
+type retryCounterContextKey struct{}
+
+// TelemetryConfig sets the OpenTelemetry providers used to instrument the API calls.
+// A nil provider is replaced with the global one registered with the otel package.
+type TelemetryConfig struct {
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+}
+
+// telemetry holds the tracer and the metric instruments of the client.
+type telemetry struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+func newTelemetry(config TelemetryConfig) (*telemetry, error) {
+	tracerProvider := config.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	meterProvider := config.MeterProvider
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+
+	meter := meterProvider.Meter(instrumentationName, metric.WithInstrumentationVersion(libraryVersion))
+	duration, err := meter.Float64Histogram(metricRequestDuration,
+		metric.WithDescription("Duration of the EdgecenterCloud API calls, including retries."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+	errorCount, err := meter.Int64Counter(metricRequestErrors,
+		metric.WithDescription("Number of the failed EdgecenterCloud API calls."),
+		metric.WithUnit("{error}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &telemetry{
+		tracer:   tracerProvider.Tracer(instrumentationName, trace.WithInstrumentationVersion(libraryVersion)),
+		duration: duration,
+		errors:   errorCount,
+	}, nil
+}
+
+// WithTelemetry is a client option for instrumenting the API calls with OpenTelemetry.
+//
+// Every call made with Do gets a client span named after the service method that made it, e.g. Instances.Create,
+// with the project, region, status code, number of retry attempts and the IDs of the started tasks as attributes.
+// The duration and the errors of the calls are recorded with the edgecloud.client.request.duration histogram
+// and the edgecloud.client.request.errors counter.
+func WithTelemetry(config TelemetryConfig) ClientOpt {
+	return func(c *Client) error {
+		t, err := newTelemetry(config)
+		if err != nil {
+			return err
+		}
+		c.telemetry = t
+
+		return nil
+	}
+}
+
+// StartSpan starts a span with the tracer of the client, as a child of the span in ctx, if any.
+// It lets helpers built on top of the client, like the task waiting of the util package, appear in the traces.
+// Without WithTelemetry, ctx is returned as is together with a non-recording span.
+func (c *Client) StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if c.telemetry == nil {
+		return ctx, noop.Span{}
+	}
+
+	return c.telemetry.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// start starts the span of a request. The returned function ends the span and records the metrics.
+func (t *telemetry) start(ctx context.Context, c *Client, req *http.Request) (context.Context, func(*Response, interface{}, error)) {
+	if t == nil {
+		return ctx, func(*Response, interface{}, error) {}
+	}
+
+	operation := operationName(ctx, req)
+	project, region := c.scope(ctx)
+	attrs := []attribute.KeyValue{
+		AttributeOperation.String(operation),
+		semconv.HTTPRequestMethodKey.String(req.Method),
+	}
+
+	ctx, span := t.tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+		trace.WithAttributes(
+			AttributeProjectID.Int(project),
+			AttributeRegionID.Int(region),
+			semconv.URLFull(req.URL.String()),
+		))
+
+	retries := new(atomic.Int64)
+	ctx = context.WithValue(ctx, retryCounterContextKey{}, retries)
+	started := time.Now()
+
+	return ctx, func(resp *Response, v interface{}, err error) {
+		if resp != nil && resp.Response != nil {
+			status := semconv.HTTPResponseStatusCode(resp.StatusCode)
+			attrs = append(attrs, status)
+			span.SetAttributes(status)
+			if requestID := resp.Header.Get(headerRequestID); requestID != "" {
+				span.SetAttributes(AttributeRequestID.String(requestID))
+			}
+		}
+		span.SetAttributes(AttributeRetryAttempts.Int64(retries.Load()))
+
+		if tasks, ok := v.(*TaskResponse); ok && tasks != nil && len(tasks.Tasks) > 0 {
+			span.SetAttributes(AttributeTaskIDs.StringSlice(tasks.Tasks))
+		}
+
+		if err != nil {
+			var respErr *ResponseError
+			if errors.As(err, &respErr) && respErr.RequestID != "" {
+				span.SetAttributes(AttributeRequestID.String(respErr.RequestID))
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			t.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+
+		t.duration.Record(ctx, time.Since(started).Seconds(), metric.WithAttributes(attrs...))
+		span.End()
+	}
+}
+
+// countRetry counts a retry of the request made with ctx, if it is instrumented.
+func countRetry(ctx context.Context) {
+	if retries, ok := ctx.Value(retryCounterContextKey{}).(*atomic.Int64); ok {
+		retries.Add(1)
+	}
+}
+
+// withOperation returns a copy of ctx naming the service method, e.g. Instances.Create, that makes the requests.
+func withOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, name)
+}
+
+// operationName returns the name of the service method that made the request with ctx, e.g. Instances.Create,
+// or the request method if the request was not made by a service of the client.
+func operationName(ctx context.Context, req *http.Request) string {
+	if name, ok := ctx.Value(operationContextKey{}).(string); ok {
+		return name
+	}
+
+	return req.Method
+}
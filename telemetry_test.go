@@ -0,0 +1,230 @@
+package edgecloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTelemetry(t *testing.T, c *Client, opts ...ClientOpt) (*Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	opts = append(opts, WithTelemetry(TelemetryConfig{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	}))
+
+	instrumented, err := New(c.HTTPClient, opts...)
+	require.NoError(t, err)
+	instrumented.BaseURL = c.BaseURL
+	instrumented.Project = c.Project
+	instrumented.Region = c.Region
+
+	return instrumented, recorder, reader
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+
+	return attrs
+}
+
+func collectMetrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	metrics := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	return metrics
+}
+
+func TestWithTelemetry(t *testing.T) {
+	setup()
+	defer teardown()
+
+	URL := path.Join(instancesBasePathV2, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.Header().Set(headerRequestID, "req-1")
+		resp, _ := json.Marshal(&TaskResponse{Tasks: []string{taskID}})
+		_, _ = fmt.Fprint(w, string(resp))
+	})
+
+	c, recorder, reader := setupTelemetry(t, client)
+
+	request := &InstanceCreateRequest{
+		Names:      []string{"test-instance"},
+		Flavor:     "g1-standard-1-2",
+		Interfaces: []InstanceInterface{{Type: InterfaceTypeExternal}},
+		Volumes:    []InstanceVolumeCreate{{TypeName: VolumeTypeStandard, Size: 5, Source: VolumeSourceNewVolume}},
+	}
+	_, _, err := c.WithScope(projectID, regionID).Instances.Create(ctx, request)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "Instances.Create", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+
+	attrs := spanAttributes(span)
+	assert.Equal(t, int64(projectID), attrs[AttributeProjectID].AsInt64())
+	assert.Equal(t, int64(regionID), attrs[AttributeRegionID].AsInt64())
+	assert.Equal(t, int64(http.StatusOK), attrs[semconv.HTTPResponseStatusCodeKey].AsInt64())
+	assert.Equal(t, int64(0), attrs[AttributeRetryAttempts].AsInt64())
+	assert.Equal(t, []string{taskID}, attrs[AttributeTaskIDs].AsStringSlice())
+	assert.Equal(t, "req-1", attrs[AttributeRequestID].AsString())
+
+	metrics := collectMetrics(t, reader)
+	duration, ok := metrics[metricRequestDuration].(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+	operation, _ := duration.DataPoints[0].Attributes.Value(AttributeOperation)
+	assert.Equal(t, "Instances.Create", operation.AsString())
+	assert.NotContains(t, metrics, metricRequestErrors)
+}
+
+func TestWithTelemetry_ErrorWithRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	URL := path.Join(instancesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID), testResourceID)
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = fmt.Fprint(w, `{"message": "unavailable"}`)
+	})
+
+	c, recorder, reader := setupTelemetry(t, client,
+		WithRetryAndBackoffs(RetryConfig{RetryMax: 2, RetryWaitMin: PtrTo(0.01), RetryWaitMax: PtrTo(0.01)}))
+
+	_, _, err := c.Instances.Get(ctx, testResourceID)
+	require.Error(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "Instances.Get", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+
+	attrs := spanAttributes(spans[0])
+	assert.Equal(t, int64(http.StatusServiceUnavailable), attrs[semconv.HTTPResponseStatusCodeKey].AsInt64())
+	assert.Equal(t, int64(2), attrs[AttributeRetryAttempts].AsInt64())
+
+	errorCount, ok := collectMetrics(t, reader)[metricRequestErrors].(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, errorCount.DataPoints, 1)
+	assert.Equal(t, int64(1), errorCount.DataPoints[0].Value)
+}
+
+func TestWithTelemetry_NotAServiceMethod(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {})
+
+	c, recorder, _ := setupTelemetry(t, client)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	req, err := c.NewRequest(ctx, http.MethodGet, "/foo", nil)
+	require.NoError(t, err)
+	_, err = c.Do(ctx, req, nil)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, http.MethodGet, spans[0].Name())
+}
+
+func TestClient_StartSpan(t *testing.T) {
+	_, span := NewClient(nil).StartSpan(ctx, "test")
+	assert.False(t, span.IsRecording())
+
+	c, recorder, _ := setupTelemetry(t, NewClient(nil))
+	spanCtx, span := c.StartSpan(ctx, "test", AttributeTaskIDs.StringSlice([]string{taskID}))
+	assert.True(t, span.IsRecording())
+	assert.Equal(t, span.SpanContext(), trace.SpanContextFromContext(spanCtx))
+	span.End()
+
+	require.Len(t, recorder.Ended(), 1)
+	assert.Equal(t, "test", recorder.Ended()[0].Name())
+}
+
+// TestServiceMethods_WithOperation checks that every service method taking a context names the operation
+// of its requests after itself.
+func TestServiceMethods_WithOperation(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	for _, file := range pkgs["edgecloud"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() || len(fn.Type.Params.List) == 0 {
+				continue
+			}
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			typeName, ok := recv.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			service, ok := strings.CutSuffix(typeName.Name, "ServiceOp")
+			if !ok {
+				continue
+			}
+			if param, ok := fn.Type.Params.List[0].Type.(*ast.SelectorExpr); !ok || param.Sel.Name != "Context" {
+				continue
+			}
+
+			name := service + "." + fn.Name.Name
+			expected := fmt.Sprintf("ctx = withOperation(ctx, %q)", name)
+			var actual string
+			if len(fn.Body.List) > 0 {
+				start, end := fset.Position(fn.Body.List[0].Pos()), fset.Position(fn.Body.List[0].End())
+				src, err := os.ReadFile(start.Filename)
+				require.NoError(t, err)
+				actual = string(src[start.Offset:end.Offset])
+			}
+			assert.Equal(t, expected, actual, "%s must name its operation first", name)
+		}
+	}
+}
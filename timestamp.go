@@ -0,0 +1,119 @@
+package edgecloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timestampLayouts are the layouts of the times of the API, without fractional seconds. The numeric zones
+// come before Z07:00, so that +00:00 is formatted back as it is rather than as Z.
+var timestampLayouts = []string{
+	"2006-01-02T15:04:05-07:00",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05-07:00",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05-0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Timestamp represents a time of the API. It is parsed from the formats the platform uses, with or without
+// a zone and fractional seconds, the times without a zone being in UTC. It is marshaled back in the format it was
+// parsed from, and in RFC 3339 if it was not parsed.
+//
+// A time in an unknown format does not fail the decoding of the response: the Timestamp is then zero
+// and keeps the value as is, which String returns.
+type Timestamp struct {
+	time.Time
+
+	layout string
+	raw    string
+}
+
+// NewTimestamp returns the Timestamp of the time.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t}
+}
+
+// ParseTimestamp parses a time of the API.
+func ParseTimestamp(value string) (Timestamp, error) {
+	for _, layout := range timestampLayouts {
+		layout = withFraction(layout, value)
+		if t, err := time.Parse(layout, value); err == nil {
+			return Timestamp{Time: t, layout: layout}, nil
+		}
+	}
+
+	return Timestamp{}, fmt.Errorf("parsing time %q: unknown format", value)
+}
+
+// withFraction adds to the layout the fractional seconds of the value, with as many digits.
+func withFraction(layout, value string) string {
+	const secondsEnd = len("2006-01-02T15:04:05")
+	if len(layout) < secondsEnd || len(value) <= secondsEnd || value[secondsEnd] != '.' {
+		return layout
+	}
+
+	digits := 0
+	for _, c := range value[secondsEnd+1:] {
+		if c < '0' || c > '9' {
+			break
+		}
+		digits++
+	}
+
+	return layout[:secondsEnd] + "." + strings.Repeat("0", digits) + layout[secondsEnd:]
+}
+
+// String returns the time in the format it was parsed from, or the value in an unknown format as is.
+func (t Timestamp) String() string {
+	if t.IsZero() {
+		return t.raw
+	}
+	if t.layout == "" {
+		return t.Time.Format(time.RFC3339Nano)
+	}
+
+	return t.Time.Format(t.layout)
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON parses the time, the empty string and null being the zero Timestamp.
+// A string in an unknown format is kept as is in a zero Timestamp.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == "" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	parsed, err := ParseTimestamp(value)
+	if err != nil {
+		*t = Timestamp{raw: value}
+		return nil
+	}
+	*t = parsed
+
+	return nil
+}
+
+// Equal reports whether the timestamps are the same instant, whatever their formats.
+func (t Timestamp) Equal(other Timestamp) bool {
+	return t.Time.Equal(other.Time)
+}
@@ -0,0 +1,79 @@
+package edgecloud
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimestamp_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"2024-08-06T17:40:32", time.Date(2024, 8, 6, 17, 40, 32, 0, time.UTC)},
+		{"2024-08-06T17:40:32Z", time.Date(2024, 8, 6, 17, 40, 32, 0, time.UTC)},
+		{"2024-08-06T17:40:32+0000", time.Date(2024, 8, 6, 17, 40, 32, 0, time.UTC)},
+		{"2024-08-06T20:40:32+03:00", time.Date(2024, 8, 6, 17, 40, 32, 0, time.UTC)},
+		{"2024-08-06T17:40:32.123456", time.Date(2024, 8, 6, 17, 40, 32, 123456000, time.UTC)},
+		{"2024-08-06T17:40:32.120000+00:00", time.Date(2024, 8, 6, 17, 40, 32, 120000000, time.UTC)},
+		{"2024-08-06 17:40:32.5+0000", time.Date(2024, 8, 6, 17, 40, 32, 500000000, time.UTC)},
+		{"2024-08-06 17:40:32", time.Date(2024, 8, 6, 17, 40, 32, 0, time.UTC)},
+		{"2024-08-06", time.Date(2024, 8, 6, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			var ts Timestamp
+			require.NoError(t, json.Unmarshal([]byte(`"`+tt.value+`"`), &ts))
+			assert.True(t, tt.expected.Equal(ts.Time), ts.Time)
+
+			data, err := json.Marshal(ts)
+			require.NoError(t, err)
+			assert.Equal(t, `"`+tt.value+`"`, string(data))
+		})
+	}
+}
+
+func TestTimestamp_Empty(t *testing.T) {
+	var task Task
+	require.NoError(t, json.Unmarshal([]byte(`{"created_on": "", "updated_on": null}`), &task))
+	assert.True(t, task.CreatedOn.IsZero())
+	assert.Nil(t, task.UpdatedOn)
+
+	data, err := json.Marshal(Timestamp{})
+	require.NoError(t, err)
+	assert.Equal(t, `""`, string(data))
+
+	data, err = json.Marshal(NewTimestamp(time.Date(2024, 8, 6, 17, 40, 32, 0, time.UTC)))
+	require.NoError(t, err)
+	assert.Equal(t, `"2024-08-06T17:40:32Z"`, string(data))
+}
+
+func TestTimestamp_Invalid(t *testing.T) {
+	var ts Timestamp
+	assert.Error(t, json.Unmarshal([]byte(`42`), &ts))
+
+	_, err := ParseTimestamp("yesterday")
+	assert.Error(t, err)
+
+	var task Task
+	require.NoError(t, json.Unmarshal([]byte(`{"created_on": "yesterday", "acknowledged_at": "2024-08-06T17:40:32"}`), &task))
+	assert.True(t, task.CreatedOn.IsZero())
+	assert.Equal(t, "yesterday", task.CreatedOn.String())
+	require.NotNil(t, task.AcknowledgedAt)
+
+	data, err := json.Marshal(task.CreatedOn)
+	require.NoError(t, err)
+	assert.Equal(t, `"yesterday"`, string(data))
+}
+
+func TestTimestamp_OmitEmpty(t *testing.T) {
+	data, err := json.Marshal(Task{})
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "acknowledged_at")
+	assert.NotContains(t, string(data), "updated_on")
+}
@@ -94,6 +94,8 @@ type AMQPSubscriptionCreateRequest struct {
 
 // ListLogSubscriptions get a list of user action log subscriptions.
 func (s *UserActionsServiceOp) ListLogSubscriptions(ctx context.Context) (*LogSubscriptions, *Response, error) {
+	ctx = withOperation(ctx, "UserActions.ListLogSubscriptions")
+
 	pathReq := fmt.Sprintf("%s/%s", userActionsBasePathV1, listLogSubscriptions)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, pathReq, nil)
@@ -112,6 +114,8 @@ func (s *UserActionsServiceOp) ListLogSubscriptions(ctx context.Context) (*LogSu
 
 // SubscribeLog subscribe to the user action log. Subscription is created for the current client.
 func (s *UserActionsServiceOp) SubscribeLog(ctx context.Context, reqBody *LogSubscriptionCreateRequest) (*Response, error) {
+	ctx = withOperation(ctx, "UserActions.SubscribeLog")
+
 	if reqBody == nil {
 		return nil, NewArgError("reqBody", "cannot be nil")
 	}
@@ -133,6 +137,8 @@ func (s *UserActionsServiceOp) SubscribeLog(ctx context.Context, reqBody *LogSub
 
 // UnsubscribeLog unsubscribe from a user action log.
 func (s *UserActionsServiceOp) UnsubscribeLog(ctx context.Context) (*Response, error) {
+	ctx = withOperation(ctx, "UserActions.UnsubscribeLog")
+
 	pathReq := fmt.Sprintf("%s/%s", userActionsBasePathV1, unsubscribeLog)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, pathReq, nil)
@@ -152,11 +158,15 @@ func (s *UserActionsServiceOp) UnsubscribeLog(ctx context.Context) (*Response, e
 //
 // Deprecated: Use ListAMQPSubscriptionsWithOpts instead.
 func (s *UserActionsServiceOp) ListAMQPSubscriptions(ctx context.Context) (*AMQPSubscriptions, *Response, error) {
+	ctx = withOperation(ctx, "UserActions.ListAMQPSubscriptions")
+
 	return s.ListAMQPSubscriptionsWithOpts(ctx, nil)
 }
 
 // ListAMQPSubscriptionsWithOpts get a list of AMQP user subscriptions.
 func (s *UserActionsServiceOp) ListAMQPSubscriptionsWithOpts(ctx context.Context, opts *UserActionsOpts) (*AMQPSubscriptions, *Response, error) {
+	ctx = withOperation(ctx, "UserActions.ListAMQPSubscriptionsWithOpts")
+
 	reqURL, err := s.buildRequestURL(listAMQPSubscriptions, opts)
 	if err != nil {
 		return nil, nil, err
@@ -180,11 +190,15 @@ func (s *UserActionsServiceOp) ListAMQPSubscriptionsWithOpts(ctx context.Context
 //
 // Deprecated: Use SubscribeAMQPWithOpts instead.
 func (s *UserActionsServiceOp) SubscribeAMQP(ctx context.Context, reqBody *AMQPSubscriptionCreateRequest) (*Response, error) {
+	ctx = withOperation(ctx, "UserActions.SubscribeAMQP")
+
 	return s.SubscribeAMQPWithOpts(ctx, nil, reqBody)
 }
 
 // SubscribeAMQPWithOpts subscribe to the user action log over AMQP. Subscription is created for the current client.
 func (s *UserActionsServiceOp) SubscribeAMQPWithOpts(ctx context.Context, opts *UserActionsOpts, reqBody *AMQPSubscriptionCreateRequest) (*Response, error) {
+	ctx = withOperation(ctx, "UserActions.SubscribeAMQPWithOpts")
+
 	if reqBody == nil {
 		return nil, NewArgError("reqBody", "cannot be nil")
 	}
@@ -211,11 +225,15 @@ func (s *UserActionsServiceOp) SubscribeAMQPWithOpts(ctx context.Context, opts *
 //
 // Deprecated: Use UnsubscribeAMQPWithOpts instead.
 func (s *UserActionsServiceOp) UnsubscribeAMQP(ctx context.Context) (*Response, error) {
+	ctx = withOperation(ctx, "UserActions.UnsubscribeAMQP")
+
 	return s.UnsubscribeAMQPWithOpts(ctx, nil)
 }
 
 // UnsubscribeAMQPWithOpts unsubscribe from the user action log over AMQP.
 func (s *UserActionsServiceOp) UnsubscribeAMQPWithOpts(ctx context.Context, opts *UserActionsOpts) (*Response, error) {
+	ctx = withOperation(ctx, "UserActions.UnsubscribeAMQPWithOpts")
+
 	reqURL, err := s.buildRequestURL(unsubscribeAMQP, opts)
 	if err != nil {
 		return nil, err
@@ -90,6 +90,8 @@ type roleAssignmentsRoot struct {
 
 // List get client’s users.
 func (s *UsersServiceOp) List(ctx context.Context, opts *UserListOptions) ([]User, *Response, error) {
+	ctx = withOperation(ctx, "Users.List")
+
 	userPath, err := addOptions(usersBasePathV1, opts)
 	if err != nil {
 		return nil, nil, err
@@ -111,6 +113,8 @@ func (s *UsersServiceOp) List(ctx context.Context, opts *UserListOptions) ([]Use
 
 // ListRoles get available roles.
 func (s *UsersServiceOp) ListRoles(ctx context.Context, opts *UserRoleListOptions) ([]UserRole, *Response, error) {
+	ctx = withOperation(ctx, "Users.ListRoles")
+
 	userPath, err := addOptions(path.Join(usersBasePathV1, usersRoles), opts)
 	if err != nil {
 		return nil, nil, err
@@ -132,6 +136,8 @@ func (s *UsersServiceOp) ListRoles(ctx context.Context, opts *UserRoleListOption
 
 // ListAssignment get available assignment roles.
 func (s *UsersServiceOp) ListAssignment(ctx context.Context, opts *UserRoleListOptions) ([]RoleAssignment, *Response, error) {
+	ctx = withOperation(ctx, "Users.ListAssignment")
+
 	userPath, err := addOptions(path.Join(usersBasePathV1, usersAssignments), opts)
 	if err != nil {
 		return nil, nil, err
@@ -153,6 +159,8 @@ func (s *UsersServiceOp) ListAssignment(ctx context.Context, opts *UserRoleListO
 
 // DeleteAssignment deletes a role assignment.
 func (s *UsersServiceOp) DeleteAssignment(ctx context.Context, assignmentID int) (*Response, error) {
+	ctx = withOperation(ctx, "Users.DeleteAssignment")
+
 	assignmentsPath := fmt.Sprintf("%s/%s/%d", usersBasePathV1, usersAssignments, assignmentID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, assignmentsPath, nil)
@@ -165,10 +173,16 @@ func (s *UsersServiceOp) DeleteAssignment(ctx context.Context, assignmentID int)
 
 // UpdateAssignment updates a role assignment.
 func (s *UsersServiceOp) UpdateAssignment(ctx context.Context, assignmentID int, reqBody *UpdateAssignmentRequest) (*Response, error) {
+	ctx = withOperation(ctx, "Users.UpdateAssignment")
+
 	if reqBody == nil {
 		return nil, NewArgError("reqBody", "cannot be nil")
 	}
 
+	if err := validateRequest(reqBody); err != nil {
+		return nil, err
+	}
+
 	assignmentsPath := fmt.Sprintf("%s/%s/%d", usersBasePathV1, usersAssignments, assignmentID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, assignmentsPath, reqBody)
@@ -181,6 +195,8 @@ func (s *UsersServiceOp) UpdateAssignment(ctx context.Context, assignmentID int,
 
 // AssignRole to an existing user.
 func (s *UsersServiceOp) AssignRole(ctx context.Context, reqBody *UpdateAssignmentRequest) (*UserRole, *Response, error) {
+	ctx = withOperation(ctx, "Users.AssignRole")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
@@ -0,0 +1,123 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const resourcePollInterval = 2 * time.Second
+
+var (
+	// ErrResourceFailed is returned when a created resource ends in an error status.
+	ErrResourceFailed = errors.New("resource ended in an error status")
+
+	errNotSingleResource = errors.New("the task result does not contain a single created resource")
+)
+
+// StableFunc reports whether the resource reached its stable status. It returns an error wrapping
+// ErrResourceFailed if the resource ended in an error status instead.
+type StableFunc[T any] func(resource *T) (bool, error)
+
+// CreateAndGet calls the create function, waits for its task to complete, then gets the created resource
+// and waits for it to be stable. createdIDs returns the IDs of the created resources of the kind from
+// the task result, the request must create a single one.
+//
+// The timeout, a minute by default or the deadline of the context, covers the whole creation.
+func CreateAndGet[Req, T any](
+	ctx context.Context, client *edgecloud.Client, create TaskAPIFunc[Req], req Req,
+	createdIDs func(result *TaskResult) []string, get GetResourceFunc[T], stable StableFunc[T],
+	timeouts ...time.Duration,
+) (*T, error) {
+	ctx, cancel := contextWithTimeout(ctx, timeouts...)
+	defer cancel()
+
+	result, err := ExecuteAndExtractTaskResult(ctx, create, req, client, timeouts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := createdIDs(result)
+	if len(ids) != 1 {
+		return nil, fmt.Errorf("%w: %v", errNotSingleResource, ids)
+	}
+
+	return waitResourceStable(ctx, get, ids[0], stable, resourcePollInterval)
+}
+
+// CreateInstanceAndGet creates the instance and returns it once it is ACTIVE.
+func CreateInstanceAndGet(ctx context.Context, client *edgecloud.Client, req *edgecloud.InstanceCreateRequest, timeouts ...time.Duration) (*edgecloud.Instance, error) {
+	return CreateAndGet(ctx, client, client.Instances.Create, req,
+		func(result *TaskResult) []string { return result.Instances },
+		client.Instances.Get,
+		func(instance *edgecloud.Instance) (bool, error) {
+			return statusReached(instance.Status, edgecloud.InstanceStatusActive)
+		},
+		timeouts...)
+}
+
+// CreateLoadbalancerAndGet creates the loadbalancer and returns it once its provisioning status is ACTIVE.
+func CreateLoadbalancerAndGet(ctx context.Context, client *edgecloud.Client, req *edgecloud.LoadbalancerCreateRequest, timeouts ...time.Duration) (*edgecloud.Loadbalancer, error) {
+	return CreateAndGet(ctx, client, client.Loadbalancers.Create, req,
+		func(result *TaskResult) []string { return result.Loadbalancers },
+		client.Loadbalancers.Get,
+		func(lb *edgecloud.Loadbalancer) (bool, error) {
+			return statusReached(lb.ProvisioningStatus, edgecloud.ProvisioningStatusActive)
+		},
+		timeouts...)
+}
+
+// CreateVolumeAndGet creates the volume and returns it once it is available, or in-use if it is attached
+// to an instance on creation.
+func CreateVolumeAndGet(ctx context.Context, client *edgecloud.Client, req *edgecloud.VolumeCreateRequest, timeouts ...time.Duration) (*edgecloud.Volume, error) {
+	return CreateAndGet(ctx, client, client.Volumes.Create, req,
+		func(result *TaskResult) []string { return result.Volumes },
+		client.Volumes.Get,
+		func(volume *edgecloud.Volume) (bool, error) {
+			if volume.Status == edgecloud.VolumeStatusInUse {
+				return true, nil
+			}
+
+			return statusReached(volume.Status, edgecloud.VolumeStatusAvailable)
+		},
+		timeouts...)
+}
+
+// CreateNetworkAndGet creates the network and returns it. A network has no status, so it is returned
+// as soon as its task completes.
+func CreateNetworkAndGet(ctx context.Context, client *edgecloud.Client, req *edgecloud.NetworkCreateRequest, timeouts ...time.Duration) (*edgecloud.Network, error) {
+	return CreateAndGet(ctx, client, client.Networks.Create, req,
+		func(result *TaskResult) []string { return result.Networks },
+		client.Networks.Get,
+		func(*edgecloud.Network) (bool, error) { return true, nil },
+		timeouts...)
+}
+
+// waitResourceStable gets the resource at the interval until it is stable or the context is done.
+func waitResourceStable[T any](ctx context.Context, get GetResourceFunc[T], id string, stable StableFunc[T], interval time.Duration) (*T, error) {
+	for {
+		resource, _, err := get(ctx, id)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			return nil, err
+		}
+
+		ok, err := stable(resource)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+		if ok {
+			return resource, nil
+		}
+
+		if err := sleep(ctx, interval); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/Edge-Center/edgecentercloud-go/v2/edgecloudtest"
+)
+
+func TestCreateAndGet(t *testing.T) {
+	ctx := context.Background()
+	server := edgecloudtest.NewServer(edgecloudtest.WithTaskPolls(0))
+	t.Cleanup(server.Close)
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	network, err := CreateNetworkAndGet(ctx, client, &edgecloud.NetworkCreateRequest{Name: testName})
+	require.NoError(t, err)
+	assert.Equal(t, testName, network.Name)
+
+	volume, err := CreateVolumeAndGet(ctx, client, &edgecloud.VolumeCreateRequest{
+		Name:     testName,
+		Size:     10,
+		Source:   edgecloud.VolumeSourceNewVolume,
+		TypeName: edgecloud.VolumeTypeStandard,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.VolumeStatusAvailable, volume.Status)
+
+	instance, err := CreateInstanceAndGet(ctx, client, &edgecloud.InstanceCreateRequest{
+		Names:      []string{testName},
+		Flavor:     "g1-standard-1-2",
+		Interfaces: []edgecloud.InstanceInterface{{Type: edgecloud.InterfaceTypeExternal}},
+		Volumes: []edgecloud.InstanceVolumeCreate{{
+			Source:    edgecloud.VolumeSourceExistingVolume,
+			VolumeID:  volume.ID,
+			BootIndex: edgecloud.PtrTo(0),
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.InstanceStatusActive, instance.Status)
+
+	lb, err := CreateLoadbalancerAndGet(ctx, client, &edgecloud.LoadbalancerCreateRequest{
+		Name: testName,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.ProvisioningStatusActive, lb.ProvisioningStatus)
+
+	_, err = CreateInstanceAndGet(ctx, client, &edgecloud.InstanceCreateRequest{
+		Names:      []string{testName, testName},
+		Flavor:     "g1-standard-1-2",
+		Interfaces: []edgecloud.InstanceInterface{{Type: edgecloud.InterfaceTypeExternal}},
+		Volumes: []edgecloud.InstanceVolumeCreate{{
+			Source:    edgecloud.VolumeSourceImage,
+			ImageID:   testResourceID,
+			Size:      10,
+			BootIndex: edgecloud.PtrTo(0),
+		}},
+	})
+	assert.ErrorIs(t, err, errNotSingleResource)
+}
+
+func TestWaitResourceStable_Failed(t *testing.T) {
+	statuses := []edgecloud.VolumeStatus{edgecloud.VolumeStatusCreating, edgecloud.VolumeStatusError}
+	polls := 0
+	get := func(ctx context.Context, id string) (*edgecloud.Volume, *edgecloud.Response, error) {
+		volume := &edgecloud.Volume{ID: id, Status: statuses[polls]}
+		polls++
+
+		return volume, nil, nil
+	}
+	stable := func(volume *edgecloud.Volume) (bool, error) {
+		return statusReached(volume.Status, edgecloud.VolumeStatusAvailable)
+	}
+
+	_, err := waitResourceStable(context.Background(), get, testResourceID, stable, time.Millisecond)
+	assert.ErrorIs(t, err, ErrResourceFailed)
+	assert.EqualError(t, err, testResourceID+": resource ended in an error status: error")
+	assert.Equal(t, 2, polls)
+}
@@ -10,7 +10,8 @@ import (
 )
 
 const (
-	DBaaSClusterHealthyStatus = "HEALTHY"
+	// Deprecated: use edgecloud.DBaaSClusterStatusHealthy instead.
+	DBaaSClusterHealthyStatus = edgecloud.DBaaSClusterStatusHealthy
 	DBaaSBackupFinishedStatus = "FINISHED"
 	dbaasClusterPollInterval  = 5 * time.Second
 	dbaasBackupPollInterval   = 5 * time.Second
@@ -100,34 +101,24 @@ func WaitDBaaSClusterByCreatorTaskID(ctx context.Context, client *edgecloud.Clie
 	}
 }
 
+// WaitDBaaSClusterStatusHealthy waits for the cluster to be HEALTHY. It returns ErrDBaaSClusterNotReady
+// if the timeout is reached first.
+//
+// Deprecated: use WaitForStatus with edgecloud.DBaaSClusterStatusHealthy instead.
 func WaitDBaaSClusterStatusHealthy(ctx context.Context, client *edgecloud.Client, clusterID string, timeouts ...time.Duration) (*edgecloud.DBaaSCluster, error) {
 	timeout := defaultTimeout
 	if len(timeouts) > 0 {
 		timeout = timeouts[0]
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
-
-	ticker := time.NewTicker(dbaasClusterPollInterval)
-	defer ticker.Stop()
-
-	for {
-		cluster, _, err := client.DBaaS.ClusterGet(ctx, clusterID)
-		if err != nil {
-			return nil, err
-		}
-
-		if cluster.Status == DBaaSClusterHealthyStatus {
-			return cluster, nil
-		}
-
-		select {
-		case <-ctx.Done():
-			return nil, ErrDBaaSClusterNotReady
-		case <-ticker.C:
-		}
+	cluster, err := WaitForStatus(ctx, client.DBaaS.ClusterGet, clusterID,
+		func(cluster *edgecloud.DBaaSCluster) edgecloud.DBaaSClusterStatus { return cluster.Status },
+		edgecloud.DBaaSClusterStatusHealthy, timeout)
+	if errors.Is(err, ErrStatusNotReached) {
+		return nil, ErrDBaaSClusterNotReady
 	}
+
+	return cluster, err
 }
 
 func CreateDBaaSBackupAndWait(ctx context.Context, client *edgecloud.Client, req edgecloud.DBaaSBackupCreateRequest, timeouts ...time.Duration) (*edgecloud.DBaaSBackup, error) {
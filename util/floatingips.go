@@ -33,6 +33,9 @@ func FloatingIPsListByPortID(ctx context.Context, client *edgecloud.Client, port
 	return FloatingIPs, nil
 }
 
+// FloatingIPDetailedByIPAddress returns the floating IP with the address.
+//
+// Deprecated: use Resolver.FloatingIP with an ip: reference instead.
 func FloatingIPDetailedByIPAddress(ctx context.Context, client *edgecloud.Client, floatingIPAddress string) (*edgecloud.FloatingIP, error) {
 	fips, _, err := client.Floatingips.List(ctx)
 	if err != nil {
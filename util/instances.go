@@ -8,7 +8,8 @@ import (
 	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
 )
 
-const InstanceShutoffStatus = "SHUTOFF"
+// Deprecated: use edgecloud.InstanceStatusShutoff instead.
+const InstanceShutoffStatus = edgecloud.InstanceStatusShutoff
 
 var (
 	ErrInstanceNotShutOff        = errors.New("the instance is not shut off")
@@ -16,6 +17,9 @@ var (
 	ErrInstancePortNotFound      = errors.New("instance port not found")
 )
 
+// WaitForInstanceShutoff stops the instance and waits for it to be SHUTOFF.
+//
+// Deprecated: stop the instance and use WaitForStatus with edgecloud.InstanceStatusShutoff instead.
 func WaitForInstanceShutoff(ctx context.Context, client *edgecloud.Client, instanceID string, attempts *uint) error {
 	_, _, err := client.Instances.InstanceStop(ctx, instanceID)
 	if err != nil {
@@ -31,6 +31,9 @@ func L7PoliciesListByListenerID(ctx context.Context, client *edgecloud.Client, l
 	return L7Polices, nil
 }
 
+// GetLbL7PolicyFromName returns the single L7 policy with the name.
+//
+// Deprecated: use Resolver.L7Policy with a name: reference instead.
 func GetLbL7PolicyFromName(ctx context.Context, client *edgecloud.Client, name string) (*edgecloud.L7Policy, error) {
 	allPolicies, _, err := client.L7Policies.List(ctx)
 	if err != nil {
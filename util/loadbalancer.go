@@ -18,6 +18,9 @@ var (
 	ErrNotActiveStatus                 = errors.New("waiting for Active status")
 )
 
+// LoadbalancerGetByName returns the single loadbalancer with the name.
+//
+// Deprecated: use Resolver.Loadbalancer with a name: reference instead.
 func LoadbalancerGetByName(ctx context.Context, client *edgecloud.Client, name string) (*edgecloud.Loadbalancer, error) {
 	var matchedLBs []edgecloud.Loadbalancer
 
@@ -44,6 +47,9 @@ func LoadbalancerGetByName(ctx context.Context, client *edgecloud.Client, name s
 	}
 }
 
+// LBListenerGetByName returns the single listener of the loadbalancer with the name.
+//
+// Deprecated: use Resolver.ListenerOf with a name: reference instead.
 func LBListenerGetByName(ctx context.Context, client *edgecloud.Client, name, loadBalancerID string) (*edgecloud.Listener, error) {
 	var matchedLBListeners []edgecloud.Listener
 
@@ -73,6 +79,9 @@ func LBListenerGetByName(ctx context.Context, client *edgecloud.Client, name, lo
 	}
 }
 
+// LBPoolGetByName returns the single pool of the loadbalancer with the name.
+//
+// Deprecated: use Resolver.PoolOf with a name: reference instead.
 func LBPoolGetByName(ctx context.Context, client *edgecloud.Client, name, loadBalancerID string) (*edgecloud.Pool, error) {
 	var matchedLBPools []edgecloud.Pool
 
@@ -0,0 +1,440 @@
+package util
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// The kinds of the operations, which identify how their resources are got when they are resumed.
+const (
+	OperationKindInstanceCreate     = "instance_create"
+	OperationKindLoadbalancerCreate = "loadbalancer_create"
+	OperationKindMKaaSClusterCreate = "mkaas_cluster_create"
+	OperationKindDBaaSClusterCreate = "dbaas_cluster_create"
+)
+
+var (
+	// ErrOperationPending is returned by Operation.Result while the operation is not done.
+	ErrOperationPending = errors.New("operation is pending")
+
+	errOperationKindUnknown = errors.New("unknown operation kind")
+	errOperationKindType    = errors.New("operation kind has another resource type")
+)
+
+// operationKind gets the resource of an operation of the kind once its tasks complete.
+type operationKind[T any] struct {
+	// resolve gets the resource from the resources created by the tasks.
+	resolve func(ctx context.Context, client *edgecloud.Client, result *TaskResult, taskIDs []string) (*T, error)
+	stable  StableFunc[T]
+}
+
+// operationKinds maps the kinds of the operations to their operationKind, of their resource type.
+var operationKinds = map[string]interface{}{
+	OperationKindInstanceCreate: operationKind[edgecloud.Instance]{
+		resolve: func(ctx context.Context, client *edgecloud.Client, result *TaskResult, _ []string) (*edgecloud.Instance, error) {
+			return getSingleResource(ctx, client.Instances.Get, result.Instances)
+		},
+		stable: func(instance *edgecloud.Instance) (bool, error) {
+			return statusReached(instance.Status, edgecloud.InstanceStatusActive)
+		},
+	},
+	OperationKindLoadbalancerCreate: operationKind[edgecloud.Loadbalancer]{
+		resolve: func(ctx context.Context, client *edgecloud.Client, result *TaskResult, _ []string) (*edgecloud.Loadbalancer, error) {
+			return getSingleResource(ctx, client.Loadbalancers.Get, result.Loadbalancers)
+		},
+		stable: func(lb *edgecloud.Loadbalancer) (bool, error) {
+			return statusReached(lb.ProvisioningStatus, edgecloud.ProvisioningStatusActive)
+		},
+	},
+	OperationKindMKaaSClusterCreate: operationKind[edgecloud.MKaaSCluster]{
+		resolve: func(ctx context.Context, client *edgecloud.Client, result *TaskResult, _ []string) (*edgecloud.MKaaSCluster, error) {
+			ids := make([]string, 0, len(result.MkaasClusters))
+			for _, id := range result.MkaasClusters {
+				ids = append(ids, strconv.Itoa(id))
+			}
+
+			return getSingleResource(ctx, func(ctx context.Context, id string) (*edgecloud.MKaaSCluster, *edgecloud.Response, error) {
+				clusterID, _ := strconv.Atoi(id)
+
+				return client.MkaaS.ClusterGet(ctx, clusterID)
+			}, ids)
+		},
+		stable: func(cluster *edgecloud.MKaaSCluster) (bool, error) {
+			if cluster.Status.IsError() {
+				return false, fmt.Errorf("%w: %s", ErrResourceFailed, cluster.Status)
+			}
+
+			return !cluster.Processing, nil
+		},
+	},
+	OperationKindDBaaSClusterCreate: operationKind[edgecloud.DBaaSCluster]{
+		resolve: func(ctx context.Context, client *edgecloud.Client, result *TaskResult, taskIDs []string) (*edgecloud.DBaaSCluster, error) {
+			if len(result.DbaasClusters) > 0 {
+				return getSingleResource(ctx, client.DBaaS.ClusterGet, result.DbaasClusters)
+			}
+
+			// the tasks do not always report the created cluster, which is then found by its creator task
+			return dbaasClusterGetByCreatorTaskIDs(ctx, client, taskIDs)
+		},
+		stable: func(cluster *edgecloud.DBaaSCluster) (bool, error) {
+			return statusReached(cluster.Status, edgecloud.DBaaSClusterStatusHealthy)
+		},
+	},
+}
+
+// OperationState is the serializable state of an Operation, from which it can be resumed.
+type OperationState struct {
+	Kind    string   `json:"kind"`
+	TaskIDs []string `json:"task_ids"`
+
+	// ProjectID and RegionID are the scope of the operation, in which it is resumed whatever the scope of the client.
+	ProjectID int `json:"project_id,omitempty"`
+	RegionID  int `json:"region_id,omitempty"`
+}
+
+// Operation is an asynchronous API call creating a resource of type T. It is done once the tasks of the call
+// complete and the resource reaches its stable status.
+//
+// An Operation is marshaled to JSON as its OperationState, so that a process can persist its pending operations
+// and resume waiting for them with ResumeOperation after a restart.
+type Operation[T any] struct {
+	client *edgecloud.Client
+	kind   string
+	spec   operationKind[T]
+	// scope is the project and region of the tasks and the resource.
+	scope edgecloud.Scope
+	// waiter gives the intervals between the polls of Wait.
+	waiter *TaskWaiter
+
+	mu      sync.Mutex
+	taskIDs []string
+	tasks   []*edgecloud.Task
+	done    bool
+	result  *T
+	err     error
+}
+
+// NewOperation returns the operation of the kind with the tasks, e.g. the tasks of a TaskResponse, in the project
+// and region of the client. It returns an error if the kind is unknown or has another resource type than T.
+func NewOperation[T any](client *edgecloud.Client, kind string, taskIDs []string) (*Operation[T], error) {
+	k, ok := operationKinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errOperationKindUnknown, kind)
+	}
+	spec, ok := k.(operationKind[T])
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errOperationKindType, kind)
+	}
+	if len(taskIDs) == 0 {
+		return nil, errNoTasks
+	}
+
+	return &Operation[T]{
+		client:  client,
+		kind:    kind,
+		spec:    spec,
+		scope:   scopeOf(context.Background(), client),
+		waiter:  NewTaskWaiter(client),
+		taskIDs: taskIDs,
+		tasks:   make([]*edgecloud.Task, len(taskIDs)),
+	}, nil
+}
+
+// ResumeOperation returns the operation of the state marshaled to JSON, in the project and region of the state,
+// or of the client if the state has none.
+func ResumeOperation[T any](client *edgecloud.Client, data []byte) (*Operation[T], error) {
+	var state OperationState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+
+	op, err := NewOperation[T](client, state.Kind, state.TaskIDs)
+	if err != nil {
+		return nil, err
+	}
+	op.scope.Project = cmp.Or(state.ProjectID, op.scope.Project)
+	op.scope.Region = cmp.Or(state.RegionID, op.scope.Region)
+
+	return op, nil
+}
+
+// ID returns the ID of the first task of the operation.
+func (o *Operation[T]) ID() string {
+	return o.taskIDs[0]
+}
+
+// TaskIDs returns the IDs of the tasks of the operation.
+func (o *Operation[T]) TaskIDs() []string {
+	return append([]string(nil), o.taskIDs...)
+}
+
+// State returns the serializable state of the operation.
+func (o *Operation[T]) State() OperationState {
+	return OperationState{Kind: o.kind, TaskIDs: o.TaskIDs(), ProjectID: o.scope.Project, RegionID: o.scope.Region}
+}
+
+func (o *Operation[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.State())
+}
+
+// Done reports whether the operation is done, successfully or not.
+func (o *Operation[T]) Done() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.done
+}
+
+// Result returns the resource of the done operation or its error. It returns ErrOperationPending
+// if the operation is not done.
+func (o *Operation[T]) Result() (*T, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !o.done {
+		return nil, ErrOperationPending
+	}
+
+	return o.result, o.err
+}
+
+// Poll gets the tasks of the operation that are not complete yet, or its resource once they are,
+// and reports whether the operation is done. The requests are made in the project and region of the operation.
+// The errors of the requests do not end the operation, unlike a failed task or resource, which are returned by Result.
+func (o *Operation[T]) Poll(ctx context.Context) (bool, error) {
+	ctx = edgecloud.ContextWithScope(ctx, o.scope.Project, o.scope.Region)
+
+	// the requests are made without holding the lock, so that Done and Result do not wait for them
+	pending, done := o.pendingTasks()
+	if done {
+		return true, nil
+	}
+
+	complete := true
+	for _, i := range pending {
+		task, _, err := o.client.Tasks.Get(ctx, o.taskIDs[i])
+		if err != nil {
+			return false, err
+		}
+		if o.recordTask(i, task) {
+			return true, nil
+		}
+		complete = complete && task.State == edgecloud.TaskStateFinished
+	}
+	if !complete {
+		return false, nil
+	}
+
+	tasks, done := o.completeTasks()
+	if done {
+		return true, nil
+	}
+	result, err := ExtractTaskResultFromTasks(tasks...)
+	if err != nil {
+		o.finish(nil, err)
+		return true, nil
+	}
+	resource, err := o.spec.resolve(ctx, o.client, result, o.taskIDs)
+	switch {
+	case errors.Is(err, errNotSingleResource):
+		o.finish(nil, err)
+		return true, nil
+	case errors.Is(err, ErrDBaaSClustersNotFound):
+		// the resource is not listed yet
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	stable, err := o.spec.stable(resource)
+	switch {
+	case err != nil:
+		o.finish(nil, err)
+	case stable:
+		o.finish(resource, nil)
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// pendingTasks returns the indexes of the tasks that are not complete yet, or true if the operation is done.
+func (o *Operation[T]) pendingTasks() ([]int, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.done {
+		return nil, true
+	}
+
+	var pending []int
+	for i, task := range o.tasks {
+		if task == nil {
+			pending = append(pending, i)
+		}
+	}
+
+	return pending, false
+}
+
+// recordTask records the task got by Poll and reports whether the operation is done.
+func (o *Operation[T]) recordTask(i int, task *edgecloud.Task) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.done {
+		return true
+	}
+
+	switch task.State {
+	case edgecloud.TaskStateFinished:
+		o.tasks[i] = task
+	case edgecloud.TaskStateError:
+		o.done, o.err = true, &TaskFailedError{Task: task}
+	case edgecloud.TaskStateNew, edgecloud.TaskStateRunning:
+	default:
+		o.done, o.err = true, fmt.Errorf("%w: [%s]", errTaskStateUnknown, task.State)
+	}
+
+	return o.done
+}
+
+// completeTasks returns the complete tasks of the operation, or true if the operation is done.
+func (o *Operation[T]) completeTasks() ([]*edgecloud.Task, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return slices.Clone(o.tasks), o.done
+}
+
+// Wait polls the operation at the intervals of a TaskWaiter until it is done and returns its result.
+// It returns the error of the context if it is done first, or the error of a poll if too many of them fail.
+func (o *Operation[T]) Wait(ctx context.Context) (*T, error) {
+	failures := 0
+	for poll := 0; ; poll++ {
+		if poll > 0 {
+			if err := sleep(ctx, o.waiter.interval(poll-1)); err != nil {
+				return nil, err
+			}
+		}
+
+		done, err := o.Poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if failures++; failures > o.waiter.maxFailures() {
+				return nil, err
+			}
+
+			continue
+		}
+		failures = 0
+
+		if done {
+			return o.Result()
+		}
+	}
+}
+
+// finish ends the operation with the result, unless a concurrent Poll has ended it first.
+func (o *Operation[T]) finish(result *T, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !o.done {
+		o.done, o.result, o.err = true, result, err
+	}
+}
+
+// CreateInstanceAsync starts creating the instance. The operation is done once the instance is ACTIVE.
+func CreateInstanceAsync(ctx context.Context, client *edgecloud.Client, req *edgecloud.InstanceCreateRequest) (*Operation[edgecloud.Instance], error) {
+	return startOperation[edgecloud.Instance](ctx, client, OperationKindInstanceCreate, client.Instances.Create, req)
+}
+
+// CreateLoadbalancerAsync starts creating the loadbalancer. The operation is done once its provisioning status
+// is ACTIVE.
+func CreateLoadbalancerAsync(ctx context.Context, client *edgecloud.Client, req *edgecloud.LoadbalancerCreateRequest) (*Operation[edgecloud.Loadbalancer], error) {
+	return startOperation[edgecloud.Loadbalancer](ctx, client, OperationKindLoadbalancerCreate, client.Loadbalancers.Create, req)
+}
+
+// CreateMKaaSClusterAsync starts creating the MKaaS cluster. The operation is done once the cluster
+// is not processing anymore.
+func CreateMKaaSClusterAsync(ctx context.Context, client *edgecloud.Client, req edgecloud.MKaaSClusterCreateRequest) (*Operation[edgecloud.MKaaSCluster], error) {
+	return startOperation[edgecloud.MKaaSCluster](ctx, client, OperationKindMKaaSClusterCreate, client.MkaaS.ClusterCreate, req)
+}
+
+// CreateDBaaSClusterAsync starts creating the DBaaS cluster. The operation is done once the cluster is HEALTHY.
+func CreateDBaaSClusterAsync(ctx context.Context, client *edgecloud.Client, req edgecloud.DBaaSClusterCreateRequest) (*Operation[edgecloud.DBaaSCluster], error) {
+	return startOperation[edgecloud.DBaaSCluster](ctx, client, OperationKindDBaaSClusterCreate, client.DBaaS.ClusterCreate, req)
+}
+
+func startOperation[T, Req any](ctx context.Context, client *edgecloud.Client, kind string, apiFunc TaskAPIFunc[Req], req Req) (*Operation[T], error) {
+	task, _, err := apiFunc(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := NewOperation[T](client, kind, task.Tasks)
+	if err != nil {
+		return nil, err
+	}
+	op.scope = scopeOf(ctx, client)
+
+	return op, nil
+}
+
+// getSingleResource gets the resource of the only ID.
+func getSingleResource[T any](ctx context.Context, get GetResourceFunc[T], ids []string) (*T, error) {
+	if len(ids) != 1 {
+		return nil, fmt.Errorf("%w: %v", errNotSingleResource, ids)
+	}
+	resource, _, err := get(ctx, ids[0])
+
+	return resource, err
+}
+
+// dbaasClusterGetByCreatorTaskIDs returns the DBaaS cluster created by one of the tasks. The clusters are matched
+// on the creator task of the list, and got one by one only if the list does not report it.
+func dbaasClusterGetByCreatorTaskIDs(ctx context.Context, client *edgecloud.Client, taskIDs []string) (*edgecloud.DBaaSCluster, error) {
+	clusters, err := edgecloud.NewPager(func(ctx context.Context, limit, offset int) ([]edgecloud.DBaaSCluster, *edgecloud.Response, error) {
+		return client.DBaaS.ClustersList(ctx, &edgecloud.DBaaSClusterListOptions{Limit: limit, Offset: offset})
+	}, 0).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range clusters {
+		cluster := &clusters[i]
+		if cluster.CreatorTaskID == "" {
+			if cluster, _, err = client.DBaaS.ClusterGet(ctx, cluster.ID); err != nil {
+				return nil, err
+			}
+		}
+		if slices.Contains(taskIDs, cluster.CreatorTaskID) {
+			return cluster, nil
+		}
+	}
+
+	return nil, ErrDBaaSClustersNotFound
+}
+
+// scopeOf returns the project and region of the requests made by the client with ctx.
+func scopeOf(ctx context.Context, client *edgecloud.Client) edgecloud.Scope {
+	scope := edgecloud.Scope{Project: client.Project, Region: client.Region}
+	if ctxScope, ok := edgecloud.ScopeFromContext(ctx); ok {
+		scope.Project = cmp.Or(ctxScope.Project, scope.Project)
+		scope.Region = cmp.Or(ctxScope.Region, scope.Region)
+	}
+
+	return scope
+}
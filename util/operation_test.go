@@ -0,0 +1,145 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/Edge-Center/edgecentercloud-go/v2/edgecloudtest"
+)
+
+func TestOperation(t *testing.T) {
+	ctx := context.Background()
+	server := edgecloudtest.NewServer(edgecloudtest.WithTaskPolls(1))
+	t.Cleanup(server.Close)
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	op, err := CreateLoadbalancerAsync(ctx, client, &edgecloud.LoadbalancerCreateRequest{Name: testName})
+	require.NoError(t, err)
+	done, err := op.Poll(ctx)
+	require.NoError(t, err)
+	assert.False(t, done)
+	assert.False(t, op.Done())
+	_, err = op.Result()
+	assert.ErrorIs(t, err, ErrOperationPending)
+
+	data, err := json.Marshal(op)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind": "loadbalancer_create", "task_ids": ["`+op.ID()+`"], "project_id": 1, "region_id": 1}`, string(data))
+
+	// the operation is resumed in its scope by a client of another one
+	resumed, err := ResumeOperation[edgecloud.Loadbalancer](client.WithScope(edgecloudtest.ProjectID+1, edgecloudtest.RegionID), data)
+	require.NoError(t, err)
+	resumed.waiter = newTestTaskWaiter(client)
+	lb, err := resumed.Wait(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, testName, lb.Name)
+	assert.Equal(t, edgecloud.ProvisioningStatusActive, lb.ProvisioningStatus)
+	assert.True(t, resumed.Done())
+	result, err := resumed.Result()
+	require.NoError(t, err)
+	assert.Equal(t, lb, result)
+
+	_, err = ResumeOperation[edgecloud.Instance](client, data)
+	assert.ErrorIs(t, err, errOperationKindType)
+	_, err = NewOperation[edgecloud.Instance](client, "unknown", op.TaskIDs())
+	assert.ErrorIs(t, err, errOperationKindUnknown)
+}
+
+func TestOperation_TaskFailed(t *testing.T) {
+	ctx := context.Background()
+	server := edgecloudtest.NewServer(edgecloudtest.WithTaskPolls(0))
+	t.Cleanup(server.Close)
+	client, err := server.Client()
+	require.NoError(t, err)
+	server.InjectFault(edgecloudtest.Fault{Method: http.MethodPost, TaskError: "no space left"})
+
+	op, err := CreateLoadbalancerAsync(ctx, client, &edgecloud.LoadbalancerCreateRequest{Name: testName})
+	require.NoError(t, err)
+	op.waiter = newTestTaskWaiter(client)
+
+	_, err = op.Wait(ctx)
+	var taskErr *TaskFailedError
+	require.ErrorAs(t, err, &taskErr)
+	assert.Equal(t, "no space left", *taskErr.Task.Error)
+	assert.True(t, op.Done())
+}
+
+func TestOperation_PollDoesNotBlockDone(t *testing.T) {
+	requested, release := make(chan struct{}), make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc(path.Join("/v1/tasks", testResourceID), func(w http.ResponseWriter, r *http.Request) {
+		close(requested)
+		<-release
+		_, _ = fmt.Fprintf(w, `{"id":"%s","state":"RUNNING"}`, testResourceID)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := edgecloud.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	op, err := NewOperation[edgecloud.Loadbalancer](client, OperationKindLoadbalancerCreate, []string{testResourceID})
+	require.NoError(t, err)
+
+	polled := make(chan bool)
+	go func() {
+		done, _ := op.Poll(context.Background())
+		polled <- done
+	}()
+	<-requested
+
+	checked := make(chan struct{})
+	go func() {
+		assert.False(t, op.Done())
+		_, err := op.Result()
+		assert.ErrorIs(t, err, ErrOperationPending)
+		close(checked)
+	}()
+	select {
+	case <-checked:
+	case <-time.After(time.Second):
+		t.Error("Done and Result wait for the request of Poll")
+	}
+
+	close(release)
+	assert.False(t, <-polled)
+}
+
+func TestDBaaSClusterGetByCreatorTaskIDs(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := edgecloud.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.Project = projectID
+	client.Region = regionID
+
+	// the created cluster is on the second page, and the list reports the creator tasks
+	mux.HandleFunc(path.Join(edgecloud.DBaaSClustersBasePathV3, strconv.Itoa(projectID), strconv.Itoa(regionID)), func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			_, _ = fmt.Fprintf(w, `{"count":2,"results":[{"id":"other","creator_task_id":"%s"}]}`, testResourceID2)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"count":2,"results":[{"id":"created","creator_task_id":"%s"}]}`, testResourceID)
+	})
+
+	cluster, err := dbaasClusterGetByCreatorTaskIDs(context.Background(), client, []string{testResourceID})
+	require.NoError(t, err)
+	assert.Equal(t, "created", cluster.ID)
+
+	_, err = dbaasClusterGetByCreatorTaskIDs(context.Background(), client, []string{"unknown"})
+	assert.ErrorIs(t, err, ErrDBaaSClustersNotFound)
+}
@@ -0,0 +1,619 @@
+package util
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const defaultOrphanScanParallelism = 4
+
+// OrphanKinds are the kinds of resources ScanOrphans looks for orphans of.
+var OrphanKinds = []edgecloud.ResourceKind{
+	edgecloud.ResourceKindVolume,
+	edgecloud.ResourceKindFloatingIP,
+	edgecloud.ResourceKindLoadbalancer,
+	edgecloud.ResourceKindSecurityGroup,
+	edgecloud.ResourceKindSnapshot,
+	edgecloud.ResourceKindReservedFixedIP,
+}
+
+// OrphanPrices are the monthly prices used to estimate the cost of the orphans of the kinds the API
+// reports no price for. The cost of the load balancers comes from the prices of their flavors.
+type OrphanPrices struct {
+	Currency string
+
+	// VolumeGiB is the price of a GiB of volume by volume type.
+	VolumeGiB       map[edgecloud.VolumeType]float64
+	SnapshotGiB     float64
+	FloatingIP      float64
+	ReservedFixedIP float64
+}
+
+// OrphanScanOptions specifies the scopes and the kinds of resources of ScanOrphans.
+type OrphanScanOptions struct {
+	// Scopes are the projects and regions to scan, the scope of the client or of ctx by default.
+	// AllScopes returns all the projects and regions of the account.
+	Scopes []edgecloud.Scope
+
+	// Kinds restricts the scan to some of the OrphanKinds, all of them by default.
+	Kinds []edgecloud.ResourceKind
+
+	// MinAge ignores the resources created for less than it, e.g. the ones being set up.
+	MinAge time.Duration
+
+	// Prices are the prices of the kinds without price data in the API, if any.
+	Prices *OrphanPrices
+
+	// Parallelism is the maximum number of scopes scanned at once, 4 by default.
+	Parallelism int
+}
+
+// Orphan is a resource which is unused but still billed.
+type Orphan struct {
+	Kind      edgecloud.ResourceKind `json:"kind"`
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name,omitempty"`
+	ProjectID int                    `json:"project_id"`
+	RegionID  int                    `json:"region_id"`
+
+	CreatedAt edgecloud.Timestamp `json:"created_at"`
+	// Age is the time since CreatedAt, zero if the API does not report it.
+	Age time.Duration `json:"age,omitempty"`
+
+	// CostPerMonth is the estimated monthly cost, zero when no price is known.
+	CostPerMonth float64 `json:"cost_per_month,omitempty"`
+	Currency     string  `json:"currency,omitempty"`
+
+	// Reason tells why the resource is an orphan, and Action what to do with it.
+	Reason string `json:"reason"`
+	Action string `json:"action"`
+}
+
+// orphanJSON is the JSON of an Orphan, whose Age is a duration string, e.g. "240h0m0s".
+type orphanJSON struct {
+	*orphan
+	Age string `json:"age,omitempty"`
+}
+
+type orphan Orphan
+
+func (o Orphan) MarshalJSON() ([]byte, error) {
+	aux := orphanJSON{orphan: (*orphan)(&o)}
+	if o.Age != 0 {
+		aux.Age = o.Age.String()
+	}
+
+	return json.Marshal(aux)
+}
+
+func (o *Orphan) UnmarshalJSON(data []byte) error {
+	aux := orphanJSON{orphan: (*orphan)(o)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.Age != "" {
+		age, err := time.ParseDuration(aux.Age)
+		if err != nil {
+			return fmt.Errorf("age: %w", err)
+		}
+		o.Age = age
+	}
+
+	return nil
+}
+
+// Orphans are the orphans found by ScanOrphans. They are marshaled to JSON as a list, and String returns them
+// as a table.
+type Orphans []Orphan
+
+// String returns the orphans as a table, with the total of the estimated costs by currency.
+func (o Orphans) String() string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(w, "PROJECT\tREGION\tKIND\tID\tNAME\tAGE\tCOST/MONTH\tREASON\tACTION")
+	totals := make(map[string]float64)
+	for _, orphan := range o {
+		cost := "-"
+		if orphan.CostPerMonth > 0 {
+			cost = fmt.Sprintf("%.2f %s", orphan.CostPerMonth, orphan.Currency)
+			totals[orphan.Currency] += orphan.CostPerMonth
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", orphan.ProjectID, orphan.RegionID, orphan.Kind,
+			orphan.ID, orphan.Name, formatAge(orphan.Age), cost, orphan.Reason, orphan.Action)
+	}
+	_ = w.Flush()
+
+	currencies := make([]string, 0, len(totals))
+	for currency := range totals {
+		currencies = append(currencies, currency)
+	}
+	slices.Sort(currencies)
+	for _, currency := range currencies {
+		_, _ = fmt.Fprintf(&b, "total: %.2f %s per month\n", totals[currency], currency)
+	}
+
+	return b.String()
+}
+
+// formatAge returns the age in days, or in hours and minutes for less than a day.
+func formatAge(age time.Duration) string {
+	switch {
+	case age <= 0:
+		return "-"
+	case age >= 24*time.Hour:
+		return fmt.Sprintf("%dd", age/(24*time.Hour))
+	default:
+		return age.Truncate(time.Minute).String()
+	}
+}
+
+// AllScopes returns the active regions of the projects of the account which are not being deleted, to scan them
+// with ScanOrphans. The API does not tell which regions a project uses, so some of the scopes may be unavailable:
+// ScanOrphans skips them.
+func AllScopes(ctx context.Context, client *edgecloud.Client) ([]edgecloud.Scope, error) {
+	projects, err := edgecloud.NewPager(func(ctx context.Context, limit, offset int) ([]edgecloud.Project, *edgecloud.Response, error) {
+		return client.Projects.List(ctx, &edgecloud.ProjectListOptions{Limit: limit, Offset: offset})
+	}, 0).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list the projects: %w", err)
+	}
+	regions, err := edgecloud.NewPager(func(ctx context.Context, limit, offset int) ([]edgecloud.Region, *edgecloud.Response, error) {
+		return client.Regions.List(ctx, &edgecloud.RegionListOptions{Limit: limit, Offset: offset})
+	}, 0).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list the regions: %w", err)
+	}
+
+	scopes := make([]edgecloud.Scope, 0, len(projects)*len(regions))
+	for _, project := range projects {
+		if project.State == edgecloud.ProjectStateDeleting || project.State == edgecloud.ProjectStateDeleted {
+			continue
+		}
+		for _, region := range regions {
+			if region.State != "" && region.State != edgecloud.RegionStateActive {
+				continue
+			}
+			scopes = append(scopes, edgecloud.Scope{Project: project.ID, Region: region.ID})
+		}
+	}
+
+	return scopes, nil
+}
+
+// ScanOrphans looks for the resources which are unused but still billed in the scopes:
+//   - the volumes attached to no instance,
+//   - the floating IPs associated with no port,
+//   - the load balancers without listeners, or without members in their pools,
+//   - the security groups used by no instance nor rule of another group, except the default one,
+//   - the snapshots of deleted volumes,
+//   - the reserved fixed IPs bound to no resource, which are neither VIPs with instance ports nor allowed
+//     address pairs.
+//
+// The ports of the load balancers and of the reserved fixed IPs may use a security group too, which the API does
+// not report, so the action of the security groups is to review them before deleting them.
+//
+// The kinds of resources the API answers with 404 Not Found or 403 Forbidden in a scope, e.g. in a region the
+// project does not use, are skipped. The orphans are sorted by scope, kind and age, the oldest first. If the scan
+// of some scopes fails, the orphans of the other scopes are returned with an error joining the errors of the
+// failed scopes.
+func ScanOrphans(ctx context.Context, client *edgecloud.Client, opts *OrphanScanOptions) (Orphans, error) {
+	if opts == nil {
+		opts = &OrphanScanOptions{}
+	}
+	scopes := opts.Scopes
+	if len(scopes) == 0 {
+		scopes = []edgecloud.Scope{scopeOf(ctx, client)}
+	}
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = defaultOrphanScanParallelism
+	}
+
+	orphans := make([]Orphans, len(scopes))
+	errs := make([]error, len(scopes))
+	sem := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+	for i, scope := range scopes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+				scanner := &orphanScanner{
+					client: client,
+					opts:   opts,
+					scope:  scope,
+					now:    time.Now(),
+				}
+				orphans[i], errs[i] = scanner.scan(edgecloud.ContextWithScope(ctx, scope.Project, scope.Region))
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+			}
+			if errs[i] != nil {
+				errs[i] = fmt.Errorf("scan project %d, region %d: %w", scope.Project, scope.Region, errs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	var all Orphans
+	for _, o := range orphans {
+		all = append(all, o...)
+	}
+	slices.SortStableFunc(all, func(a, b Orphan) int {
+		return cmp.Or(
+			cmp.Compare(a.ProjectID, b.ProjectID),
+			cmp.Compare(a.RegionID, b.RegionID),
+			cmp.Compare(slices.Index(OrphanKinds, a.Kind), slices.Index(OrphanKinds, b.Kind)),
+			cmp.Compare(b.Age, a.Age),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	return all, errors.Join(errs...)
+}
+
+// orphanScanner scans a scope, listing every kind of resources at most once.
+type orphanScanner struct {
+	client *edgecloud.Client
+	opts   *OrphanScanOptions
+	scope  edgecloud.Scope
+	now    time.Time
+
+	volumes   []edgecloud.Volume
+	instances []edgecloud.Instance
+}
+
+func (s *orphanScanner) scan(ctx context.Context) (Orphans, error) {
+	kinds := s.opts.Kinds
+	if len(kinds) == 0 {
+		kinds = OrphanKinds
+	}
+
+	var orphans Orphans
+	for _, kind := range OrphanKinds {
+		if !slices.Contains(kinds, kind) {
+			continue
+		}
+
+		var (
+			found Orphans
+			err   error
+		)
+		switch kind {
+		case edgecloud.ResourceKindVolume:
+			found, err = s.scanVolumes(ctx)
+		case edgecloud.ResourceKindFloatingIP:
+			found, err = s.scanFloatingIPs(ctx)
+		case edgecloud.ResourceKindLoadbalancer:
+			found, err = s.scanLoadbalancers(ctx)
+		case edgecloud.ResourceKindSecurityGroup:
+			found, err = s.scanSecurityGroups(ctx)
+		case edgecloud.ResourceKindSnapshot:
+			found, err = s.scanSnapshots(ctx)
+		case edgecloud.ResourceKindReservedFixedIP:
+			found, err = s.scanReservedFixedIPs(ctx)
+		}
+		if unavailable(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		orphans = append(orphans, found...)
+	}
+
+	return orphans, nil
+}
+
+// unavailable reports whether the error is a 404 Not Found or a 403 Forbidden response, which the API returns
+// for the resources of a region the project does not use, or of a service the account has no access to.
+func unavailable(err error) bool {
+	var respErr *edgecloud.ResponseError
+
+	return errors.As(err, &respErr) &&
+		(respErr.StatusCode == http.StatusNotFound || respErr.StatusCode == http.StatusForbidden)
+}
+
+// orphan returns the Orphan of the resource, or false if it is younger than MinAge.
+func (s *orphanScanner) orphan(resource edgecloud.Resource, createdAt edgecloud.Timestamp, reason, action string) (Orphan, bool) {
+	var age time.Duration
+	if !createdAt.IsZero() {
+		age = s.now.Sub(createdAt.Time)
+	}
+	if s.opts.MinAge > 0 && age < s.opts.MinAge {
+		return Orphan{}, false
+	}
+
+	return Orphan{
+		Kind:      resource.Kind(),
+		ID:        resource.GetID(),
+		Name:      resource.GetName(),
+		ProjectID: s.scope.Project,
+		RegionID:  s.scope.Region,
+		CreatedAt: createdAt,
+		Age:       age,
+		Reason:    reason,
+		Action:    action,
+	}, true
+}
+
+// setCost sets the cost of the orphan from the monthly price, if known.
+func setCost(orphan *Orphan, price float64, currency string) {
+	if price > 0 {
+		orphan.CostPerMonth, orphan.Currency = price, currency
+	}
+}
+
+func (s *orphanScanner) listVolumes(ctx context.Context) ([]edgecloud.Volume, error) {
+	if s.volumes == nil {
+		volumes, err := edgecloud.NewPager(func(ctx context.Context, limit, offset int) ([]edgecloud.Volume, *edgecloud.Response, error) {
+			return s.client.Volumes.List(ctx, &edgecloud.VolumeListOptions{Limit: limit, Offset: offset})
+		}, 0).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list the volumes: %w", err)
+		}
+		s.volumes = append(make([]edgecloud.Volume, 0, len(volumes)), volumes...)
+	}
+
+	return s.volumes, nil
+}
+
+func (s *orphanScanner) listInstances(ctx context.Context) ([]edgecloud.Instance, error) {
+	if s.instances == nil {
+		instances, err := edgecloud.NewPager(func(ctx context.Context, limit, offset int) ([]edgecloud.Instance, *edgecloud.Response, error) {
+			return s.client.Instances.List(ctx, &edgecloud.InstanceListOptions{Limit: limit, Offset: offset})
+		}, 0).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list the instances: %w", err)
+		}
+		s.instances = append(make([]edgecloud.Instance, 0, len(instances)), instances...)
+	}
+
+	return s.instances, nil
+}
+
+func (s *orphanScanner) scanVolumes(ctx context.Context) (Orphans, error) {
+	volumes, err := s.listVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans Orphans
+	for i := range volumes {
+		volume := &volumes[i]
+		if len(volume.Attachments) > 0 || volume.InstanceID != "" || volume.Status.IsTransitional() {
+			continue
+		}
+		orphan, ok := s.orphan(volume, volume.CreatedAt, "attached to no instance",
+			"delete the volume, after a snapshot of it to keep the data")
+		if !ok {
+			continue
+		}
+		if prices := s.opts.Prices; prices != nil {
+			setCost(&orphan, prices.VolumeGiB[volume.VolumeType]*float64(volume.Size), prices.Currency)
+		}
+		orphans = append(orphans, orphan)
+	}
+
+	return orphans, nil
+}
+
+func (s *orphanScanner) scanFloatingIPs(ctx context.Context) (Orphans, error) {
+	fips, _, err := s.client.Floatingips.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list the floating IPs: %w", err)
+	}
+
+	var orphans Orphans
+	for i := range fips {
+		fip := &fips[i]
+		if fip.PortID != "" {
+			continue
+		}
+		orphan, ok := s.orphan(fip, fip.CreatedAt, "associated with no port", "delete the floating IP")
+		if !ok {
+			continue
+		}
+		if prices := s.opts.Prices; prices != nil {
+			setCost(&orphan, prices.FloatingIP, prices.Currency)
+		}
+		orphans = append(orphans, orphan)
+	}
+
+	return orphans, nil
+}
+
+func (s *orphanScanner) scanLoadbalancers(ctx context.Context) (Orphans, error) {
+	lbs, _, err := s.client.Loadbalancers.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list the load balancers: %w", err)
+	}
+
+	// The pools are listed only if a load balancer has listeners, as they are needed for the members only.
+	var pools []edgecloud.Pool
+	if slices.ContainsFunc(lbs, func(lb edgecloud.Loadbalancer) bool { return len(lb.Listeners) > 0 }) {
+		if pools, _, err = s.client.Loadbalancers.PoolList(ctx, &edgecloud.PoolListOptions{Details: true}); err != nil {
+			return nil, fmt.Errorf("list the load balancer pools: %w", err)
+		}
+	}
+
+	var (
+		orphans Orphans
+		prices  map[string]edgecloud.Flavor
+	)
+	for i := range lbs {
+		lb := &lbs[i]
+		var reason, action string
+		if len(lb.Listeners) == 0 {
+			reason, action = "no listeners", "delete the load balancer"
+		} else if !slices.ContainsFunc(pools, func(pool edgecloud.Pool) bool {
+			return len(pool.Members) > 0 && slices.ContainsFunc(pool.Loadbalancers, func(id edgecloud.ID) bool { return id.ID == lb.ID })
+		}) {
+			reason, action = "no members in its pools", "add members to its pools, or delete the load balancer"
+		} else {
+			continue
+		}
+		orphan, ok := s.orphan(lb, lb.CreatedAt, reason, action)
+		if !ok {
+			continue
+		}
+
+		flavor := lb.Flavor
+		if flavor.PricePerMonth == 0 {
+			if prices == nil {
+				prices = s.loadbalancerFlavorPrices(ctx)
+			}
+			if price, ok := prices[flavor.FlavorID]; ok {
+				flavor = price
+			}
+		}
+		setCost(&orphan, flavor.PricePerMonth, flavor.CurrencyCode)
+		orphans = append(orphans, orphan)
+	}
+
+	return orphans, nil
+}
+
+// loadbalancerFlavorPrices returns the load balancer flavors with their prices by flavor ID. The prices are
+// not available to every account, a failure only leaves the costs unknown, so it returns no flavors then.
+func (s *orphanScanner) loadbalancerFlavorPrices(ctx context.Context) map[string]edgecloud.Flavor {
+	flavors, _, err := s.client.Loadbalancers.FlavorList(ctx, &edgecloud.FlavorsOptions{IncludePrices: true})
+	if err != nil {
+		return map[string]edgecloud.Flavor{}
+	}
+
+	prices := make(map[string]edgecloud.Flavor, len(flavors))
+	for _, flavor := range flavors {
+		prices[flavor.FlavorID] = flavor
+	}
+
+	return prices
+}
+
+func (s *orphanScanner) scanSecurityGroups(ctx context.Context) (Orphans, error) {
+	sgs, _, err := s.client.SecurityGroups.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list the security groups: %w", err)
+	}
+	instances, err := s.listInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The instances report the names of their security groups, so a name in use makes all the groups
+	// with this name used.
+	used := make(map[string]bool)
+	for _, instance := range instances {
+		for _, sg := range instance.SecurityGroups {
+			used[sg.Name] = true
+		}
+	}
+	referenced := make(map[string]bool)
+	for _, sg := range sgs {
+		for _, rule := range sg.SecurityGroupRules {
+			if rule.RemoteGroupID != "" && rule.RemoteGroupID != sg.ID {
+				referenced[rule.RemoteGroupID] = true
+			}
+		}
+	}
+
+	var orphans Orphans
+	for i := range sgs {
+		sg := &sgs[i]
+		if sg.Name == "default" || used[sg.Name] || referenced[sg.ID] {
+			continue
+		}
+		if orphan, ok := s.orphan(sg, sg.CreatedAt, "used by no instance",
+			"review the ports of the load balancers and reserved fixed IPs using it, then delete the security group"); ok {
+			orphans = append(orphans, orphan)
+		}
+	}
+
+	return orphans, nil
+}
+
+func (s *orphanScanner) scanSnapshots(ctx context.Context) (Orphans, error) {
+	snapshots, err := edgecloud.NewPager(func(ctx context.Context, limit, offset int) ([]edgecloud.Snapshot, *edgecloud.Response, error) {
+		return s.client.Snapshots.List(ctx, &edgecloud.SnapshotListOptions{Limit: limit, Offset: offset})
+	}, 0).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list the snapshots: %w", err)
+	}
+	volumes, err := s.listVolumes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans Orphans
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+		if snapshot.VolumeID == "" || slices.ContainsFunc(volumes, func(v edgecloud.Volume) bool { return v.ID == snapshot.VolumeID }) {
+			continue
+		}
+		orphan, ok := s.orphan(snapshot, snapshot.CreatedAt, fmt.Sprintf("its volume %s is deleted", snapshot.VolumeID),
+			"delete the snapshot, after a volume from it to keep the data")
+		if !ok {
+			continue
+		}
+		if prices := s.opts.Prices; prices != nil {
+			setCost(&orphan, prices.SnapshotGiB*float64(snapshot.Size), prices.Currency)
+		}
+		orphans = append(orphans, orphan)
+	}
+
+	return orphans, nil
+}
+
+func (s *orphanScanner) scanReservedFixedIPs(ctx context.Context) (Orphans, error) {
+	ips, err := edgecloud.NewPager(func(ctx context.Context, limit, offset int) ([]edgecloud.ReservedFixedIP, *edgecloud.Response, error) {
+		return s.client.ReservedFixedIP.List(ctx, &edgecloud.ReservedFixedIPListOptions{Limit: limit, Offset: offset})
+	}, 0).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list the reserved fixed IPs: %w", err)
+	}
+
+	var orphans Orphans
+	for i := range ips {
+		ip := &ips[i]
+		if ip.Reservation.ResourceID != "" || ip.PortID == "" || len(ip.AllowedAddressPairs) > 0 {
+			continue
+		}
+		if ip.IsVIP {
+			// A VIP is used by the instance ports sharing it, which its reservation does not report.
+			ports, _, err := s.client.ReservedFixedIP.ListInstancePorts(ctx, ip.PortID)
+			if err != nil {
+				return nil, fmt.Errorf("list the instance ports of the reserved fixed IP %s: %w", ip.PortID, err)
+			}
+			if len(ports) > 0 {
+				continue
+			}
+		}
+		orphan, ok := s.orphan(ip, ip.CreatedAt, "bound to no resource", "delete the reserved fixed IP")
+		if !ok {
+			continue
+		}
+		if prices := s.opts.Prices; prices != nil {
+			setCost(&orphan, prices.ReservedFixedIP, prices.Currency)
+		}
+		orphans = append(orphans, orphan)
+	}
+
+	return orphans, nil
+}
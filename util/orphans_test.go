@@ -0,0 +1,187 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/Edge-Center/edgecentercloud-go/v2/edgecloudtest"
+)
+
+func TestScanOrphans(t *testing.T) {
+	ctx := context.Background()
+	server := edgecloudtest.NewServer(edgecloudtest.WithTaskPolls(0))
+	t.Cleanup(server.Close)
+	client, err := server.Client()
+	require.NoError(t, err)
+	other := edgecloud.Scope{Project: edgecloudtest.ProjectID + 1, Region: edgecloudtest.RegionID}
+
+	newVolume := func(client *edgecloud.Client, name string) *edgecloud.Volume {
+		volume, err := CreateVolumeAndGet(ctx, client, &edgecloud.VolumeCreateRequest{
+			Name: name, Size: 10, Source: edgecloud.VolumeSourceNewVolume, TypeName: edgecloud.VolumeTypeStandard,
+		})
+		require.NoError(t, err)
+
+		return volume
+	}
+	unattached := newVolume(client, "unattached")
+	attached := newVolume(client, "attached")
+	otherVolume := newVolume(client.WithScope(other.Project, other.Region), "other")
+
+	used, _, err := client.SecurityGroups.Create(ctx, &edgecloud.SecurityGroupCreateRequest{
+		SecurityGroup: edgecloud.SecurityGroupCreateRequestInner{Name: "used"},
+	})
+	require.NoError(t, err)
+	unused, _, err := client.SecurityGroups.Create(ctx, &edgecloud.SecurityGroupCreateRequest{
+		SecurityGroup: edgecloud.SecurityGroupCreateRequestInner{Name: "unused"},
+	})
+	require.NoError(t, err)
+
+	_, err = CreateInstanceAndGet(ctx, client, &edgecloud.InstanceCreateRequest{
+		Names:  []string{testName},
+		Flavor: "g1-standard-1-2",
+		Interfaces: []edgecloud.InstanceInterface{{
+			Type:       edgecloud.InterfaceTypeExternal,
+			FloatingIP: &edgecloud.InterfaceFloatingIP{Source: edgecloud.NewFloatingIP},
+		}},
+		Volumes: []edgecloud.InstanceVolumeCreate{
+			{Source: edgecloud.VolumeSourceExistingVolume, VolumeID: attached.ID, BootIndex: edgecloud.PtrTo(0)},
+		},
+		SecurityGroups: []edgecloud.ID{{ID: used.ID}},
+	})
+	require.NoError(t, err)
+	result, err := ExecuteAndExtractTaskResult(ctx, client.Floatingips.Create, &edgecloud.FloatingIPCreateRequest{}, client)
+	require.NoError(t, err)
+	fipID := result.FloatingIPs[0]
+	lb, err := CreateLoadbalancerAndGet(ctx, client, &edgecloud.LoadbalancerCreateRequest{Name: testName})
+	require.NoError(t, err)
+
+	opts := &OrphanScanOptions{
+		Scopes: []edgecloud.Scope{{Project: edgecloudtest.ProjectID, Region: edgecloudtest.RegionID}, other},
+		Kinds: []edgecloud.ResourceKind{
+			edgecloud.ResourceKindVolume,
+			edgecloud.ResourceKindFloatingIP,
+			edgecloud.ResourceKindLoadbalancer,
+			edgecloud.ResourceKindSecurityGroup,
+		},
+		Prices: &OrphanPrices{
+			Currency:  "EUR",
+			VolumeGiB: map[edgecloud.VolumeType]float64{edgecloud.VolumeTypeStandard: 0.1},
+		},
+	}
+	orphans, err := ScanOrphans(ctx, client, opts)
+	require.NoError(t, err)
+
+	ids := make([]string, 0, len(orphans))
+	for _, orphan := range orphans {
+		ids = append(ids, orphan.ID)
+	}
+	assert.Equal(t, []string{unattached.ID, fipID, lb.ID, unused.ID, otherVolume.ID}, ids)
+
+	volume := orphans[0]
+	assert.Equal(t, edgecloud.ResourceKindVolume, volume.Kind)
+	assert.Equal(t, "unattached", volume.Name)
+	assert.Equal(t, edgecloudtest.ProjectID, volume.ProjectID)
+	assert.InDelta(t, 1.0, volume.CostPerMonth, 1e-9)
+	assert.Equal(t, "EUR", volume.Currency)
+	assert.Positive(t, volume.Age)
+	assert.Equal(t, "no listeners", orphans[2].Reason)
+	assert.Contains(t, orphans[3].Action, "review the ports")
+	assert.Equal(t, other.Project, orphans[4].ProjectID)
+
+	table := orphans.String()
+	assert.Contains(t, table, "PROJECT")
+	assert.Contains(t, table, "total: 2.00 EUR per month")
+
+	opts.MinAge = time.Hour
+	orphans, err = ScanOrphans(ctx, client, opts)
+	require.NoError(t, err)
+	assert.Empty(t, orphans)
+}
+
+func TestScanOrphans_SnapshotsAndReservedFixedIPs(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := edgecloud.NewClient(nil)
+	baseURL, _ := url.Parse(server.URL)
+	client.BaseURL = baseURL
+	client.Project = projectID
+	client.Region = regionID
+
+	scope := path.Join(strconv.Itoa(projectID), strconv.Itoa(regionID))
+	vipID := "7a3f0d52-2c4e-4b8a-9f61-0c9d4e6b1a27"
+	// the volume of the kept snapshot is on the second page of the volumes
+	mux.HandleFunc(path.Join("/v1/volumes", scope), func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "" {
+			_, _ = fmt.Fprintf(w, `{"count":2,"results":[{"id":"%s","status":"in-use","instance_id":"%s"}]}`, testResourceID2, testResourceID2)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"count":2,"results":[{"id":"%s","status":"in-use","instance_id":"%s"}]}`, testResourceID, testResourceID2)
+	})
+	mux.HandleFunc(path.Join("/v1/snapshots", scope), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"results":[
+			{"id":"kept","volume_id":"%s","size":5},
+			{"id":"orphan","volume_id":"deleted","size":5,"created_at":"2024-01-02T03:04:05"}
+		]}`, testResourceID)
+	})
+	mux.HandleFunc(path.Join("/v1/reserved_fixed_ips", scope), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"results":[
+			{"port_id":"bound","reservation":{"resource_type":"instance","resource_id":"instance"}},
+			{"port_id":"free","reservation":{"status":"available"}},
+			{"port_id":"pairs","allowed_address_pairs":[{"ip_address":"10.0.0.5"}],"reservation":{"status":"available"}},
+			{"port_id":"%s","is_vip":true,"reservation":{"status":"available"}},
+			{"port_id":"%s","is_vip":true,"reservation":{"status":"available"}}
+		]}`, testResourceID, vipID)
+	})
+	mux.HandleFunc(path.Join("/v1/reserved_fixed_ips", scope, testResourceID, "connected_devices"), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"results":[{"port_id":"%s","instance_id":"instance"}]}`, testResourceID2)
+	})
+	mux.HandleFunc(path.Join("/v1/reserved_fixed_ips", scope, vipID, "connected_devices"), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"results":[]}`)
+	})
+	mux.HandleFunc(path.Join("/v1/loadbalancers", scope), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = fmt.Fprint(w, `{"message":"the region is not available for the project"}`)
+	})
+
+	orphans, err := ScanOrphans(context.Background(), client, &OrphanScanOptions{
+		Kinds: []edgecloud.ResourceKind{
+			edgecloud.ResourceKindLoadbalancer,
+			edgecloud.ResourceKindSnapshot,
+			edgecloud.ResourceKindReservedFixedIP,
+		},
+		Prices: &OrphanPrices{Currency: "USD", SnapshotGiB: 0.05},
+	})
+	require.NoError(t, err)
+	require.Len(t, orphans, 3)
+
+	assert.Equal(t, "orphan", orphans[0].ID)
+	assert.Equal(t, "its volume deleted is deleted", orphans[0].Reason)
+	assert.InDelta(t, 0.25, orphans[0].CostPerMonth, 1e-9)
+	assert.Greater(t, orphans[0].Age, 24*time.Hour)
+	assert.Equal(t, vipID, orphans[1].ID)
+	assert.Equal(t, edgecloud.ResourceKindReservedFixedIP, orphans[1].Kind)
+	assert.Zero(t, orphans[1].CostPerMonth)
+	assert.Equal(t, "free", orphans[2].ID)
+
+	data, err := json.Marshal(orphans)
+	require.NoError(t, err)
+	var decoded Orphans
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, orphans[0].Age, decoded[0].Age)
+	assert.True(t, orphans[0].CreatedAt.Equal(decoded[0].CreatedAt))
+	assert.Contains(t, string(data), `"age":"`)
+}
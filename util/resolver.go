@@ -0,0 +1,618 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+var (
+	// ErrReferenceNotFound is returned by the Resolver when no resource matches the reference.
+	ErrReferenceNotFound = errors.New("no resource matches the reference")
+	// ErrReferenceAmbiguous is returned by the Resolver when several resources match the reference.
+	ErrReferenceAmbiguous = errors.New("multiple resources match the reference")
+
+	errReferenceInvalid     = errors.New("invalid reference")
+	errReferenceUnsupported = errors.New("the resource kind cannot be referenced this way")
+)
+
+// ReferenceType is the way a Reference identifies a resource.
+type ReferenceType string
+
+const (
+	// ReferenceAuto is the type of the references without a prefix. They are resolved as an ID if the value has
+	// the format of the IDs of the kind, as an IP address if the value is one and the kind has IP addresses,
+	// and as a name otherwise.
+	ReferenceAuto     ReferenceType = ""
+	ReferenceID       ReferenceType = "id"
+	ReferenceName     ReferenceType = "name"
+	ReferenceIP       ReferenceType = "ip"
+	ReferenceMetadata ReferenceType = "metadata"
+)
+
+// Reference is a human-friendly reference to a resource, parsed from one of the forms
+//
+//	id:<id>
+//	name:<name>
+//	ip:<address>
+//	metadata:<key>=<value>[,<key>=<value>...]
+//	<id, address or name>
+//
+// Reference implements encoding.TextUnmarshaler, so it may be used as is in configuration files.
+type Reference struct {
+	Type     ReferenceType
+	Value    string
+	Metadata edgecloud.Metadata
+}
+
+// ParseReference parses the reference.
+func ParseReference(ref string) (Reference, error) {
+	prefix, value, found := strings.Cut(ref, ":")
+	referenceType := ReferenceType(prefix)
+	switch {
+	case !found:
+		return Reference{Value: ref}, nil
+	case referenceType == ReferenceID || referenceType == ReferenceName:
+		return Reference{Type: referenceType, Value: value}, nil
+	case referenceType == ReferenceIP:
+		if net.ParseIP(value) == nil {
+			return Reference{}, fmt.Errorf("%w: %q is not an IP address", errReferenceInvalid, value)
+		}
+
+		return Reference{Type: ReferenceIP, Value: value}, nil
+	case referenceType == ReferenceMetadata:
+		metadata := make(edgecloud.Metadata)
+		for _, pair := range strings.Split(value, ",") {
+			key, val, ok := strings.Cut(pair, "=")
+			if !ok || key == "" {
+				return Reference{}, fmt.Errorf("%w: %q is not a key=value pair", errReferenceInvalid, pair)
+			}
+			metadata[key] = val
+		}
+
+		return Reference{Type: ReferenceMetadata, Value: value, Metadata: metadata}, nil
+	default:
+		// Not a known prefix, e.g. an IPv6 address or a name with a colon.
+		return Reference{Value: ref}, nil
+	}
+}
+
+// String returns the reference in the form it is parsed from.
+func (r Reference) String() string {
+	if r.Type == ReferenceAuto {
+		return r.Value
+	}
+
+	return string(r.Type) + ":" + r.Value
+}
+
+func (r Reference) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Reference) UnmarshalText(text []byte) error {
+	ref, err := ParseReference(string(text))
+	if err != nil {
+		return err
+	}
+	*r = ref
+
+	return nil
+}
+
+// ResolveError is returned by the Resolver when a reference cannot be resolved to a single resource.
+// It matches ErrReferenceNotFound or ErrReferenceAmbiguous with errors.Is, as well as edgecloud.ErrResourceDoesntExist
+// and, for the ambiguous names, edgecloud.ErrMultipleResourcesWithTheSameName.
+type ResolveError struct {
+	Kind      edgecloud.ResourceKind
+	Reference Reference
+	// IDs are the IDs of the resources matching an ambiguous reference.
+	IDs []string
+	Err error
+}
+
+func (e *ResolveError) Error() string {
+	msg := fmt.Sprintf("%s %q: %v", e.Kind, e.Reference, e.Err)
+	if len(e.IDs) > 0 {
+		msg += fmt.Sprintf(" (%s)", strings.Join(e.IDs, ", "))
+	}
+
+	return msg
+}
+
+func (e *ResolveError) Unwrap() []error {
+	switch {
+	case errors.Is(e.Err, ErrReferenceNotFound):
+		return []error{e.Err, edgecloud.ErrResourceDoesntExist}
+	case errors.Is(e.Err, ErrReferenceAmbiguous) && e.Reference.Type == ReferenceName:
+		return []error{e.Err, edgecloud.ErrMultipleResourcesWithTheSameName}
+	default:
+		return []error{e.Err}
+	}
+}
+
+// Resolver resolves the references of the CLI arguments and configuration files, e.g. "name:web-1",
+// "ip:203.0.113.10" or "metadata:env=prod", to a single resource of the project and region of the client.
+type Resolver struct {
+	client *edgecloud.Client
+}
+
+// NewResolver returns a Resolver of the resources of the client.
+func NewResolver(client *edgecloud.Client) *Resolver {
+	return &Resolver{client: client}
+}
+
+// resolveSpec describes how the resources of a kind are looked up. The nil name, ips and metadata functions
+// mean the kind cannot be referenced this way.
+type resolveSpec[T any] struct {
+	kind     edgecloud.ResourceKind
+	get      GetResourceFunc[T]
+	list     func(ctx context.Context) ([]T, *edgecloud.Response, error)
+	isID     func(value string) bool
+	id       func(resource *T) string
+	name     func(resource *T) string
+	ips      func(resource *T) []net.IP
+	metadata func(resource *T) edgecloud.Metadata
+	// excluded reports whether the resource is not matched by any lookup, e.g. because it is being deleted.
+	excluded func(resource *T) bool
+}
+
+// resolve parses the reference and returns the single resource of the spec matching it.
+func resolve[T any](ctx context.Context, spec resolveSpec[T], ref string) (*T, error) {
+	reference, err := ParseReference(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveReference(ctx, spec, reference)
+}
+
+func resolveReference[T any](ctx context.Context, spec resolveSpec[T], ref Reference) (*T, error) {
+	if spec.isID == nil {
+		spec.isID = isUUID
+	}
+
+	if ref.Type == ReferenceAuto {
+		switch {
+		case spec.isID(ref.Value):
+			ref.Type = ReferenceID
+		case spec.ips != nil && net.ParseIP(ref.Value) != nil:
+			ref.Type = ReferenceIP
+		default:
+			ref.Type = ReferenceName
+		}
+	}
+
+	var match func(resource *T) bool
+	switch ref.Type {
+	case ReferenceID:
+		resource, _, err := spec.get(ctx, ref.Value)
+		switch {
+		case errors.Is(err, edgecloud.ErrNotFound):
+			return nil, &ResolveError{Kind: spec.kind, Reference: ref, Err: fmt.Errorf("%w: %w", ErrReferenceNotFound, err)}
+		case err != nil:
+			return nil, err
+		case spec.excluded != nil && spec.excluded(resource):
+			return nil, &ResolveError{Kind: spec.kind, Reference: ref, Err: ErrReferenceNotFound}
+		}
+
+		return resource, nil
+	case ReferenceName:
+		if spec.name == nil {
+			return nil, &ResolveError{Kind: spec.kind, Reference: ref, Err: errReferenceUnsupported}
+		}
+		match = func(resource *T) bool { return spec.name(resource) == ref.Value }
+	case ReferenceIP:
+		if spec.ips == nil {
+			return nil, &ResolveError{Kind: spec.kind, Reference: ref, Err: errReferenceUnsupported}
+		}
+		ip := net.ParseIP(ref.Value)
+		match = func(resource *T) bool {
+			return slices.ContainsFunc(spec.ips(resource), ip.Equal)
+		}
+	case ReferenceMetadata:
+		if spec.metadata == nil {
+			return nil, &ResolveError{Kind: spec.kind, Reference: ref, Err: errReferenceUnsupported}
+		}
+		match = func(resource *T) bool {
+			metadata := spec.metadata(resource)
+			for key, value := range ref.Metadata {
+				if actual, ok := metadata[key]; !ok || actual != value {
+					return false
+				}
+			}
+
+			return true
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown type %q", errReferenceInvalid, ref.Type)
+	}
+
+	resources, _, err := spec.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*T
+	for i := range resources {
+		resource := &resources[i]
+		if spec.excluded != nil && spec.excluded(resource) {
+			continue
+		}
+		if match(resource) {
+			matched = append(matched, resource)
+		}
+	}
+
+	switch len(matched) {
+	case 1:
+		return matched[0], nil
+	case 0:
+		return nil, &ResolveError{Kind: spec.kind, Reference: ref, Err: ErrReferenceNotFound}
+	default:
+		ids := make([]string, 0, len(matched))
+		for _, resource := range matched {
+			ids = append(ids, spec.id(resource))
+		}
+
+		return nil, &ResolveError{Kind: spec.kind, Reference: ref, IDs: ids, Err: ErrReferenceAmbiguous}
+	}
+}
+
+func isUUID(value string) bool {
+	_, err := uuid.Parse(value)
+	return err == nil
+}
+
+func isIntID(value string) bool {
+	_, err := strconv.Atoi(value)
+	return err == nil
+}
+
+// parseIPs returns the valid IP addresses of the values.
+func parseIPs(values ...string) []net.IP {
+	ips := make([]net.IP, 0, len(values))
+	for _, value := range values {
+		if ip := net.ParseIP(value); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips
+}
+
+func provisioningDeleted(status edgecloud.ProvisioningStatus) bool {
+	return status == edgecloud.ProvisioningStatusDeleted || status == edgecloud.ProvisioningStatusPendingDelete
+}
+
+// Instance resolves the reference to an instance, by ID, name, any of its IP addresses or metadata.
+func (r *Resolver) Instance(ctx context.Context, ref string) (*edgecloud.Instance, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Instance]{
+		kind: edgecloud.ResourceKindInstance,
+		get:  r.client.Instances.Get,
+		list: func(ctx context.Context) ([]edgecloud.Instance, *edgecloud.Response, error) {
+			return r.client.Instances.List(ctx, nil)
+		},
+		id:   (*edgecloud.Instance).GetID,
+		name: (*edgecloud.Instance).GetName,
+		ips: func(instance *edgecloud.Instance) []net.IP {
+			var ips []net.IP
+			for _, addresses := range instance.Addresses {
+				for _, address := range addresses {
+					ips = append(ips, address.Address)
+				}
+			}
+
+			return ips
+		},
+		metadata: (*edgecloud.Instance).GetMetadata,
+	}, ref)
+}
+
+// Volume resolves the reference to a volume, by ID, name or metadata.
+func (r *Resolver) Volume(ctx context.Context, ref string) (*edgecloud.Volume, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Volume]{
+		kind: edgecloud.ResourceKindVolume,
+		get:  r.client.Volumes.Get,
+		list: func(ctx context.Context) ([]edgecloud.Volume, *edgecloud.Response, error) {
+			return r.client.Volumes.List(ctx, nil)
+		},
+		id:       (*edgecloud.Volume).GetID,
+		name:     (*edgecloud.Volume).GetName,
+		metadata: (*edgecloud.Volume).GetMetadata,
+	}, ref)
+}
+
+// Network resolves the reference to a network, by ID, name or metadata.
+func (r *Resolver) Network(ctx context.Context, ref string) (*edgecloud.Network, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Network]{
+		kind: edgecloud.ResourceKindNetwork,
+		get:  r.client.Networks.Get,
+		list: func(ctx context.Context) ([]edgecloud.Network, *edgecloud.Response, error) {
+			return r.client.Networks.List(ctx, nil)
+		},
+		id:       (*edgecloud.Network).GetID,
+		name:     (*edgecloud.Network).GetName,
+		metadata: (*edgecloud.Network).GetMetadata,
+	}, ref)
+}
+
+// Subnetwork resolves the reference to a subnetwork, by ID, name or metadata.
+func (r *Resolver) Subnetwork(ctx context.Context, ref string) (*edgecloud.Subnetwork, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Subnetwork]{
+		kind: edgecloud.ResourceKindSubnetwork,
+		get:  r.client.Subnetworks.Get,
+		list: func(ctx context.Context) ([]edgecloud.Subnetwork, *edgecloud.Response, error) {
+			return r.client.Subnetworks.List(ctx, nil)
+		},
+		id:       (*edgecloud.Subnetwork).GetID,
+		name:     (*edgecloud.Subnetwork).GetName,
+		metadata: (*edgecloud.Subnetwork).GetMetadata,
+	}, ref)
+}
+
+// Router resolves the reference to a router, by ID, name or any of its external IP addresses.
+func (r *Resolver) Router(ctx context.Context, ref string) (*edgecloud.Router, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Router]{
+		kind: edgecloud.ResourceKindRouter,
+		get:  r.client.Routers.Get,
+		list: r.client.Routers.List,
+		id:   (*edgecloud.Router).GetID,
+		name: (*edgecloud.Router).GetName,
+		ips: func(router *edgecloud.Router) []net.IP {
+			addresses := make([]string, 0, len(router.ExternalGatewayInfo.ExternalFixedIPs))
+			for _, fixedIP := range router.ExternalGatewayInfo.ExternalFixedIPs {
+				addresses = append(addresses, fixedIP.IPAddress)
+			}
+
+			return parseIPs(addresses...)
+		},
+	}, ref)
+}
+
+// Loadbalancer resolves the reference to a loadbalancer, by ID, name, VIP or floating IP address, or metadata.
+// The loadbalancers being deleted are not matched.
+func (r *Resolver) Loadbalancer(ctx context.Context, ref string) (*edgecloud.Loadbalancer, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Loadbalancer]{
+		kind: edgecloud.ResourceKindLoadbalancer,
+		get:  r.client.Loadbalancers.Get,
+		list: func(ctx context.Context) ([]edgecloud.Loadbalancer, *edgecloud.Response, error) {
+			return r.client.Loadbalancers.List(ctx, nil)
+		},
+		id:   (*edgecloud.Loadbalancer).GetID,
+		name: (*edgecloud.Loadbalancer).GetName,
+		ips: func(lb *edgecloud.Loadbalancer) []net.IP {
+			ips := []net.IP{lb.VipAddress}
+			for _, fip := range lb.FloatingIPs {
+				ips = append(ips, parseIPs(fip.FloatingIPAddress)...)
+			}
+
+			return ips
+		},
+		metadata: (*edgecloud.Loadbalancer).GetMetadata,
+		excluded: func(lb *edgecloud.Loadbalancer) bool { return provisioningDeleted(lb.ProvisioningStatus) },
+	}, ref)
+}
+
+// Listener resolves the reference to a loadbalancer listener of the region, by ID or name. The listeners being
+// deleted are not matched. The names of the listeners are often repeated across the loadbalancers, e.g. "http",
+// use ListenerOf to resolve them among the listeners of a loadbalancer.
+func (r *Resolver) Listener(ctx context.Context, ref string) (*edgecloud.Listener, error) {
+	return r.ListenerOf(ctx, "", ref)
+}
+
+// ListenerOf resolves the reference to a listener of the loadbalancer, by ID or name. The listeners being deleted
+// are not matched. An empty loadbalancerID resolves the reference among all the listeners of the region.
+func (r *Resolver) ListenerOf(ctx context.Context, loadbalancerID, ref string) (*edgecloud.Listener, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Listener]{
+		kind: edgecloud.ResourceKindListener,
+		get:  r.client.Loadbalancers.ListenerGet,
+		list: func(ctx context.Context) ([]edgecloud.Listener, *edgecloud.Response, error) {
+			return r.client.Loadbalancers.ListenerList(ctx, &edgecloud.ListenerListOptions{LoadbalancerID: loadbalancerID})
+		},
+		id:   (*edgecloud.Listener).GetID,
+		name: (*edgecloud.Listener).GetName,
+		excluded: func(listener *edgecloud.Listener) bool {
+			return provisioningDeleted(listener.ProvisioningStatus) ||
+				(loadbalancerID != "" && listener.LoadbalancerID != loadbalancerID)
+		},
+	}, ref)
+}
+
+// Pool resolves the reference to a loadbalancer pool of the region, by ID or name. The pools being deleted
+// are not matched. Use PoolOf to resolve the names repeated across the loadbalancers.
+func (r *Resolver) Pool(ctx context.Context, ref string) (*edgecloud.Pool, error) {
+	return r.PoolOf(ctx, "", ref)
+}
+
+// PoolOf resolves the reference to a pool of the loadbalancer, by ID or name. The pools being deleted are not matched.
+// An empty loadbalancerID resolves the reference among all the pools of the region.
+func (r *Resolver) PoolOf(ctx context.Context, loadbalancerID, ref string) (*edgecloud.Pool, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Pool]{
+		kind: edgecloud.ResourceKindPool,
+		get:  r.client.Loadbalancers.PoolGet,
+		list: func(ctx context.Context) ([]edgecloud.Pool, *edgecloud.Response, error) {
+			return r.client.Loadbalancers.PoolList(ctx, &edgecloud.PoolListOptions{LoadbalancerID: loadbalancerID, Details: true})
+		},
+		id:   (*edgecloud.Pool).GetID,
+		name: (*edgecloud.Pool).GetName,
+		excluded: func(pool *edgecloud.Pool) bool {
+			return provisioningDeleted(pool.ProvisioningStatus) ||
+				(loadbalancerID != "" && !slices.ContainsFunc(pool.Loadbalancers, func(lb edgecloud.ID) bool {
+					return lb.ID == loadbalancerID
+				}))
+		},
+	}, ref)
+}
+
+// L7Policy resolves the reference to an L7 policy, by ID or name.
+func (r *Resolver) L7Policy(ctx context.Context, ref string) (*edgecloud.L7Policy, error) {
+	return resolve(ctx, resolveSpec[edgecloud.L7Policy]{
+		kind: edgecloud.ResourceKindL7Policy,
+		get:  r.client.L7Policies.Get,
+		list: r.client.L7Policies.List,
+		id:   (*edgecloud.L7Policy).GetID,
+		name: (*edgecloud.L7Policy).GetName,
+	}, ref)
+}
+
+// FloatingIP resolves the reference to a floating IP, by ID, address or metadata. Floating IPs have no name.
+func (r *Resolver) FloatingIP(ctx context.Context, ref string) (*edgecloud.FloatingIP, error) {
+	return resolve(ctx, resolveSpec[edgecloud.FloatingIP]{
+		kind:     edgecloud.ResourceKindFloatingIP,
+		get:      r.client.Floatingips.Get,
+		list:     r.client.Floatingips.List,
+		id:       (*edgecloud.FloatingIP).GetID,
+		ips:      func(fip *edgecloud.FloatingIP) []net.IP { return parseIPs(fip.FloatingIPAddress) },
+		metadata: (*edgecloud.FloatingIP).GetMetadata,
+	}, ref)
+}
+
+// SecurityGroup resolves the reference to a security group, by ID, name or metadata.
+func (r *Resolver) SecurityGroup(ctx context.Context, ref string) (*edgecloud.SecurityGroup, error) {
+	return resolve(ctx, resolveSpec[edgecloud.SecurityGroup]{
+		kind: edgecloud.ResourceKindSecurityGroup,
+		get:  r.client.SecurityGroups.Get,
+		list: func(ctx context.Context) ([]edgecloud.SecurityGroup, *edgecloud.Response, error) {
+			return r.client.SecurityGroups.List(ctx, nil)
+		},
+		id:       (*edgecloud.SecurityGroup).GetID,
+		name:     (*edgecloud.SecurityGroup).GetName,
+		metadata: (*edgecloud.SecurityGroup).GetMetadata,
+	}, ref)
+}
+
+// Snapshot resolves the reference to a snapshot, by ID, name or metadata.
+func (r *Resolver) Snapshot(ctx context.Context, ref string) (*edgecloud.Snapshot, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Snapshot]{
+		kind: edgecloud.ResourceKindSnapshot,
+		get:  r.client.Snapshots.Get,
+		list: func(ctx context.Context) ([]edgecloud.Snapshot, *edgecloud.Response, error) {
+			return r.client.Snapshots.List(ctx, nil)
+		},
+		id:       (*edgecloud.Snapshot).GetID,
+		name:     (*edgecloud.Snapshot).GetName,
+		metadata: (*edgecloud.Snapshot).GetMetadata,
+	}, ref)
+}
+
+// Image resolves the reference to an image, by ID, name or metadata.
+func (r *Resolver) Image(ctx context.Context, ref string) (*edgecloud.Image, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Image]{
+		kind: edgecloud.ResourceKindImage,
+		get:  r.client.Images.Get,
+		list: func(ctx context.Context) ([]edgecloud.Image, *edgecloud.Response, error) {
+			return r.client.Images.List(ctx, nil)
+		},
+		id:       (*edgecloud.Image).GetID,
+		name:     (*edgecloud.Image).GetName,
+		metadata: (*edgecloud.Image).GetMetadata,
+	}, ref)
+}
+
+// KeyPair resolves the reference to a keypair, by ID or name.
+func (r *Resolver) KeyPair(ctx context.Context, ref string) (*edgecloud.KeyPair, error) {
+	return resolve(ctx, resolveSpec[edgecloud.KeyPair]{
+		kind: edgecloud.ResourceKindKeyPair,
+		get:  r.client.KeyPairs.Get,
+		list: r.client.KeyPairs.List,
+		id:   (*edgecloud.KeyPair).GetID,
+		name: (*edgecloud.KeyPair).GetName,
+	}, ref)
+}
+
+// Secret resolves the reference to a secret, by ID or name.
+func (r *Resolver) Secret(ctx context.Context, ref string) (*edgecloud.Secret, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Secret]{
+		kind: edgecloud.ResourceKindSecret,
+		get:  r.client.Secrets.Get,
+		list: r.client.Secrets.List,
+		id:   (*edgecloud.Secret).GetID,
+		name: (*edgecloud.Secret).GetName,
+	}, ref)
+}
+
+// ServerGroup resolves the reference to a server group, by ID or name.
+func (r *Resolver) ServerGroup(ctx context.Context, ref string) (*edgecloud.ServerGroup, error) {
+	return resolve(ctx, resolveSpec[edgecloud.ServerGroup]{
+		kind: edgecloud.ResourceKindServerGroup,
+		get:  r.client.ServerGroups.Get,
+		list: r.client.ServerGroups.List,
+		id:   (*edgecloud.ServerGroup).GetID,
+		name: (*edgecloud.ServerGroup).GetName,
+	}, ref)
+}
+
+// ReservedFixedIP resolves the reference to a reserved fixed IP, by the ID of its port, name or address.
+func (r *Resolver) ReservedFixedIP(ctx context.Context, ref string) (*edgecloud.ReservedFixedIP, error) {
+	return resolve(ctx, resolveSpec[edgecloud.ReservedFixedIP]{
+		kind: edgecloud.ResourceKindReservedFixedIP,
+		get:  r.client.ReservedFixedIP.Get,
+		list: func(ctx context.Context) ([]edgecloud.ReservedFixedIP, *edgecloud.Response, error) {
+			return r.client.ReservedFixedIP.List(ctx, nil)
+		},
+		id:   (*edgecloud.ReservedFixedIP).GetID,
+		name: (*edgecloud.ReservedFixedIP).GetName,
+		ips:  func(rfip *edgecloud.ReservedFixedIP) []net.IP { return []net.IP{rfip.FixedIPAddress} },
+	}, ref)
+}
+
+// MKaaSCluster resolves the reference to an MKaaS cluster, by its integer ID, name or internal or external
+// IP address.
+func (r *Resolver) MKaaSCluster(ctx context.Context, ref string) (*edgecloud.MKaaSCluster, error) {
+	return resolve(ctx, resolveSpec[edgecloud.MKaaSCluster]{
+		kind: edgecloud.ResourceKindMKaaSCluster,
+		get: func(ctx context.Context, id string) (*edgecloud.MKaaSCluster, *edgecloud.Response, error) {
+			clusterID, err := strconv.Atoi(id)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: %q is not an MKaaS cluster ID", errReferenceInvalid, id)
+			}
+
+			return r.client.MkaaS.ClusterGet(ctx, clusterID)
+		},
+		list: func(ctx context.Context) ([]edgecloud.MKaaSCluster, *edgecloud.Response, error) {
+			return r.client.MkaaS.ClustersList(ctx, nil)
+		},
+		isID: isIntID,
+		id:   (*edgecloud.MKaaSCluster).GetID,
+		name: (*edgecloud.MKaaSCluster).GetName,
+		ips: func(cluster *edgecloud.MKaaSCluster) []net.IP {
+			return parseIPs(cluster.InternalIP, cluster.ExternalIP)
+		},
+	}, ref)
+}
+
+// DBaaSCluster resolves the reference to a DBaaS cluster, by ID or name.
+func (r *Resolver) DBaaSCluster(ctx context.Context, ref string) (*edgecloud.DBaaSCluster, error) {
+	return resolve(ctx, resolveSpec[edgecloud.DBaaSCluster]{
+		kind: edgecloud.ResourceKindDBaaSCluster,
+		get:  r.client.DBaaS.ClusterGet,
+		list: func(ctx context.Context) ([]edgecloud.DBaaSCluster, *edgecloud.Response, error) {
+			return r.client.DBaaS.ClustersList(ctx, nil)
+		},
+		id:   (*edgecloud.DBaaSCluster).GetID,
+		name: (*edgecloud.DBaaSCluster).GetName,
+	}, ref)
+}
+
+// Project resolves the reference to a project, by its integer ID or name.
+func (r *Resolver) Project(ctx context.Context, ref string) (*edgecloud.Project, error) {
+	return resolve(ctx, resolveSpec[edgecloud.Project]{
+		kind: edgecloud.ResourceKindProject,
+		get:  r.client.Projects.Get,
+		list: func(ctx context.Context) ([]edgecloud.Project, *edgecloud.Response, error) {
+			return r.client.Projects.List(ctx, nil)
+		},
+		isID: isIntID,
+		id:   (*edgecloud.Project).GetID,
+		name: (*edgecloud.Project).GetName,
+	}, ref)
+}
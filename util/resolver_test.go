@@ -0,0 +1,192 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/Edge-Center/edgecentercloud-go/v2/edgecloudtest"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		ref      string
+		expected Reference
+	}{
+		{ref: "web-1", expected: Reference{Value: "web-1"}},
+		{ref: testResourceID, expected: Reference{Value: testResourceID}},
+		{ref: "id:" + testResourceID, expected: Reference{Type: ReferenceID, Value: testResourceID}},
+		{ref: "name:id:1", expected: Reference{Type: ReferenceName, Value: "id:1"}},
+		{ref: "ip:203.0.113.10", expected: Reference{Type: ReferenceIP, Value: "203.0.113.10"}},
+		{ref: "2001:db8::1", expected: Reference{Value: "2001:db8::1"}},
+		{ref: "metadata:env=prod,team=", expected: Reference{
+			Type:     ReferenceMetadata,
+			Value:    "env=prod,team=",
+			Metadata: edgecloud.Metadata{"env": "prod", "team": ""},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			ref, err := ParseReference(tt.ref)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, ref)
+			assert.Equal(t, tt.ref, ref.String())
+		})
+	}
+
+	for _, ref := range []string{"ip:web-1", "metadata:env", "metadata:=prod"} {
+		_, err := ParseReference(ref)
+		assert.ErrorIs(t, err, errReferenceInvalid, ref)
+	}
+
+	var config struct {
+		Network Reference `json:"network"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"network":"name:private"}`), &config))
+	assert.Equal(t, Reference{Type: ReferenceName, Value: "private"}, config.Network)
+}
+
+func TestResolver(t *testing.T) {
+	ctx := context.Background()
+	server := edgecloudtest.NewServer(edgecloudtest.WithTaskPolls(0))
+	t.Cleanup(server.Close)
+	client, err := server.Client()
+	require.NoError(t, err)
+	resolver := NewResolver(client)
+
+	network, err := CreateNetworkAndGet(ctx, client, &edgecloud.NetworkCreateRequest{Name: testName})
+	require.NoError(t, err)
+	duplicates := make([]string, 2)
+	for i := range duplicates {
+		duplicate, err := CreateNetworkAndGet(ctx, client, &edgecloud.NetworkCreateRequest{Name: "duplicate"})
+		require.NoError(t, err)
+		duplicates[i] = duplicate.ID
+	}
+
+	for _, ref := range []string{network.ID, "id:" + network.ID, testName, "name:" + testName} {
+		resolved, err := resolver.Network(ctx, ref)
+		require.NoError(t, err, ref)
+		assert.Equal(t, network.ID, resolved.ID, ref)
+	}
+
+	_, err = resolver.Network(ctx, "duplicate")
+	var resolveErr *ResolveError
+	require.ErrorAs(t, err, &resolveErr)
+	assert.Equal(t, edgecloud.ResourceKindNetwork, resolveErr.Kind)
+	assert.ElementsMatch(t, duplicates, resolveErr.IDs)
+	assert.ErrorIs(t, err, ErrReferenceAmbiguous)
+	assert.ErrorIs(t, err, edgecloud.ErrMultipleResourcesWithTheSameName)
+
+	_, err = resolver.Network(ctx, "missing")
+	assert.ErrorIs(t, err, ErrReferenceNotFound)
+	assert.ErrorIs(t, err, edgecloud.ErrResourceDoesntExist)
+
+	_, err = resolver.Network(ctx, testResourceID)
+	assert.ErrorIs(t, err, ErrReferenceNotFound)
+	assert.ErrorIs(t, err, edgecloud.ErrNotFound)
+
+	result, err := ExecuteAndExtractTaskResult(ctx, client.Floatingips.Create, &edgecloud.FloatingIPCreateRequest{
+		Metadata: edgecloud.Metadata{"env": "prod", "team": "core"},
+	}, client)
+	require.NoError(t, err)
+	fip, _, err := client.Floatingips.Get(ctx, result.FloatingIPs[0])
+	require.NoError(t, err)
+
+	for _, ref := range []string{fip.FloatingIPAddress, "ip:" + fip.FloatingIPAddress, "metadata:env=prod,team=core"} {
+		resolved, err := resolver.FloatingIP(ctx, ref)
+		require.NoError(t, err, ref)
+		assert.Equal(t, fip.ID, resolved.ID, ref)
+	}
+
+	_, err = resolver.FloatingIP(ctx, "metadata:env=dev")
+	assert.ErrorIs(t, err, ErrReferenceNotFound)
+
+	_, err = resolver.FloatingIP(ctx, "name:public")
+	assert.ErrorIs(t, err, errReferenceUnsupported)
+
+	_, err = resolver.Network(ctx, "ip:"+fip.FloatingIPAddress)
+	assert.ErrorIs(t, err, errReferenceUnsupported)
+}
+
+func TestResolver_LoadbalancerScope(t *testing.T) {
+	const (
+		lbID, otherLBID             = "a1e9ba7e-b9c7-4b7a-9f0c-9b1a2a6d7f01", "a1e9ba7e-b9c7-4b7a-9f0c-9b1a2a6d7f02"
+		listenerID, otherListenerID = "b2e9ba7e-b9c7-4b7a-9f0c-9b1a2a6d7f01", "b2e9ba7e-b9c7-4b7a-9f0c-9b1a2a6d7f02"
+		poolID, otherPoolID         = "c3e9ba7e-b9c7-4b7a-9f0c-9b1a2a6d7f01", "c3e9ba7e-b9c7-4b7a-9f0c-9b1a2a6d7f02"
+	)
+	listeners := map[string]string{listenerID: lbID, otherListenerID: otherLBID}
+	pools := map[string]string{poolID: lbID, otherPoolID: otherLBID}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := edgecloud.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.Project = projectID
+	client.Region = regionID
+
+	scope := path.Join(strconv.Itoa(projectID), strconv.Itoa(regionID))
+	listener := func(id string) string {
+		return fmt.Sprintf(`{"id":"%s","name":"http","loadbalancer_id":"%s","provisioning_status":"ACTIVE"}`, id, listeners[id])
+	}
+	pool := func(id string) string {
+		return fmt.Sprintf(`{"id":"%s","name":"http","loadbalancers":[{"id":"%s"}],"provisioning_status":"ACTIVE"}`, id, pools[id])
+	}
+	list := func(w http.ResponseWriter, r *http.Request, items map[string]string, format func(string) string) {
+		var results []string
+		for id, lb := range items {
+			if r.URL.Query().Get("loadbalancer_id") == "" || r.URL.Query().Get("loadbalancer_id") == lb {
+				results = append(results, format(id))
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"results":[%s]}`, strings.Join(results, ","))
+	}
+	mux.HandleFunc(path.Join("/v1/lblisteners", scope), func(w http.ResponseWriter, r *http.Request) {
+		list(w, r, listeners, listener)
+	})
+	mux.HandleFunc(path.Join("/v1/lbpools", scope), func(w http.ResponseWriter, r *http.Request) {
+		list(w, r, pools, pool)
+	})
+	mux.HandleFunc(path.Join("/v1/lblisteners", scope, otherListenerID), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, listener(otherListenerID))
+	})
+	mux.HandleFunc(path.Join("/v1/lbpools", scope, otherPoolID), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, pool(otherPoolID))
+	})
+	mux.HandleFunc(path.Join("/v1/loadbalancers", scope, lbID), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"id":"%s","provisioning_status":"PENDING_DELETE"}`, lbID)
+	})
+	resolver := NewResolver(client)
+	ctx := context.Background()
+
+	_, err := resolver.Listener(ctx, "http")
+	assert.ErrorIs(t, err, ErrReferenceAmbiguous)
+	resolvedListener, err := resolver.ListenerOf(ctx, lbID, "http")
+	require.NoError(t, err)
+	assert.Equal(t, listenerID, resolvedListener.ID)
+	_, err = resolver.ListenerOf(ctx, lbID, otherListenerID)
+	assert.ErrorIs(t, err, ErrReferenceNotFound, "a listener of another loadbalancer")
+
+	_, err = resolver.Pool(ctx, "http")
+	assert.ErrorIs(t, err, ErrReferenceAmbiguous)
+	resolvedPool, err := resolver.PoolOf(ctx, lbID, "http")
+	require.NoError(t, err)
+	assert.Equal(t, poolID, resolvedPool.ID)
+	_, err = resolver.PoolOf(ctx, lbID, "id:"+otherPoolID)
+	assert.ErrorIs(t, err, ErrReferenceNotFound, "a pool of another loadbalancer")
+
+	_, err = resolver.Loadbalancer(ctx, lbID)
+	assert.ErrorIs(t, err, ErrReferenceNotFound, "a loadbalancer being deleted")
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
@@ -24,7 +25,7 @@ func ResourceIsDeleted[T any](ctx context.Context, getResourceFunc GetResourceFu
 		return errResourceNotDeleted
 	}
 
-	if resp != nil && resp.StatusCode == http.StatusNotFound {
+	if IsNotFoundErr(resp) || errors.Is(err, edgecloud.ErrNotFound) {
 		return nil
 	}
 
@@ -48,17 +49,45 @@ func ResourceIsExist[T any](ctx context.Context, getResourceFunc GetResourceFunc
 	}
 }
 
+// InstanceDeleter deletes the instances with the options, e.g. together with their volumes and floating IPs,
+// when passed to DeleteResourceIfExist instead of the InstancesService.
+type InstanceDeleter struct {
+	Instances edgecloud.InstancesService
+	Options   *edgecloud.InstanceDeleteOptions
+}
+
+// MKaaSClusterPools identifies the pools of an MKaaS cluster for DeleteResourceIfExist, which takes the ID of the pool.
+type MKaaSClusterPools struct {
+	MKaaS     edgecloud.MKaaSPools
+	ClusterID int
+}
+
+// LoadbalancerPoolMembers identifies the members of a load balancer pool for DeleteResourceIfExist,
+// which takes the ID of the member.
+type LoadbalancerPoolMembers struct {
+	Loadbalancers edgecloud.LoadbalancersService
+	PoolID        string
+}
+
+// DeleteResourceIfExist deletes the resource, waits for the deletion task and checks that the resource is gone.
+// The resource is identified by the service of its kind, e.g. client.Volumes, or by its edgecloud.ResourceKind
+// for the kinds sharing a service, e.g. edgecloud.ResourceKindListener or edgecloud.ResourceKindDBaaSCluster;
+// client.DBaaS stands for the DBaaS backups. The instances may be deleted with options with an InstanceDeleter,
+// the MKaaS pools with an MKaaSClusterPools and the members of the load balancer pools with a LoadbalancerPoolMembers.
+//
+// A resource that does not exist is not an error, and the deletion of a locked resource is retried
+// until the timeout.
 func DeleteResourceIfExist(ctx context.Context, client *edgecloud.Client, resource interface{}, resourceID string, timeouts ...time.Duration) error {
 	deleteAndWait := func(
 		deleter func(ctx context.Context, resourceID string) (*edgecloud.TaskResponse, *edgecloud.Response, error),
 	) error {
 		task, resp, err := deleter(ctx, resourceID)
 		if err != nil {
-			if IsNotFoundErr(resp) {
+			if IsNotFoundErr(resp) || errors.Is(err, edgecloud.ErrNotFound) {
 				return nil
 			}
 
-			if IsLockedErr(resp) {
+			if IsLockedErr(resp) || errors.Is(err, edgecloud.ErrConflict) {
 				return RetryDeleteLocked(ctx, client, deleter, resourceID, timeouts...)
 			}
 
@@ -73,6 +102,63 @@ func DeleteResourceIfExist(ctx context.Context, client *edgecloud.Client, resour
 	}
 
 	switch v := resource.(type) {
+	case edgecloud.ResourceKind:
+		api, err := client.Resources(v)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errDeleteResourceIfExistIsNotSupported, err)
+		}
+		if err := deleteAndWait(api.Delete); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, func(ctx context.Context, id string) (*edgecloud.Resource, *edgecloud.Response, error) {
+			resource, resp, err := api.Get(ctx, id)
+			return &resource, resp, err
+		}, resourceID)
+	case InstanceDeleter:
+		if err := deleteAndWait(func(ctx context.Context, id string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+			return v.Instances.Delete(ctx, id, v.Options)
+		}); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Instances.Get, resourceID)
+	case MKaaSClusterPools:
+		poolID, err := strconv.Atoi(resourceID)
+		if err != nil {
+			return fmt.Errorf("%w: the MKaaS pool ID %q is not an integer", errDeleteResourceIfExistIsNotSupported, resourceID)
+		}
+		if err := deleteAndWait(func(ctx context.Context, _ string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+			return v.MKaaS.PoolDelete(ctx, v.ClusterID, poolID)
+		}); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, func(ctx context.Context, _ string) (*edgecloud.MKaaSPool, *edgecloud.Response, error) {
+			return v.MKaaS.PoolGet(ctx, v.ClusterID, poolID)
+		}, resourceID)
+	case LoadbalancerPoolMembers:
+		if err := deleteAndWait(func(ctx context.Context, id string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+			return v.Loadbalancers.PoolMemberDelete(ctx, v.PoolID, id)
+		}); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, func(ctx context.Context, id string) (*edgecloud.PoolMember, *edgecloud.Response, error) {
+			pool, resp, err := v.Loadbalancers.PoolGet(ctx, v.PoolID)
+			if err != nil {
+				return nil, resp, err
+			}
+			for i := range pool.Members {
+				if pool.Members[i].ID == id {
+					return &pool.Members[i], resp, nil
+				}
+			}
+			return nil, resp, edgecloud.ErrNotFound
+		}, resourceID)
+	case edgecloud.InstancesService:
+		if err := deleteAndWait(func(ctx context.Context, id string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+			return v.Delete(ctx, id, nil)
+		}); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
 	case edgecloud.LoadbalancersService:
 		if err := deleteAndWait(v.Delete); err != nil {
 			return err
@@ -105,6 +191,63 @@ func DeleteResourceIfExist(ctx context.Context, client *edgecloud.Client, resour
 		return ResourceIsDeleted(ctx, func(ctx context.Context, id string) (*edgecloud.DBaaSBackup, *edgecloud.Response, error) {
 			return v.BackupGet(ctx, id, false)
 		}, resourceID)
+	case edgecloud.NetworksService:
+		if err := deleteAndWait(v.Delete); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
+	case edgecloud.SubnetworksService:
+		if err := deleteAndWait(v.Delete); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
+	case edgecloud.RoutersService:
+		if err := deleteAndWait(v.Delete); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
+	case edgecloud.SecretsService:
+		if err := deleteAndWait(v.Delete); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
+	case edgecloud.SecurityGroupsService:
+		// deleted synchronously, without a task to wait for
+		if err := deleteAndWait(func(ctx context.Context, id string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+			resp, err := v.Delete(ctx, id)
+			return nil, resp, err
+		}); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
+	case edgecloud.ServerGroupsService:
+		if err := deleteAndWait(func(ctx context.Context, id string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+			resp, err := v.Delete(ctx, id)
+			return nil, resp, err
+		}); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
+	case edgecloud.ImagesService:
+		if err := deleteAndWait(v.Delete); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
+	case edgecloud.ReservedFixedIPsService:
+		if err := deleteAndWait(v.Delete); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
+	case edgecloud.KeyPairsService:
+		if err := deleteAndWait(v.Delete); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
+	case edgecloud.ProjectsService:
+		if err := deleteAndWait(v.Delete); err != nil {
+			return err
+		}
+		return ResourceIsDeleted(ctx, v.Get, resourceID)
 	default:
 		return errDeleteResourceIfExistIsNotSupported
 	}
@@ -135,11 +278,11 @@ func RetryDeleteLocked(ctx context.Context, client *edgecloud.Client,
 		case <-ticker.C:
 			task, resp, err := deleter(ctx, resourceID)
 			if err != nil {
-				if IsNotFoundErr(resp) {
+				if IsNotFoundErr(resp) || errors.Is(err, edgecloud.ErrNotFound) {
 					return nil
 				}
 
-				if IsLockedErr(resp) {
+				if IsLockedErr(resp) || errors.Is(err, edgecloud.ErrConflict) {
 					continue
 				}
 
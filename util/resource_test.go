@@ -12,8 +12,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/Edge-Center/edgecentercloud-go/v2/edgecloudtest"
 )
 
 const (
@@ -170,6 +172,66 @@ func TestDeleteResourceIfExist(t *testing.T) {
 			urlPath:  "/v1/snapshots",
 			resource: client.Snapshots,
 		},
+		{
+			name:     "InstancesService",
+			urlPath:  "/v1/instances",
+			resource: client.Instances,
+		},
+		{
+			name:     "NetworksService",
+			urlPath:  "/v1/networks",
+			resource: client.Networks,
+		},
+		{
+			name:     "SubnetworksService",
+			urlPath:  "/v1/subnets",
+			resource: client.Subnetworks,
+		},
+		{
+			name:     "RoutersService",
+			urlPath:  "/v1/routers",
+			resource: client.Routers,
+		},
+		{
+			name:     "SecretsService",
+			urlPath:  "/v1/secrets",
+			resource: client.Secrets,
+		},
+		{
+			name:     "SecurityGroupsService",
+			urlPath:  "/v1/securitygroups",
+			resource: client.SecurityGroups,
+		},
+		{
+			name:     "ServerGroupsService",
+			urlPath:  "/v1/servergroups",
+			resource: client.ServerGroups,
+		},
+		{
+			name:     "ImagesService",
+			urlPath:  "/v1/images",
+			resource: client.Images,
+		},
+		{
+			name:     "ReservedFixedIPsService",
+			urlPath:  "/v1/reserved_fixed_ips",
+			resource: client.ReservedFixedIP,
+		},
+		{
+			name:     "KeyPairsService",
+			urlPath:  "/v1/keypairs",
+			resource: client.KeyPairs,
+		},
+		{
+			name:     "ResourceKindListener",
+			urlPath:  "/v1/lblisteners",
+			resource: edgecloud.ResourceKindListener,
+		},
+		{
+			name:     "ResourceKindPool",
+			urlPath:  "/v1/lbpools",
+			resource: edgecloud.ResourceKindPool,
+		},
 	}
 
 	for _, tt := range tests {
@@ -284,4 +346,112 @@ func TestDeleteResourceIfExist_ResourceNotSupported(t *testing.T) {
 
 	err := DeleteResourceIfExist(context.Background(), nil, client.Flavors, testResourceID)
 	assert.Equal(t, err, errDeleteResourceIfExistIsNotSupported)
+
+	err = DeleteResourceIfExist(context.Background(), client, edgecloud.ResourceKind("unknown"), testResourceID)
+	assert.ErrorIs(t, err, errDeleteResourceIfExistIsNotSupported)
+	assert.ErrorIs(t, err, edgecloud.ErrResourceKindUnknown)
+}
+
+func TestDeleteResourceIfExist_InstanceDeleter(t *testing.T) {
+	ctx := context.Background()
+	server := edgecloudtest.NewServer(edgecloudtest.WithTaskPolls(0))
+	t.Cleanup(server.Close)
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	volumes := make([]*edgecloud.Volume, 2)
+	for i := range volumes {
+		volumes[i], err = CreateVolumeAndGet(ctx, client, &edgecloud.VolumeCreateRequest{
+			Name:     testName,
+			Size:     10,
+			Source:   edgecloud.VolumeSourceNewVolume,
+			TypeName: edgecloud.VolumeTypeStandard,
+		})
+		require.NoError(t, err)
+	}
+	instance, err := CreateInstanceAndGet(ctx, client, &edgecloud.InstanceCreateRequest{
+		Names:      []string{testName},
+		Flavor:     "g1-standard-1-2",
+		Interfaces: []edgecloud.InstanceInterface{{Type: edgecloud.InterfaceTypeExternal}},
+		Volumes: []edgecloud.InstanceVolumeCreate{
+			{Source: edgecloud.VolumeSourceExistingVolume, VolumeID: volumes[0].ID, BootIndex: edgecloud.PtrTo(0)},
+			{Source: edgecloud.VolumeSourceExistingVolume, VolumeID: volumes[1].ID, BootIndex: edgecloud.PtrTo(1)},
+		},
+	})
+	require.NoError(t, err)
+
+	deleter := InstanceDeleter{
+		Instances: client.Instances,
+		Options:   &edgecloud.InstanceDeleteOptions{Volumes: []string{volumes[1].ID}},
+	}
+	require.NoError(t, DeleteResourceIfExist(ctx, client, deleter, instance.ID))
+	require.NoError(t, DeleteResourceIfExist(ctx, client, deleter, instance.ID))
+
+	_, _, err = client.Volumes.Get(ctx, volumes[0].ID)
+	require.NoError(t, err)
+	_, _, err = client.Volumes.Get(ctx, volumes[1].ID)
+	assert.ErrorIs(t, err, edgecloud.ErrNotFound)
+
+	require.NoError(t, DeleteResourceIfExist(ctx, client, edgecloud.ResourceKindVolume, volumes[0].ID))
+	_, _, err = client.Volumes.Get(ctx, volumes[0].ID)
+	assert.ErrorIs(t, err, edgecloud.ErrNotFound)
+}
+
+func TestDeleteResourceIfExist_MKaaSClusterPools(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := edgecloud.NewClient(nil)
+	baseURL, _ := url.Parse(server.URL)
+	client.BaseURL = baseURL
+	client.Project = projectID
+	client.Region = regionID
+
+	URL := path.Join("/mkaas/v2/clusters", strconv.Itoa(projectID), strconv.Itoa(regionID), "42/pools/7")
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			_, _ = fmt.Fprintf(w, `{"tasks":["%s"]}`, testResourceID)
+		case http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	mux.HandleFunc(path.Join("/v1/tasks", testResourceID), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"id":"%s","state":"%s"}`, testResourceID, edgecloud.TaskStateFinished)
+	})
+
+	pools := MKaaSClusterPools{MKaaS: client.MkaaS, ClusterID: 42}
+	assert.NoError(t, DeleteResourceIfExist(context.Background(), client, pools, "7"))
+
+	err := DeleteResourceIfExist(context.Background(), client, pools, testResourceID)
+	assert.ErrorIs(t, err, errDeleteResourceIfExistIsNotSupported)
+}
+
+func TestDeleteResourceIfExist_LoadbalancerPoolMembers(t *testing.T) {
+	const memberID = "7c2e1bd4-957b-45da-a4d7-bc23e9bfafde"
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := edgecloud.NewClient(nil)
+	baseURL, _ := url.Parse(server.URL)
+	client.BaseURL = baseURL
+	client.Project = projectID
+	client.Region = regionID
+
+	poolURL := path.Join("/v1/lbpools", strconv.Itoa(projectID), strconv.Itoa(regionID), testResourceID)
+	mux.HandleFunc(path.Join(poolURL, "member", memberID), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		_, _ = fmt.Fprintf(w, `{"tasks":["%s"]}`, testResourceID)
+	})
+	mux.HandleFunc(poolURL, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"id":"%s","members":[{"id":"%s"}]}`, testResourceID, testResourceID2)
+	})
+	mux.HandleFunc(path.Join("/v1/tasks", testResourceID), func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"id":"%s","state":"%s"}`, testResourceID, edgecloud.TaskStateFinished)
+	})
+
+	members := LoadbalancerPoolMembers{Loadbalancers: client.Loadbalancers, PoolID: testResourceID}
+	assert.NoError(t, DeleteResourceIfExist(context.Background(), client, members, memberID))
 }
@@ -7,6 +7,7 @@ import (
 	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
 )
 
+// Deprecated: use edgecloud.SnapshotStatusAvailable instead.
 const SnapshotReadyStatus = "available"
 
 var (
@@ -23,7 +24,7 @@ func SnapshotsListByStatusAndVolumeID(ctx context.Context, client *edgecloud.Cli
 	}
 
 	for _, snap := range snapList {
-		if snap.Status == status {
+		if string(snap.Status) == status {
 			snapshots = append(snapshots, snap)
 		}
 	}
@@ -56,6 +57,9 @@ func SnapshotsListByNameAndVolumeID(ctx context.Context, client *edgecloud.Clien
 	return snapshots, nil
 }
 
+// WaitSnapshotStatusReady waits for the snapshot to be available.
+//
+// Deprecated: use WaitForStatus with edgecloud.SnapshotStatusAvailable instead.
 func WaitSnapshotStatusReady(ctx context.Context, client *edgecloud.Client, snapshotID string, attempts *uint) error {
 	return WithRetry(
 		func() error {
@@ -64,7 +68,7 @@ func WaitSnapshotStatusReady(ctx context.Context, client *edgecloud.Client, snap
 				return err
 			}
 
-			if snapshot.Status == SnapshotReadyStatus {
+			if snapshot.Status == edgecloud.SnapshotStatusAvailable {
 				return nil
 			}
 
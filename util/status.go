@@ -0,0 +1,55 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrStatusNotReached is returned by WaitForStatus when the resource does not reach the status in time.
+var ErrStatusNotReached = errors.New("the resource did not reach the status within the allocated time")
+
+// Status is a typed status of a resource, e.g. edgecloud.InstanceStatus.
+type Status interface {
+	~string
+	IsError() bool
+	IsTransitional() bool
+	IsTerminal() bool
+}
+
+// WaitForStatus gets the resource until its status is the target one and returns it. It returns an error
+// wrapping ErrResourceFailed if the resource gets in an error status instead, and ErrStatusNotReached
+// if the timeout, a minute by default or the deadline of the context, is reached first.
+//
+//	instance, err := util.WaitForStatus(ctx, client.Instances.Get, instanceID,
+//		func(instance *edgecloud.Instance) edgecloud.InstanceStatus { return instance.Status },
+//		edgecloud.InstanceStatusShutoff)
+func WaitForStatus[T any, S Status](
+	ctx context.Context, get GetResourceFunc[T], id string, status func(resource *T) S, target S,
+	timeouts ...time.Duration,
+) (*T, error) {
+	ctx, cancel := contextWithTimeout(ctx, timeouts...)
+	defer cancel()
+
+	resource, err := waitResourceStable(ctx, get, id, func(resource *T) (bool, error) {
+		return statusReached(status(resource), target)
+	}, resourcePollInterval)
+	if err != nil && ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+		return nil, fmt.Errorf("%w: %s: %w", ErrStatusNotReached, target, err)
+	}
+
+	return resource, err
+}
+
+// statusReached reports whether the status is the target one, and returns an error if it is an error status.
+func statusReached[S Status](status, target S) (bool, error) {
+	switch {
+	case status == target:
+		return true, nil
+	case status.IsError():
+		return false, fmt.Errorf("%w: %s", ErrResourceFailed, status)
+	default:
+		return false, nil
+	}
+}
@@ -0,0 +1,43 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func TestWaitForStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        edgecloud.InstanceStatus
+		expectedError error
+	}{
+		{name: "status reached", status: edgecloud.InstanceStatusShutoff},
+		{name: "error status", status: edgecloud.InstanceStatusError, expectedError: ErrResourceFailed},
+		{name: "timeout", status: edgecloud.InstanceStatusActive, expectedError: ErrStatusNotReached},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			get := func(ctx context.Context, id string) (*edgecloud.Instance, *edgecloud.Response, error) {
+				return &edgecloud.Instance{ID: id, Status: tt.status}, nil, nil
+			}
+
+			instance, err := WaitForStatus(context.Background(), get, testResourceID,
+				func(instance *edgecloud.Instance) edgecloud.InstanceStatus { return instance.Status },
+				edgecloud.InstanceStatusShutoff, 20*time.Millisecond)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, instance)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testResourceID, instance.ID)
+		})
+	}
+}
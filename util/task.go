@@ -3,7 +3,6 @@ package util
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -17,97 +16,130 @@ const (
 	taskFailure            = 3
 	taskGetInfoRetrySecond = 5
 	defaultTimeout         = time.Minute
+	taskWaitSpanName       = "Tasks.Wait"
 )
 
 var (
 	errTaskWaitTimeout    = errors.New("a timeout occurred")
 	errTaskWithErrorState = errors.New("task with error state")
 	errTaskStateUnknown   = errors.New("unknown task state")
+	errNoTasks            = errors.New("no tasks in the task response")
 )
 
 type TaskResult struct {
-	DdosProfiles   []int     `json:"ddos_profiles"`
-	FloatingIPs    []string  `json:"floatingips"`
-	HealthMonitors []string  `json:"healthmonitors"`
-	Images         []string  `json:"images"`
-	Instances      []string  `json:"instances"`
-	L7Polices      []string  `json:"l7polices"`
-	L7Rules        []string  `json:"l7rules"`
-	Listeners      []string  `json:"listeners"`
-	Loadbalancers  []string  `json:"loadbalancers"`
-	Members        []string  `json:"members"`
-	Networks       []string  `json:"networks"`
-	Pools          []string  `json:"pools"`
-	Ports          []string  `json:"ports"`
-	Projects       []string  `json:"projects"`
-	Routers        []string  `json:"routers"`
-	Secrets        []string  `json:"secrets"`
-	ServerGroups   []string  `json:"servergroups"`
-	Snapshots      []string  `json:"snapshots"`
-	Subnets        []string  `json:"subnets"`
-	Volumes        []string  `json:"volumes"`
-	MkaasClusters  []float64 `json:"mkaasclusters"`
-	MkaasPools     []float64 `json:"mkaaspools"`
-	DbaasClusters  []string  `json:"dbaasclusters"`
+	DdosProfiles   []int    `json:"ddos_profiles"`
+	FloatingIPs    []string `json:"floatingips"`
+	HealthMonitors []string `json:"healthmonitors"`
+	Images         []string `json:"images"`
+	Instances      []string `json:"instances"`
+	L7Polices      []string `json:"l7polices"`
+	L7Rules        []string `json:"l7rules"`
+	Listeners      []string `json:"listeners"`
+	Loadbalancers  []string `json:"loadbalancers"`
+	Members        []string `json:"members"`
+	Networks       []string `json:"networks"`
+	Pools          []string `json:"pools"`
+	Ports          []string `json:"ports"`
+	Projects       []string `json:"projects"`
+	Routers        []string `json:"routers"`
+	Secrets        []string `json:"secrets"`
+	ServerGroups   []string `json:"servergroups"`
+	Snapshots      []string `json:"snapshots"`
+	Subnets        []string `json:"subnets"`
+	Volumes        []string `json:"volumes"`
+	MkaasClusters  []int    `json:"mkaasclusters"`
+	MkaasPools     []int    `json:"mkaaspools"`
+	DbaasClusters  []string `json:"dbaasclusters"`
 }
 
 type TaskAPIFunc[T any] func(ctx context.Context, opt T) (*edgecloud.TaskResponse, *edgecloud.Response, error)
 
+// ExecuteAndExtractTaskResult calls the API function and waits for its tasks to complete. It returns the resources
+// created by the tasks. If the function starts several tasks, e.g. when several instances are created at once, they
+// are waited concurrently as with WaitAndExtractTasksResult.
 func ExecuteAndExtractTaskResult[T any](ctx context.Context, apiFunc TaskAPIFunc[T], opt T, client *edgecloud.Client, timeouts ...time.Duration) (*TaskResult, error) {
 	task, _, err := apiFunc(ctx, opt)
 	if err != nil {
 		return nil, err
 	}
 
-	taskInfo, err := WaitAndGetTaskInfo(ctx, client, task.Tasks[0], timeouts...)
+	switch len(task.Tasks) {
+	case 0:
+		return nil, errNoTasks
+	case 1:
+		taskInfo, err := WaitAndGetTaskInfo(ctx, client, task.Tasks[0], timeouts...)
+		if err != nil {
+			return nil, err
+		}
+
+		return ExtractTaskResultFromTask(taskInfo)
+	default:
+		return WaitAndExtractTasksResult(ctx, client, task.Tasks, timeouts...)
+	}
+}
+
+// WaitAndExtractTasksResult waits for the tasks to complete concurrently and returns the resources created
+// by all of them. If some of the tasks fail, it returns a *MultiTaskError naming them together with the resources
+// created by the others.
+func WaitAndExtractTasksResult(ctx context.Context, client *edgecloud.Client, taskIDs []string, timeouts ...time.Duration) (*TaskResult, error) {
+	timeout := defaultTimeout
+
+	if len(timeouts) > 0 {
+		timeout = timeouts[0]
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tasks, waitErr := NewTaskWaiter(client).WaitAll(ctx, taskIDs)
+	result, err := ExtractTaskResultFromTasks(tasks...)
 	if err != nil {
 		return nil, err
 	}
 
-	return ExtractTaskResultFromTask(taskInfo)
+	return result, waitErr
 }
 
+// ExtractTaskResultFromTask returns the resources created by the task. They are decoded after the json tags
+// of TaskResult, like the task itself.
 func ExtractTaskResultFromTask(task *edgecloud.Task) (*TaskResult, error) {
 	var result TaskResult
-	if err := mapstructure.Decode(task.CreatedResources, &result); err != nil {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{TagName: "json", Result: &result})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(task.CreatedResources); err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
 
-// waitTask waits for the task to complete.
-func waitTask(ctx context.Context, client *edgecloud.Client, taskID string) (*edgecloud.Task, error) {
-	failCount := 0
-	for {
-		taskInfo, _, err := client.Tasks.Get(ctx, taskID)
-		if err != nil {
-			if failCount <= taskFailure {
-				failCount++
-				time.Sleep(taskGetInfoRetrySecond * time.Second)
-				continue
-			}
-
-			return nil, err
+// ExtractTaskResultFromTasks returns the resources created by all the tasks, in the order of the tasks.
+// The nil tasks are skipped.
+func ExtractTaskResultFromTasks(tasks ...*edgecloud.Task) (*TaskResult, error) {
+	resources := make(map[string]interface{})
+	for _, task := range tasks {
+		if task == nil {
+			continue
 		}
-
-		switch taskInfo.State {
-		case edgecloud.TaskStateRunning, edgecloud.TaskStateNew:
-			<-time.After(taskGetInfoRetrySecond * time.Second)
-		case edgecloud.TaskStateError:
-			if taskInfo.Error != nil {
-				err = fmt.Errorf("%w; task_type: %s; err: %s", errTaskWithErrorState, taskInfo.TaskType, *taskInfo.Error)
+		for kind, ids := range task.CreatedResources {
+			merged, _ := resources[kind].([]interface{})
+			if values, ok := ids.([]interface{}); ok {
+				merged = append(merged, values...)
 			} else {
-				err = fmt.Errorf("%w; task_type: %s", errTaskWithErrorState, taskInfo.TaskType)
+				merged = append(merged, ids)
 			}
-
-			return nil, err
-		case edgecloud.TaskStateFinished:
-			return taskInfo, nil
-		default:
-			return nil, fmt.Errorf("%w: [%s]", errTaskStateUnknown, taskInfo.State)
+			resources[kind] = merged
 		}
 	}
+
+	return ExtractTaskResultFromTask(&edgecloud.Task{CreatedResources: resources})
+}
+
+// waitTask waits for the task to complete with the default TaskWaiter.
+func waitTask(ctx context.Context, client *edgecloud.Client, taskID string) (*edgecloud.Task, error) {
+	return NewTaskWaiter(client).Wait(ctx, taskID)
 }
 
 func WaitForTaskComplete(ctx context.Context, client *edgecloud.Client, taskID string, timeouts ...time.Duration) error {
@@ -117,12 +149,12 @@ func WaitForTaskComplete(ctx context.Context, client *edgecloud.Client, taskID s
 		timeout = timeouts[0]
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	_, err := waitTask(ctx, client, taskID)
+	_, err := waitTask(waitCtx, client, taskID)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
 			return edgecloud.NewArgError("taskID", errTaskWaitTimeout.Error())
 		}
 
@@ -139,19 +171,17 @@ func WaitAndGetTaskInfo(ctx context.Context, client *edgecloud.Client, taskID st
 		timeout = timeouts[0]
 	}
 
-	done := make(chan struct{})
-	var taskInfo *edgecloud.Task
-	var taskErr error
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	go func() {
-		taskInfo, taskErr = waitTask(ctx, client, taskID)
-		close(done)
-	}()
+	taskInfo, err := waitTask(waitCtx, client, taskID)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return nil, edgecloud.NewArgError("task error", errTaskWaitTimeout.Error())
+		}
 
-	select {
-	case <-done:
-		return taskInfo, taskErr
-	case <-time.After(timeout):
-		return nil, edgecloud.NewArgError("task error", errTaskWaitTimeout.Error())
+		return nil, err
 	}
+
+	return taskInfo, nil
 }
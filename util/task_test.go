@@ -12,6 +12,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
 )
@@ -58,7 +61,7 @@ func TestWaitTask(t *testing.T) {
 				}
 				_, _ = fmt.Fprint(w, string(resp))
 			},
-			expectedError: fmt.Errorf("%w; task_type: %s", errTaskWithErrorState, taskType),
+			expectedError: &TaskFailedError{Task: &edgecloud.Task{ID: testResourceID, State: edgecloud.TaskStateError, TaskType: taskType}},
 		},
 	}
 
@@ -101,6 +104,12 @@ func TestWaitForTaskComplete_TaskTimeoutExceeded(t *testing.T) {
 
 	err := WaitForTaskComplete(context.Background(), client, testResourceID, timeout)
 	assert.Equal(t, edgecloud.NewArgError("taskID", errTaskWaitTimeout.Error()), err)
+
+	// the deadline of the caller is not the timeout of the wait
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err = WaitForTaskComplete(ctx, client, testResourceID, time.Minute)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
 }
 
 func TestWaitAndGetTaskInfo_Success(t *testing.T) {
@@ -157,3 +166,50 @@ func TestExecuteAndExtractTaskResult_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 }
+
+func TestWaitAndGetTaskInfo_Telemetry(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	URL := path.Join("/v1/tasks", testResourceID)
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		resp, err := json.Marshal(&edgecloud.Task{ID: testResourceID, State: edgecloud.TaskStateFinished})
+		if err != nil {
+			t.Fatalf("failed to marshal JSON: %v", err)
+		}
+		_, _ = fmt.Fprint(w, string(resp))
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	client, err := edgecloud.New(nil, edgecloud.WithTelemetry(edgecloud.TelemetryConfig{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+	}))
+	require.NoError(t, err)
+	baseURL, _ := url.Parse(server.URL)
+	client.BaseURL = baseURL
+
+	_, err = WaitAndGetTaskInfo(context.Background(), client, testResourceID, timeout)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	poll, wait := spans[0], spans[1]
+	assert.Equal(t, "Tasks.Get", poll.Name())
+	assert.Equal(t, taskWaitSpanName, wait.Name())
+	assert.Equal(t, wait.SpanContext().SpanID(), poll.Parent().SpanID())
+	require.Len(t, wait.Events(), 1)
+	assert.Equal(t, "poll", wait.Events()[0].Name)
+}
+
+func TestExtractTaskResultFromTask_IDTypes(t *testing.T) {
+	var task edgecloud.Task
+	err := json.Unmarshal([]byte(`{"created_resources": {"mkaasclusters": [42], "mkaaspools": [7, 8], "ddos_profiles": [3]}}`), &task)
+	require.NoError(t, err)
+
+	result, err := ExtractTaskResultFromTask(&task)
+	require.NoError(t, err)
+	assert.Equal(t, []int{42}, result.MkaasClusters)
+	assert.Equal(t, []int{7, 8}, result.MkaasPools)
+	assert.Equal(t, []int{3}, result.DdosProfiles)
+}
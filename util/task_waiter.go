@@ -0,0 +1,282 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	defaultTaskInitialInterval = time.Second
+	defaultTaskMaxInterval     = taskGetInfoRetrySecond * time.Second
+	defaultTaskJitter          = 0.1
+	defaultTaskMaxConcurrency  = 4
+	taskIntervalMultiplier     = 2
+)
+
+// TaskFailedError is returned when a task ends in the ERROR state.
+type TaskFailedError struct {
+	// Task is the failed task, with its error, if the API reported one.
+	Task *edgecloud.Task
+}
+
+func (e *TaskFailedError) Error() string {
+	if e.Task.Error != nil {
+		return fmt.Sprintf("%s; task_type: %s; err: %s", errTaskWithErrorState, e.Task.TaskType, *e.Task.Error)
+	}
+
+	return fmt.Sprintf("%s; task_type: %s", errTaskWithErrorState, e.Task.TaskType)
+}
+
+func (e *TaskFailedError) Unwrap() error {
+	return errTaskWithErrorState
+}
+
+// TaskError is the error of a task waited together with other tasks.
+type TaskError struct {
+	TaskID string
+	Err    error
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("task %s: %v", e.TaskID, e.Err)
+}
+
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// MultiTaskError is returned when some of the tasks waited together fail. It wraps the errors of the failed tasks,
+// so errors.As finds e.g. their *TaskFailedError.
+type MultiTaskError struct {
+	// Errors are the errors of the failed tasks, in the order the tasks were given.
+	Errors []*TaskError
+	// Total is the number of the waited tasks.
+	Total int
+}
+
+func (e *MultiTaskError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+
+	return fmt.Sprintf("%d of %d tasks failed: %s", len(e.Errors), e.Total, strings.Join(msgs, "; "))
+}
+
+func (e *MultiTaskError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// TaskIDs returns the IDs of the failed tasks.
+func (e *MultiTaskError) TaskIDs() []string {
+	ids := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		ids = append(ids, err.TaskID)
+	}
+
+	return ids
+}
+
+// TaskWaiter waits for tasks to complete. It polls a task at growing intervals, from InitialInterval
+// doubling up to MaxInterval, and stops as soon as the context is done.
+//
+// The zero values of the fields are replaced with the defaults, so a TaskWaiter can be created
+// with NewTaskWaiter or as a literal with the Client only.
+type TaskWaiter struct {
+	Client *edgecloud.Client
+
+	// InitialInterval is the interval between the first and the second poll, 1s by default.
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum interval between polls, 5s by default.
+	MaxInterval time.Duration
+
+	// Jitter is the fraction of an interval by which it varies randomly, so that the waiters started together
+	// do not poll together. It is in [0, 1], 0.1 by default; a negative Jitter disables it.
+	Jitter float64
+
+	// MaxFailures is the number of consecutive polls that may fail before the waiting fails, 3 by default.
+	MaxFailures int
+
+	// MaxConcurrency is the maximum number of tasks polled concurrently by WaitAll, 4 by default.
+	MaxConcurrency int
+
+	// OnStateChange is called with the task when its state changes, including when it is polled for the first time.
+	// With WaitAll, it is called concurrently for the different tasks.
+	OnStateChange func(task *edgecloud.Task)
+}
+
+// NewTaskWaiter returns a TaskWaiter with the default intervals.
+func NewTaskWaiter(client *edgecloud.Client) *TaskWaiter {
+	return &TaskWaiter{
+		Client:          client,
+		InitialInterval: defaultTaskInitialInterval,
+		MaxInterval:     defaultTaskMaxInterval,
+		Jitter:          defaultTaskJitter,
+		MaxFailures:     taskFailure,
+		MaxConcurrency:  defaultTaskMaxConcurrency,
+	}
+}
+
+// Wait waits for the task to complete and returns it. It returns a *TaskFailedError if the task ends
+// in the ERROR state, and the error of the context if it is done before the task completes.
+// The waiting is traced as a span, so that the task polling requests appear as its children
+// in the traces of a client with telemetry enabled.
+func (w *TaskWaiter) Wait(ctx context.Context, taskID string) (task *edgecloud.Task, err error) {
+	ctx, span := w.Client.StartSpan(ctx, taskWaitSpanName, edgecloud.AttributeTaskIDs.StringSlice([]string{taskID}))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	var state edgecloud.TaskState
+	failures := 0
+	for poll := 0; ; poll++ {
+		if poll > 0 {
+			if err := sleep(ctx, w.interval(poll-1)); err != nil {
+				return nil, err
+			}
+		}
+
+		task, _, err := w.Client.Tasks.Get(ctx, taskID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if failures++; failures > w.maxFailures() {
+				return nil, err
+			}
+
+			continue
+		}
+		failures = 0
+
+		span.AddEvent("poll", trace.WithAttributes(attribute.String("edgecloud.task_state", string(task.State))))
+		if task.State != state {
+			state = task.State
+			if w.OnStateChange != nil {
+				w.OnStateChange(task)
+			}
+		}
+
+		switch task.State {
+		case edgecloud.TaskStateRunning, edgecloud.TaskStateNew:
+		case edgecloud.TaskStateError:
+			return nil, &TaskFailedError{Task: task}
+		case edgecloud.TaskStateFinished:
+			return task, nil
+		default:
+			return nil, fmt.Errorf("%w: [%s]", errTaskStateUnknown, task.State)
+		}
+	}
+}
+
+// WaitAll waits for all the tasks to complete, at most MaxConcurrency of them at once, and returns them
+// in the order of the IDs. If some of the tasks fail, it returns a *MultiTaskError with their errors together
+// with the other tasks, the failed ones being nil, so that the resources of the completed tasks are not lost.
+func (w *TaskWaiter) WaitAll(ctx context.Context, taskIDs []string) ([]*edgecloud.Task, error) {
+	tasks := make([]*edgecloud.Task, len(taskIDs))
+	errs := make([]error, len(taskIDs))
+
+	concurrency := w.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultTaskMaxConcurrency
+	}
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, taskID := range taskIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+				tasks[i], errs[i] = w.Wait(ctx, taskID)
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+			}
+		}()
+	}
+	wg.Wait()
+
+	multiErr := &MultiTaskError{Total: len(taskIDs)}
+	for i, err := range errs {
+		if err != nil {
+			multiErr.Errors = append(multiErr.Errors, &TaskError{TaskID: taskIDs[i], Err: err})
+		}
+	}
+	if len(multiErr.Errors) > 0 {
+		return tasks, multiErr
+	}
+
+	return tasks, nil
+}
+
+// interval returns the interval after the n-th poll, counting from 0.
+func (w *TaskWaiter) interval(n int) time.Duration {
+	initial, maxInterval := w.InitialInterval, w.MaxInterval
+	if initial <= 0 {
+		initial = defaultTaskInitialInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = defaultTaskMaxInterval
+	}
+
+	interval := initial
+	for i := 0; i < n && interval < maxInterval; i++ {
+		interval *= taskIntervalMultiplier
+	}
+	interval = min(interval, maxInterval)
+
+	jitter := w.Jitter
+	switch {
+	case jitter == 0:
+		jitter = defaultTaskJitter
+	case jitter < 0:
+		return interval
+	}
+	jitter = min(jitter, 1)
+
+	return time.Duration(float64(interval) * (1 + jitter*(2*rand.Float64()-1))) //nolint:gosec
+}
+
+func (w *TaskWaiter) maxFailures() int {
+	if w.MaxFailures <= 0 {
+		return taskFailure
+	}
+
+	return w.MaxFailures
+}
+
+// sleep waits for the duration or until the context is done, in which case it returns the error of the context.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,278 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// newTaskWaiterTestClient returns a client of a server that responds to the polls of the task with the tasks
+// of the states in order, repeating the last one. The empty states are responded with 500 Internal Server Error.
+func newTaskWaiterTestClient(t *testing.T, states ...edgecloud.TaskState) *edgecloud.Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	polls := 0
+	mux.HandleFunc(path.Join("/v1/tasks", testResourceID), func(w http.ResponseWriter, r *http.Request) {
+		state := states[min(polls, len(states)-1)]
+		polls++
+		if state == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		task := &edgecloud.Task{ID: testResourceID, State: state, TaskType: taskType}
+		if state == edgecloud.TaskStateError {
+			task.Error = edgecloud.PtrTo("no space left")
+		}
+		_ = json.NewEncoder(w).Encode(task)
+	})
+
+	client := edgecloud.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	return client
+}
+
+func newTestTaskWaiter(client *edgecloud.Client) *TaskWaiter {
+	return &TaskWaiter{Client: client, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond, Jitter: -1}
+}
+
+func TestTaskWaiter_Wait(t *testing.T) {
+	client := newTaskWaiterTestClient(t,
+		edgecloud.TaskStateNew, edgecloud.TaskStateRunning, edgecloud.TaskStateRunning, edgecloud.TaskStateFinished)
+	waiter := newTestTaskWaiter(client)
+	var states []edgecloud.TaskState
+	waiter.OnStateChange = func(task *edgecloud.Task) {
+		states = append(states, task.State)
+	}
+
+	task, err := waiter.Wait(context.Background(), testResourceID)
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.TaskStateFinished, task.State)
+	assert.Equal(t, []edgecloud.TaskState{
+		edgecloud.TaskStateNew, edgecloud.TaskStateRunning, edgecloud.TaskStateFinished,
+	}, states)
+}
+
+func TestTaskWaiter_Wait_TaskFailed(t *testing.T) {
+	client := newTaskWaiterTestClient(t, edgecloud.TaskStateRunning, edgecloud.TaskStateError)
+
+	_, err := newTestTaskWaiter(client).Wait(context.Background(), testResourceID)
+	var taskErr *TaskFailedError
+	require.ErrorAs(t, err, &taskErr)
+	assert.Equal(t, testResourceID, taskErr.Task.ID)
+	assert.Equal(t, "no space left", *taskErr.Task.Error)
+	assert.ErrorIs(t, err, errTaskWithErrorState)
+	assert.EqualError(t, err, "task with error state; task_type: create_vm; err: no space left")
+}
+
+func TestTaskWaiter_Wait_Failures(t *testing.T) {
+	client := newTaskWaiterTestClient(t, "", "", "", edgecloud.TaskStateFinished)
+	task, err := newTestTaskWaiter(client).Wait(context.Background(), testResourceID)
+	require.NoError(t, err)
+	assert.Equal(t, edgecloud.TaskStateFinished, task.State)
+
+	client = newTaskWaiterTestClient(t, "", "", "", "", edgecloud.TaskStateFinished)
+	_, err = newTestTaskWaiter(client).Wait(context.Background(), testResourceID)
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, errTaskWithErrorState))
+}
+
+func TestTaskWaiter_Wait_Canceled(t *testing.T) {
+	client := newTaskWaiterTestClient(t, edgecloud.TaskStateRunning)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	waiter := NewTaskWaiter(client)
+	waiter.InitialInterval = time.Hour
+	waiter.OnStateChange = func(_ *edgecloud.Task) {
+		cancel()
+	}
+
+	start := time.Now()
+	_, err := waiter.Wait(ctx, testResourceID)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestTaskWaiter_Interval(t *testing.T) {
+	waiter := &TaskWaiter{InitialInterval: time.Second, MaxInterval: 5 * time.Second, Jitter: -1}
+	var intervals []time.Duration
+	for n := 0; n < 5; n++ {
+		intervals = append(intervals, waiter.interval(n))
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, intervals)
+
+	waiter.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		interval := waiter.interval(0)
+		assert.GreaterOrEqual(t, interval, 500*time.Millisecond)
+		assert.LessOrEqual(t, interval, 1500*time.Millisecond)
+	}
+
+	interval := (&TaskWaiter{}).interval(10)
+	assert.InDelta(t, float64(defaultTaskMaxInterval), float64(interval), float64(defaultTaskMaxInterval)*defaultTaskJitter)
+}
+
+func TestWaitAndGetTaskInfo_Canceled(t *testing.T) {
+	client := newTaskWaiterTestClient(t, edgecloud.TaskStateRunning)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := WaitAndGetTaskInfo(ctx, client, testResourceID, time.Minute)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// testTaskID returns the UUID of the n-th test task.
+func testTaskID(n int) string {
+	return fmt.Sprintf("f0d19cec-5c3f-4853-886e-30491596%04d", n)
+}
+
+// newTasksTestClient returns a client of a server that responds to the polls of the tasks with their tasks.
+// It reports the maximum number of the polls it served concurrently.
+func newTasksTestClient(t *testing.T, tasks map[string]*edgecloud.Task) (*edgecloud.Client, func() int) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var mu sync.Mutex
+	active, maxActive := 0, 0
+	mux.HandleFunc("/v1/tasks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		active++
+		maxActive = max(maxActive, active)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			active--
+			mu.Unlock()
+		}()
+
+		time.Sleep(5 * time.Millisecond)
+		task, ok := tasks[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(task)
+	})
+
+	client := edgecloud.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	return client, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return maxActive
+	}
+}
+
+func TestTaskWaiter_WaitAll(t *testing.T) {
+	tasks := make(map[string]*edgecloud.Task)
+	var taskIDs []string
+	for i := 0; i < 6; i++ {
+		id := testTaskID(i)
+		tasks[id] = &edgecloud.Task{
+			ID:               id,
+			State:            edgecloud.TaskStateFinished,
+			CreatedResources: map[string]interface{}{"instances": []interface{}{fmt.Sprintf("instance-%d", i)}},
+		}
+		taskIDs = append(taskIDs, id)
+	}
+	tasks[testTaskID(2)].State = edgecloud.TaskStateError
+	tasks[testTaskID(2)].Error = edgecloud.PtrTo("no space left")
+	delete(tasks, testTaskID(4))
+
+	client, maxActive := newTasksTestClient(t, tasks)
+	waiter := newTestTaskWaiter(client)
+	waiter.MaxFailures = 1
+	waiter.MaxConcurrency = 2
+
+	got, err := waiter.WaitAll(context.Background(), taskIDs)
+	require.Len(t, got, len(taskIDs))
+	for i, task := range got {
+		if i == 2 || i == 4 {
+			assert.Nil(t, task)
+			continue
+		}
+		assert.Equal(t, taskIDs[i], task.ID)
+	}
+	assert.LessOrEqual(t, maxActive(), 2)
+
+	var multiErr *MultiTaskError
+	require.ErrorAs(t, err, &multiErr)
+	assert.Equal(t, []string{testTaskID(2), testTaskID(4)}, multiErr.TaskIDs())
+	assert.Equal(t, len(taskIDs), multiErr.Total)
+	var taskErr *TaskFailedError
+	require.ErrorAs(t, err, &taskErr)
+	assert.Equal(t, testTaskID(2), taskErr.Task.ID)
+	assert.ErrorIs(t, err, edgecloud.ErrNotFound)
+	assert.Contains(t, err.Error(), fmt.Sprintf("2 of 6 tasks failed: task %s: task with error state", testTaskID(2)))
+}
+
+func TestWaitAndExtractTasksResult(t *testing.T) {
+	client, _ := newTasksTestClient(t, map[string]*edgecloud.Task{
+		testTaskID(1): {ID: testTaskID(1), State: edgecloud.TaskStateFinished, CreatedResources: map[string]interface{}{
+			"instances": []interface{}{"instance-1"},
+			"ports":     []interface{}{"port-1"},
+		}},
+		testTaskID(2): {ID: testTaskID(2), State: edgecloud.TaskStateFinished, CreatedResources: map[string]interface{}{
+			"instances": []interface{}{"instance-2"},
+		}},
+		testTaskID(3): {ID: testTaskID(3), State: edgecloud.TaskStateError},
+	})
+
+	result, err := WaitAndExtractTasksResult(context.Background(), client, []string{testTaskID(1), testTaskID(2)}, timeout)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"instance-1", "instance-2"}, result.Instances)
+	assert.Equal(t, []string{"port-1"}, result.Ports)
+
+	result, err = WaitAndExtractTasksResult(context.Background(), client, []string{testTaskID(1), testTaskID(3)}, timeout)
+	var multiErr *MultiTaskError
+	require.ErrorAs(t, err, &multiErr)
+	assert.Equal(t, []string{testTaskID(3)}, multiErr.TaskIDs())
+	assert.Equal(t, []string{"instance-1"}, result.Instances)
+}
+
+func TestExecuteAndExtractTaskResult_Tasks(t *testing.T) {
+	client, _ := newTasksTestClient(t, map[string]*edgecloud.Task{
+		testTaskID(1): {ID: testTaskID(1), State: edgecloud.TaskStateFinished, CreatedResources: map[string]interface{}{
+			"instances": []interface{}{"instance-1"},
+		}},
+		testTaskID(2): {ID: testTaskID(2), State: edgecloud.TaskStateFinished, CreatedResources: map[string]interface{}{
+			"instances": []interface{}{"instance-2"},
+		}},
+	})
+	create := func(taskIDs ...string) TaskAPIFunc[string] {
+		return func(ctx context.Context, opt string) (*edgecloud.TaskResponse, *edgecloud.Response, error) {
+			return &edgecloud.TaskResponse{Tasks: taskIDs}, &edgecloud.Response{}, nil
+		}
+	}
+
+	result, err := ExecuteAndExtractTaskResult(context.Background(), create(testTaskID(1), testTaskID(2)), "", client)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"instance-1", "instance-2"}, result.Instances)
+
+	_, err = ExecuteAndExtractTaskResult(context.Background(), create(), "", client)
+	assert.ErrorIs(t, err, errNoTasks)
+}
@@ -0,0 +1,629 @@
+package util
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// TeardownKindPoolMember is the kind of the members of the load balancer pools in a TeardownPlan. The members
+// are not resources of their own in the API, they are listed with their pools and deleted with a LoadbalancerPoolMembers.
+const TeardownKindPoolMember edgecloud.ResourceKind = "pool_member"
+
+const defaultTeardownParallelism = 4
+
+var errTeardownCycle = errors.New("dependency cycle between the resources to tear down")
+
+// TeardownKinds are the kinds of resources a teardown deletes, in the order of the steps of a plan
+// when they do not depend on each other.
+var TeardownKinds = []edgecloud.ResourceKind{
+	TeardownKindPoolMember,
+	edgecloud.ResourceKindPool,
+	edgecloud.ResourceKindListener,
+	edgecloud.ResourceKindLoadbalancer,
+	edgecloud.ResourceKindFloatingIP,
+	edgecloud.ResourceKindInstance,
+	edgecloud.ResourceKindSnapshot,
+	edgecloud.ResourceKindVolume,
+	edgecloud.ResourceKindRouter,
+	edgecloud.ResourceKindSubnetwork,
+	edgecloud.ResourceKindNetwork,
+	edgecloud.ResourceKindSecurityGroup,
+}
+
+// TeardownOptions specifies the resources of a teardown and how they are deleted.
+type TeardownOptions struct {
+	// Kinds restricts the teardown to some of the TeardownKinds, all of them by default. The resources of the other
+	// kinds are not listed, so the plan does not account for them.
+	Kinds []edgecloud.ResourceKind
+
+	// Include selects the resources having all the metadata, Exclude keeps the resources having any of it.
+	// The pool members, pools and listeners follow their load balancer, and the routers follow the networks
+	// of their interfaces, as they have no metadata. The resources used by a kept resource, e.g. the volumes
+	// of a kept instance, are kept as well.
+	Include edgecloud.Metadata
+	Exclude edgecloud.Metadata
+
+	// Parallelism is the maximum number of resources deleted at once, 4 by default.
+	Parallelism int
+
+	// Timeout is the timeout of the deletion of every resource, as in DeleteResourceIfExist.
+	Timeout time.Duration
+
+	// DryRun makes Teardown return the plan without deleting anything.
+	DryRun bool
+
+	// OnDelete is called, possibly concurrently, when the deletion of a resource ends, with its error if any.
+	OnDelete func(item TeardownItem, err error)
+}
+
+// TeardownItem is a resource of a TeardownPlan.
+type TeardownItem struct {
+	Kind edgecloud.ResourceKind `json:"kind"`
+	ID   string                 `json:"id"`
+	Name string                 `json:"name,omitempty"`
+
+	// PoolID is the ID of the pool of a pool member.
+	PoolID string `json:"pool_id,omitempty"`
+
+	// After are the resources of the plan deleted before this one, as kind/id.
+	After []string `json:"after,omitempty"`
+}
+
+func (i TeardownItem) key() string {
+	return teardownKey(i.Kind, i.ID)
+}
+
+// TeardownSkip is a resource left in place by a TeardownPlan, with the reason why.
+type TeardownSkip struct {
+	TeardownItem
+	Reason string `json:"reason"`
+}
+
+// TeardownPlan is the ordered deletion of the resources of a project and region.
+type TeardownPlan struct {
+	ProjectID int `json:"project_id"`
+	RegionID  int `json:"region_id"`
+
+	// Steps are deleted one after the other, the resources of a step being deleted in parallel.
+	Steps [][]TeardownItem `json:"steps"`
+
+	Skipped []TeardownSkip `json:"skipped,omitempty"`
+}
+
+// String returns the plan as a table, for a dry run.
+func (p *TeardownPlan) String() string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintf(w, "teardown of project %d, region %d\n", p.ProjectID, p.RegionID)
+	for i, step := range p.Steps {
+		_, _ = fmt.Fprintf(w, "step %d\n", i+1)
+		for _, item := range step {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\n", item.Kind, item.ID, item.Name)
+		}
+	}
+	if len(p.Skipped) > 0 {
+		_, _ = fmt.Fprintln(w, "skipped")
+		for _, skip := range p.Skipped {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", skip.Kind, skip.ID, skip.Name, skip.Reason)
+		}
+	}
+	_ = w.Flush()
+
+	return b.String()
+}
+
+// Len returns the number of resources deleted by the plan.
+func (p *TeardownPlan) Len() int {
+	n := 0
+	for _, step := range p.Steps {
+		n += len(step)
+	}
+
+	return n
+}
+
+// TeardownError is the error of the deletion of a resource of a TeardownPlan.
+type TeardownError struct {
+	Item TeardownItem
+	Err  error
+}
+
+func (e *TeardownError) Error() string {
+	return fmt.Sprintf("%s %s: %v", e.Item.Kind, e.Item.ID, e.Err)
+}
+
+func (e *TeardownError) Unwrap() error {
+	return e.Err
+}
+
+// Teardown deletes the resources of the project and region of the client, or of the scope of ctx, in the order
+// of their dependencies. See PlanTeardown for the plan, which is returned, and TeardownPlan.Execute for the deletion.
+//
+//	plan, err := util.Teardown(ctx, client, &util.TeardownOptions{
+//		Include: edgecloud.Metadata{"ci": "true"},
+//		Exclude: edgecloud.Metadata{"keep": "true"},
+//		DryRun:  true,
+//	})
+//	fmt.Print(plan)
+func Teardown(ctx context.Context, client *edgecloud.Client, opts *TeardownOptions) (*TeardownPlan, error) {
+	plan, err := PlanTeardown(ctx, client, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts != nil && opts.DryRun {
+		return plan, nil
+	}
+
+	return plan, plan.Execute(ctx, client, opts)
+}
+
+// PlanTeardown lists the resources of the project and region of the client, or of the scope of ctx, and plans
+// their deletion in steps, every resource being deleted after the resources using it, e.g. a volume after the
+// instance it is attached to and after its snapshots, or a subnetwork after the routers, load balancers and
+// instances having an interface in it. The external and shared networks and the default security group
+// are never deleted.
+func PlanTeardown(ctx context.Context, client *edgecloud.Client, opts *TeardownOptions) (*TeardownPlan, error) {
+	if opts == nil {
+		opts = &TeardownOptions{}
+	}
+	kinds := opts.Kinds
+	if len(kinds) == 0 {
+		kinds = TeardownKinds
+	}
+
+	g := newTeardownGraph(kinds)
+	for _, kind := range TeardownKinds {
+		if kind == TeardownKindPoolMember || !g.listed(kind) {
+			continue
+		}
+		api, err := client.Resources(kind)
+		if err != nil {
+			return nil, err
+		}
+		resources, _, err := api.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("list the resources of kind %s: %w", kind, err)
+		}
+		for _, resource := range resources {
+			g.add(resource)
+		}
+	}
+	g.link()
+
+	plan, err := g.plan(opts.Include, opts.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	scope := scopeOf(ctx, client)
+	plan.ProjectID, plan.RegionID = scope.Project, scope.Region
+
+	return plan, nil
+}
+
+// Execute deletes the resources of the plan, step by step, with DeleteResourceIfExist and at most
+// opts.Parallelism of them at once. The steps after a step in which some deletions fail are not run,
+// the returned error joins the *TeardownError of the failed deletions.
+func (p *TeardownPlan) Execute(ctx context.Context, client *edgecloud.Client, opts *TeardownOptions) error {
+	if opts == nil {
+		opts = &TeardownOptions{}
+	}
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = defaultTeardownParallelism
+	}
+	var timeouts []time.Duration
+	if opts.Timeout > 0 {
+		timeouts = append(timeouts, opts.Timeout)
+	}
+	ctx = edgecloud.ContextWithScope(ctx, p.ProjectID, p.RegionID)
+
+	for i, step := range p.Steps {
+		errs := make([]error, len(step))
+		sem := make(chan struct{}, parallelism)
+
+		var wg sync.WaitGroup
+		for j, item := range step {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				var err error
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+					err = deleteTeardownItem(ctx, client, item, timeouts)
+				case <-ctx.Done():
+					err = ctx.Err()
+				}
+				if opts.OnDelete != nil {
+					opts.OnDelete(item, err)
+				}
+				if err != nil {
+					errs[j] = &TeardownError{Item: item, Err: err}
+				}
+			}()
+		}
+		wg.Wait()
+
+		if err := errors.Join(errs...); err != nil {
+			return fmt.Errorf("teardown step %d of %d: %w", i+1, len(p.Steps), err)
+		}
+	}
+
+	return nil
+}
+
+func deleteTeardownItem(ctx context.Context, client *edgecloud.Client, item TeardownItem, timeouts []time.Duration) error {
+	if item.Kind == TeardownKindPoolMember {
+		members := LoadbalancerPoolMembers{Loadbalancers: client.Loadbalancers, PoolID: item.PoolID}
+		return DeleteResourceIfExist(ctx, client, members, item.ID, timeouts...)
+	}
+
+	return DeleteResourceIfExist(ctx, client, item.Kind, item.ID, timeouts...)
+}
+
+func teardownKey(kind edgecloud.ResourceKind, id string) string {
+	return string(kind) + "/" + id
+}
+
+// teardownNode is a resource in the dependency graph of a teardown.
+type teardownNode struct {
+	item     TeardownItem
+	resource edgecloud.Resource
+
+	// follows are the resources whose selection the node follows, for the kinds without metadata.
+	follows []string
+
+	// blocks are the edges to the resources deleted after the node.
+	blocks []teardownEdge
+
+	skip string
+}
+
+// teardownEdge is an edge of the dependency graph. A weak edge only orders the deletions, the resource
+// at its end may be deleted while the resource at its start is kept, e.g. an instance with a floating IP.
+type teardownEdge struct {
+	to   string
+	weak bool
+}
+
+type teardownGraph struct {
+	kinds map[edgecloud.ResourceKind]bool
+	nodes map[string]*teardownNode
+	order []string
+}
+
+func newTeardownGraph(kinds []edgecloud.ResourceKind) *teardownGraph {
+	g := &teardownGraph{
+		kinds: make(map[edgecloud.ResourceKind]bool, len(kinds)),
+		nodes: make(map[string]*teardownNode),
+	}
+	for _, kind := range kinds {
+		g.kinds[kind] = true
+	}
+
+	return g
+}
+
+// listed reports whether the resources of the kind are listed, the pools being listed for their members too.
+func (g *teardownGraph) listed(kind edgecloud.ResourceKind) bool {
+	return g.kinds[kind] || kind == edgecloud.ResourceKindPool && g.kinds[TeardownKindPoolMember]
+}
+
+func (g *teardownGraph) add(resource edgecloud.Resource) {
+	if pool, ok := resource.(*edgecloud.Pool); ok && g.kinds[TeardownKindPoolMember] {
+		for i := range pool.Members {
+			member := &pool.Members[i]
+			g.addNode(&teardownNode{
+				item: TeardownItem{Kind: TeardownKindPoolMember, ID: member.ID, Name: member.Address.String(), PoolID: pool.ID},
+			})
+		}
+	}
+	if !g.kinds[resource.Kind()] {
+		return
+	}
+
+	node := &teardownNode{
+		item:     TeardownItem{Kind: resource.Kind(), ID: resource.GetID(), Name: resource.GetName()},
+		resource: resource,
+	}
+	switch r := resource.(type) {
+	case *edgecloud.Network:
+		if r.External || r.Shared {
+			node.skip = "external or shared network"
+		}
+	case *edgecloud.SecurityGroup:
+		if r.Name == "default" {
+			node.skip = "default security group"
+		}
+	}
+	g.addNode(node)
+}
+
+func (g *teardownGraph) addNode(node *teardownNode) {
+	key := node.item.key()
+	if _, ok := g.nodes[key]; ok {
+		return
+	}
+	g.nodes[key] = node
+	g.order = append(g.order, key)
+}
+
+// link adds the edges of the graph from the fields of the resources.
+func (g *teardownGraph) link() {
+	networksByName := make(map[string][]string)
+	subnetsOfNetwork := make(map[string][]string)
+	securityGroupsByName := make(map[string][]string)
+	loadbalancersByVIPPort := make(map[string]string)
+	for _, key := range g.order {
+		switch r := g.nodes[key].resource.(type) {
+		case *edgecloud.Network:
+			networksByName[r.Name] = append(networksByName[r.Name], r.ID)
+		case *edgecloud.Subnetwork:
+			subnetsOfNetwork[r.NetworkID] = append(subnetsOfNetwork[r.NetworkID], r.ID)
+		case *edgecloud.SecurityGroup:
+			securityGroupsByName[r.Name] = append(securityGroupsByName[r.Name], r.ID)
+		case *edgecloud.Loadbalancer:
+			loadbalancersByVIPPort[r.VipPortID] = r.ID
+		}
+	}
+
+	// The resources at the ends of an edge may not be listed, e.g. the instance of a volume when only
+	// the volumes are torn down.
+	edge := func(from, to string, weak bool) {
+		node, ok := g.nodes[from]
+		if _, listed := g.nodes[to]; !ok || !listed || from == to {
+			return
+		}
+		for i, e := range node.blocks {
+			if e.to == to {
+				node.blocks[i].weak = e.weak && weak
+				return
+			}
+		}
+		node.blocks = append(node.blocks, teardownEdge{to: to, weak: weak})
+	}
+	// A resource using a network uses its subnetworks as well.
+	network := func(from, networkID string) {
+		edge(from, teardownKey(edgecloud.ResourceKindNetwork, networkID), false)
+		for _, subnetID := range subnetsOfNetwork[networkID] {
+			edge(from, teardownKey(edgecloud.ResourceKindSubnetwork, subnetID), false)
+		}
+	}
+
+	for _, key := range g.order {
+		node := g.nodes[key]
+		if node.item.Kind == TeardownKindPoolMember {
+			pool := teardownKey(edgecloud.ResourceKindPool, node.item.PoolID)
+			edge(key, pool, false)
+			if member := g.poolMember(node.item); member != nil && member.InstanceID != "" {
+				edge(key, teardownKey(edgecloud.ResourceKindInstance, member.InstanceID), true)
+			}
+			node.follows = []string{pool}
+
+			continue
+		}
+
+		switch r := node.resource.(type) {
+		case *edgecloud.Pool:
+			for _, listener := range r.Listeners {
+				edge(key, teardownKey(edgecloud.ResourceKindListener, listener.ID), false)
+				node.follows = append(node.follows, teardownKey(edgecloud.ResourceKindListener, listener.ID))
+			}
+			for _, lb := range r.Loadbalancers {
+				edge(key, teardownKey(edgecloud.ResourceKindLoadbalancer, lb.ID), false)
+				node.follows = append(node.follows, teardownKey(edgecloud.ResourceKindLoadbalancer, lb.ID))
+			}
+		case *edgecloud.Listener:
+			edge(key, teardownKey(edgecloud.ResourceKindLoadbalancer, r.LoadbalancerID), false)
+			node.follows = []string{teardownKey(edgecloud.ResourceKindLoadbalancer, r.LoadbalancerID)}
+		case *edgecloud.Loadbalancer:
+			network(key, r.VipNetworkID)
+		case *edgecloud.FloatingIP:
+			if r.Instance.ID != "" {
+				edge(key, teardownKey(edgecloud.ResourceKindInstance, r.Instance.ID), true)
+			}
+			lbID := r.Loadbalancer.ID
+			if lbID == "" && r.PortID != "" {
+				lbID = loadbalancersByVIPPort[r.PortID]
+			}
+			if lbID != "" {
+				edge(key, teardownKey(edgecloud.ResourceKindLoadbalancer, lbID), true)
+			}
+		case *edgecloud.Instance:
+			for _, volume := range r.Volumes {
+				edge(key, teardownKey(edgecloud.ResourceKindVolume, volume.ID), false)
+			}
+			for networkName, addresses := range r.Addresses {
+				for _, networkID := range networksByName[networkName] {
+					network(key, networkID)
+				}
+				for _, address := range addresses {
+					if address.SubnetID != "" {
+						edge(key, teardownKey(edgecloud.ResourceKindSubnetwork, address.SubnetID), false)
+					}
+				}
+			}
+			for _, sg := range r.SecurityGroups {
+				for _, sgID := range securityGroupsByName[sg.Name] {
+					edge(key, teardownKey(edgecloud.ResourceKindSecurityGroup, sgID), false)
+				}
+			}
+		case *edgecloud.Volume:
+			// The attachments are reported on the volumes, the instances may not list all their volumes.
+			if r.InstanceID != "" {
+				edge(teardownKey(edgecloud.ResourceKindInstance, r.InstanceID), key, false)
+			}
+			for _, attachment := range r.Attachments {
+				edge(teardownKey(edgecloud.ResourceKindInstance, attachment.ServerID), key, false)
+			}
+		case *edgecloud.Snapshot:
+			edge(key, teardownKey(edgecloud.ResourceKindVolume, r.VolumeID), false)
+		case *edgecloud.Router:
+			for _, iface := range r.Interfaces {
+				network(key, iface.NetworkID)
+				for _, ip := range iface.IPAssignments {
+					edge(key, teardownKey(edgecloud.ResourceKindSubnetwork, ip.SubnetID), false)
+				}
+				node.follows = append(node.follows, teardownKey(edgecloud.ResourceKindNetwork, iface.NetworkID))
+			}
+		case *edgecloud.Subnetwork:
+			edge(key, teardownKey(edgecloud.ResourceKindNetwork, r.NetworkID), false)
+		}
+	}
+}
+
+// poolMember returns the member of the item, from its pool.
+func (g *teardownGraph) poolMember(item TeardownItem) *edgecloud.PoolMember {
+	node, ok := g.nodes[teardownKey(edgecloud.ResourceKindPool, item.PoolID)]
+	if !ok {
+		return nil
+	}
+	pool, _ := node.resource.(*edgecloud.Pool)
+	for i := range pool.Members {
+		if pool.Members[i].ID == item.ID {
+			return &pool.Members[i]
+		}
+	}
+
+	return nil
+}
+
+// plan selects the resources with the metadata filters, keeps the resources used by the kept ones and orders
+// the deletion of the others in steps.
+func (g *teardownGraph) plan(include, exclude edgecloud.Metadata) (*TeardownPlan, error) {
+	for _, key := range g.order {
+		g.selectNode(g.nodes[key], include, exclude)
+	}
+
+	// A resource at the end of a strong edge from a kept resource is kept, until nothing changes.
+	for changed := true; changed; {
+		changed = false
+		for _, key := range g.order {
+			node := g.nodes[key]
+			if node.skip == "" {
+				continue
+			}
+			for _, e := range node.blocks {
+				if to := g.nodes[e.to]; !e.weak && to.skip == "" {
+					to.skip = fmt.Sprintf("used by the kept %s %s", node.item.Kind, node.item.ID)
+					changed = true
+				}
+			}
+		}
+	}
+
+	indegree := make(map[string]int)
+	for _, key := range g.order {
+		node := g.nodes[key]
+		if node.skip != "" {
+			continue
+		}
+		for _, e := range node.blocks {
+			if to := g.nodes[e.to]; to.skip == "" {
+				indegree[e.to]++
+				to.item.After = append(to.item.After, key)
+			}
+		}
+	}
+
+	plan := &TeardownPlan{}
+	var ready []string
+	for _, key := range g.order {
+		node := g.nodes[key]
+		if node.skip != "" {
+			plan.Skipped = append(plan.Skipped, TeardownSkip{TeardownItem: node.item, Reason: node.skip})
+		} else if indegree[key] == 0 {
+			ready = append(ready, key)
+		}
+	}
+
+	planned := 0
+	for len(ready) > 0 {
+		step := make([]TeardownItem, 0, len(ready))
+		var next []string
+		for _, key := range ready {
+			node := g.nodes[key]
+			step = append(step, node.item)
+			for _, e := range node.blocks {
+				if g.nodes[e.to].skip != "" {
+					continue
+				}
+				if indegree[e.to]--; indegree[e.to] == 0 {
+					next = append(next, e.to)
+				}
+			}
+		}
+		slices.SortFunc(step, compareTeardownItems)
+		plan.Steps = append(plan.Steps, step)
+		planned += len(step)
+		ready = next
+	}
+	if planned+len(plan.Skipped) != len(g.order) {
+		return nil, errTeardownCycle
+	}
+	slices.SortFunc(plan.Skipped, func(a, b TeardownSkip) int {
+		return compareTeardownItems(a.TeardownItem, b.TeardownItem)
+	})
+
+	return plan, nil
+}
+
+// selectNode sets the reason why the node is kept, if any, from the metadata filters, and returns it.
+// A node following other nodes is kept with any of them, or with the filters if none of them is listed.
+func (g *teardownGraph) selectNode(node *teardownNode, include, exclude edgecloud.Metadata) string {
+	if node.skip != "" {
+		return node.skip
+	}
+
+	followed := 0
+	for _, key := range node.follows {
+		if other, ok := g.nodes[key]; ok {
+			followed++
+			if g.selectNode(other, include, exclude) != "" {
+				node.skip = fmt.Sprintf("follows the kept %s %s", other.item.Kind, other.item.ID)
+				return node.skip
+			}
+		}
+	}
+	if followed > 0 {
+		return ""
+	}
+
+	var metadata edgecloud.Metadata
+	if node.resource != nil {
+		metadata = node.resource.GetMetadata()
+	}
+	for key, value := range exclude {
+		if actual, ok := metadata[key]; ok && actual == value {
+			node.skip = fmt.Sprintf("excluded by the metadata %s=%s", key, value)
+			return node.skip
+		}
+	}
+	for key, value := range include {
+		if actual, ok := metadata[key]; !ok || actual != value {
+			node.skip = fmt.Sprintf("not included by the metadata %s=%s", key, value)
+			return node.skip
+		}
+	}
+
+	return ""
+}
+
+func compareTeardownItems(a, b TeardownItem) int {
+	return cmp.Or(
+		cmp.Compare(slices.Index(TeardownKinds, a.Kind), slices.Index(TeardownKinds, b.Kind)),
+		cmp.Compare(a.Name, b.Name),
+		cmp.Compare(a.ID, b.ID),
+	)
+}
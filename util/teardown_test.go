@@ -0,0 +1,258 @@
+package util
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go/v2"
+	"github.com/Edge-Center/edgecentercloud-go/v2/edgecloudtest"
+)
+
+// stepOf returns the index of the step deleting the resource, or -1.
+func stepOf(plan *TeardownPlan, kind edgecloud.ResourceKind, id string) int {
+	for i, step := range plan.Steps {
+		for _, item := range step {
+			if item.Kind == kind && item.ID == id {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+func TestTeardown(t *testing.T) {
+	ctx := context.Background()
+	server := edgecloudtest.NewServer(edgecloudtest.WithTaskPolls(0))
+	t.Cleanup(server.Close)
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	network, err := CreateNetworkAndGet(ctx, client, &edgecloud.NetworkCreateRequest{Name: testName})
+	require.NoError(t, err)
+	result, err := ExecuteAndExtractTaskResult(ctx, client.Subnetworks.Create, &edgecloud.SubnetworkCreateRequest{
+		Name:      testName,
+		NetworkID: network.ID,
+		CIDR:      "10.0.0.0/24",
+	}, client)
+	require.NoError(t, err)
+	subnetID := result.Subnets[0]
+	sg, _, err := client.SecurityGroups.Create(ctx, &edgecloud.SecurityGroupCreateRequest{
+		SecurityGroup: edgecloud.SecurityGroupCreateRequestInner{Name: testName},
+	})
+	require.NoError(t, err)
+
+	volume, err := CreateVolumeAndGet(ctx, client, &edgecloud.VolumeCreateRequest{
+		Name: "data", Size: 10, Source: edgecloud.VolumeSourceNewVolume, TypeName: edgecloud.VolumeTypeStandard,
+	})
+	require.NoError(t, err)
+	archive, err := CreateVolumeAndGet(ctx, client, &edgecloud.VolumeCreateRequest{
+		Name: "archive", Size: 10, Source: edgecloud.VolumeSourceNewVolume, TypeName: edgecloud.VolumeTypeStandard,
+		Metadata: edgecloud.Metadata{"keep": "true"},
+	})
+	require.NoError(t, err)
+
+	result, err = ExecuteAndExtractTaskResult(ctx, client.Instances.Create, &edgecloud.InstanceCreateRequest{
+		Names:  []string{testName},
+		Flavor: "g1-standard-1-2",
+		Interfaces: []edgecloud.InstanceInterface{{
+			Type:       edgecloud.InterfaceTypeSubnet,
+			NetworkID:  network.ID,
+			SubnetID:   subnetID,
+			FloatingIP: &edgecloud.InterfaceFloatingIP{Source: edgecloud.NewFloatingIP},
+		}},
+		Volumes: []edgecloud.InstanceVolumeCreate{
+			{Source: edgecloud.VolumeSourceExistingVolume, VolumeID: volume.ID, BootIndex: edgecloud.PtrTo(0)},
+		},
+		SecurityGroups: []edgecloud.ID{{ID: sg.ID}},
+	}, client)
+	require.NoError(t, err)
+	instanceID, fipID := result.Instances[0], result.FloatingIPs[0]
+
+	lb, err := CreateLoadbalancerAndGet(ctx, client, &edgecloud.LoadbalancerCreateRequest{
+		Name:        testName,
+		VipSubnetID: subnetID,
+	})
+	require.NoError(t, err)
+
+	opts := &TeardownOptions{
+		Kinds: []edgecloud.ResourceKind{
+			edgecloud.ResourceKindLoadbalancer,
+			edgecloud.ResourceKindFloatingIP,
+			edgecloud.ResourceKindInstance,
+			edgecloud.ResourceKindVolume,
+			edgecloud.ResourceKindSubnetwork,
+			edgecloud.ResourceKindNetwork,
+			edgecloud.ResourceKindSecurityGroup,
+		},
+		Exclude: edgecloud.Metadata{"keep": "true"},
+		DryRun:  true,
+	}
+	plan, err := Teardown(ctx, client, opts)
+	require.NoError(t, err)
+	assert.Contains(t, plan.String(), "excluded by the metadata keep=true")
+
+	assert.Equal(t, 7, plan.Len())
+	assert.Less(t, stepOf(plan, edgecloud.ResourceKindFloatingIP, fipID), stepOf(plan, edgecloud.ResourceKindInstance, instanceID))
+	assert.Less(t, stepOf(plan, edgecloud.ResourceKindInstance, instanceID), stepOf(plan, edgecloud.ResourceKindVolume, volume.ID))
+	assert.Less(t, stepOf(plan, edgecloud.ResourceKindInstance, instanceID), stepOf(plan, edgecloud.ResourceKindSecurityGroup, sg.ID))
+	assert.Less(t, stepOf(plan, edgecloud.ResourceKindInstance, instanceID), stepOf(plan, edgecloud.ResourceKindSubnetwork, subnetID))
+	assert.Less(t, stepOf(plan, edgecloud.ResourceKindLoadbalancer, lb.ID), stepOf(plan, edgecloud.ResourceKindSubnetwork, subnetID))
+	assert.Less(t, stepOf(plan, edgecloud.ResourceKindSubnetwork, subnetID), stepOf(plan, edgecloud.ResourceKindNetwork, network.ID))
+	require.Len(t, plan.Skipped, 1)
+	assert.Equal(t, archive.ID, plan.Skipped[0].ID)
+	assert.Equal(t, "excluded by the metadata keep=true", plan.Skipped[0].Reason)
+
+	_, _, err = client.Instances.Get(ctx, instanceID)
+	require.NoError(t, err, "a dry run deletes nothing")
+
+	var deleted atomic.Int64
+	opts.DryRun = false
+	opts.Parallelism = 2
+	opts.OnDelete = func(_ TeardownItem, err error) {
+		assert.NoError(t, err)
+		deleted.Add(1)
+	}
+	require.NoError(t, plan.Execute(ctx, client, opts))
+	assert.Equal(t, int64(plan.Len()), deleted.Load())
+
+	volumes, _, err := client.Volumes.List(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+	assert.Equal(t, archive.ID, volumes[0].ID)
+	for _, kind := range []edgecloud.ResourceKind{
+		edgecloud.ResourceKindInstance, edgecloud.ResourceKindNetwork, edgecloud.ResourceKindSecurityGroup,
+	} {
+		api, err := client.Resources(kind)
+		require.NoError(t, err)
+		resources, _, err := api.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, resources, kind)
+	}
+}
+
+func TestPlanTeardown_Pages(t *testing.T) {
+	ctx := context.Background()
+	server := edgecloudtest.NewServer(edgecloudtest.WithTaskPolls(0), edgecloudtest.WithMaxLimit(1))
+	t.Cleanup(server.Close)
+	client, err := server.Client()
+	require.NoError(t, err)
+
+	network, err := CreateNetworkAndGet(ctx, client, &edgecloud.NetworkCreateRequest{Name: testName})
+	require.NoError(t, err)
+	result, err := ExecuteAndExtractTaskResult(ctx, client.Subnetworks.Create, &edgecloud.SubnetworkCreateRequest{
+		Name:      testName,
+		NetworkID: network.ID,
+		CIDR:      "10.0.0.0/24",
+	}, client)
+	require.NoError(t, err)
+	subnetID := result.Subnets[0]
+
+	var instanceIDs []string
+	for _, name := range []string{"first", "second"} {
+		result, err := ExecuteAndExtractTaskResult(ctx, client.Instances.Create, &edgecloud.InstanceCreateRequest{
+			Names:      []string{name},
+			Flavor:     "g1-standard-1-2",
+			Interfaces: []edgecloud.InstanceInterface{{Type: edgecloud.InterfaceTypeSubnet, NetworkID: network.ID, SubnetID: subnetID}},
+			Volumes: []edgecloud.InstanceVolumeCreate{
+				{Source: edgecloud.VolumeSourceNewVolume, Size: 10, TypeName: edgecloud.VolumeTypeStandard, BootIndex: edgecloud.PtrTo(0)},
+			},
+		}, client)
+		require.NoError(t, err)
+		instanceIDs = append(instanceIDs, result.Instances[0])
+	}
+
+	plan, err := PlanTeardown(ctx, client, &TeardownOptions{
+		Kinds: []edgecloud.ResourceKind{
+			edgecloud.ResourceKindInstance,
+			edgecloud.ResourceKindSubnetwork,
+			edgecloud.ResourceKindNetwork,
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, plan.Len())
+	for _, instanceID := range instanceIDs {
+		step := stepOf(plan, edgecloud.ResourceKindInstance, instanceID)
+		require.NotEqual(t, -1, step, "the instance %s is not planned", instanceID)
+		assert.Less(t, step, stepOf(plan, edgecloud.ResourceKindSubnetwork, subnetID))
+	}
+	assert.Less(t, stepOf(plan, edgecloud.ResourceKindSubnetwork, subnetID), stepOf(plan, edgecloud.ResourceKindNetwork, network.ID))
+}
+
+func TestTeardownGraph(t *testing.T) {
+	const (
+		lbID, listenerID, poolID, memberID = "lb", "listener", "pool", "member"
+		instanceID, volumeID, snapshotID   = "instance", "volume", "snapshot"
+		routerID, subnetID, networkID      = "router", "subnet", "network"
+		keptInstanceID, keptVolumeID       = "kept-instance", "kept-volume"
+	)
+	ci := edgecloud.Metadata{"ci": "true"}
+
+	g := newTeardownGraph(TeardownKinds)
+	for _, resource := range []edgecloud.Resource{
+		&edgecloud.Loadbalancer{ID: lbID, VipNetworkID: networkID, MetadataDetailed: []edgecloud.MetadataDetailed{{Key: "ci", Value: "true"}}},
+		&edgecloud.Listener{ID: listenerID, LoadbalancerID: lbID},
+		&edgecloud.Pool{
+			ID:            poolID,
+			Listeners:     []edgecloud.ID{{ID: listenerID}},
+			Loadbalancers: []edgecloud.ID{{ID: lbID}},
+			Members: []edgecloud.PoolMember{{ID: memberID, PoolMemberCreateRequest: edgecloud.PoolMemberCreateRequest{
+				Address: net.ParseIP("10.0.0.3"), InstanceID: instanceID,
+			}}},
+		},
+		&edgecloud.Instance{ID: instanceID, Metadata: ci},
+		&edgecloud.Volume{ID: volumeID, Metadata: ci, Attachments: []edgecloud.Attachment{{ServerID: instanceID}}},
+		&edgecloud.Snapshot{ID: snapshotID, Metadata: ci, VolumeID: volumeID},
+		&edgecloud.Instance{ID: keptInstanceID, Metadata: edgecloud.Metadata{"ci": "true", "keep": "true"}},
+		&edgecloud.Volume{ID: keptVolumeID, Metadata: ci, InstanceID: keptInstanceID},
+		&edgecloud.Router{ID: routerID, Interfaces: []edgecloud.RouterInterface{{
+			NetworkID: networkID, IPAssignments: []edgecloud.PortIP{{SubnetID: subnetID}},
+		}}},
+		&edgecloud.Subnetwork{ID: subnetID, NetworkID: networkID, Metadata: []edgecloud.MetadataDetailed{{Key: "ci", Value: "true"}}},
+		&edgecloud.Network{ID: networkID, Metadata: []edgecloud.MetadataDetailed{{Key: "ci", Value: "true"}}},
+		&edgecloud.Network{ID: "external", External: true},
+		&edgecloud.SecurityGroup{ID: "default", Name: "default"},
+	} {
+		g.add(resource)
+	}
+	g.link()
+
+	plan, err := g.plan(ci, edgecloud.Metadata{"keep": "true"})
+	require.NoError(t, err)
+
+	steps := [][]string{
+		{"pool_member/" + memberID, "snapshot/" + snapshotID, "router/" + routerID},
+		{"pool/" + poolID, "instance/" + instanceID},
+		{"listener/" + listenerID, "volume/" + volumeID},
+		{"loadbalancer/" + lbID},
+		{"subnetwork/" + subnetID},
+		{"network/" + networkID},
+	}
+	require.Len(t, plan.Steps, len(steps), plan)
+	for i, step := range plan.Steps {
+		keys := make([]string, 0, len(step))
+		for _, item := range step {
+			keys = append(keys, item.key())
+		}
+		assert.Equal(t, steps[i], keys, "step %d", i+1)
+	}
+	assert.Equal(t, poolID, plan.Steps[0][0].PoolID)
+	assert.ElementsMatch(t, []string{"instance/" + instanceID, "snapshot/" + snapshotID}, plan.Steps[2][1].After)
+
+	reasons := make(map[string]string)
+	for _, skip := range plan.Skipped {
+		reasons[skip.key()] = skip.Reason
+	}
+	assert.Equal(t, map[string]string{
+		"instance/" + keptInstanceID: "excluded by the metadata keep=true",
+		"volume/" + keptVolumeID:     "used by the kept instance " + keptInstanceID,
+		"network/external":           "external or shared network",
+		"securitygroup/default":      "default security group",
+	}, reasons)
+}
@@ -13,6 +13,9 @@ var (
 	ErrVolumesNotDetached = errors.New("volume failed to be detached within the allocated time")
 )
 
+// VolumesListByName returns the volumes with the name.
+//
+// Deprecated: use Resolver.Volume with a name: reference, which fails if several volumes have the name, instead.
 func VolumesListByName(ctx context.Context, client *edgecloud.Client, name string) ([]edgecloud.Volume, error) {
 	var volumes []edgecloud.Volume
 
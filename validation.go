@@ -0,0 +1,244 @@
+package edgecloud
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const maxNameLength = 255
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+// validate is the validator shared by all the services. Besides the rules of go-playground/validator,
+// it knows the custom rules used in the validate tags of the request types:
+//
+//   - enum: the value of an enum type must be valid according to its IsValid method;
+//   - name: a string must be a valid resource name;
+//   - rfe=Field:v1;v2 (required for enum): the field is required when Field has one of the values;
+//   - sfe=Field:v1;v2 (skipped for enum): the field must be empty when Field has one of the values;
+//   - allowed_without=F1 F2: the field may be set only if any of the fields is empty;
+//   - allowed_without_all=F1 F2: the field may be set only if all the fields are empty.
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(fieldName)
+
+	validations := map[string]validator.Func{
+		"enum":                validateEnum,
+		"name":                validateName,
+		"rfe":                 validateRequiredForEnum,
+		"sfe":                 validateSkippedForEnum,
+		"allowed_without":     validateAllowedWithout,
+		"allowed_without_all": validateAllowedWithoutAll,
+	}
+	for tag, fn := range validations {
+		if err := v.RegisterValidation(tag, fn, true); err != nil {
+			panic(err)
+		}
+	}
+
+	return v
+}
+
+// ValidationError is returned when a request fails the validation on the client side, before it is sent.
+// It matches ErrInvalidRequest with errors.Is.
+type ValidationError struct {
+	FieldErrors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	details := make([]string, 0, len(e.FieldErrors))
+	for _, fieldErr := range e.FieldErrors {
+		details = append(details, fieldErr.String())
+	}
+
+	return fmt.Sprintf("%s: %s", ErrInvalidRequest, strings.Join(details, "; "))
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidRequest //nolint:errorlint
+}
+
+// validateRequest validates the request with the shared validator and returns a *ValidationError
+// with an error per invalid field.
+func validateRequest(req interface{}) error {
+	err := validate.Struct(req)
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return err
+	}
+
+	fieldErrors := make([]FieldError, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   fieldPath(fieldErr.Namespace()),
+			Message: validationMessage(fieldErr),
+		})
+	}
+
+	return &ValidationError{FieldErrors: fieldErrors}
+}
+
+// fieldName names the fields in the validation errors as they are named in the request bodies or queries.
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "url"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+// fieldPath drops the name of the request type from the namespace of a field, e.g.
+// InstanceCreateRequest.interfaces[0].network_id becomes interfaces[0].network_id.
+func fieldPath(namespace string) string {
+	if _, path, ok := strings.Cut(namespace, "."); ok {
+		return path
+	}
+
+	return namespace
+}
+
+func validationMessage(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "required_with":
+		return fmt.Sprintf("is required together with %s", strings.Join(strings.Fields(fieldErr.Param()), " or "))
+	case "required_without":
+		return fmt.Sprintf("is required when %s is not set", strings.Join(strings.Fields(fieldErr.Param()), " or "))
+	case "required_without_all":
+		return fmt.Sprintf("is required when none of %s is set", strings.Join(strings.Fields(fieldErr.Param()), ", "))
+	case "rfe":
+		field, values := enumCondition(fieldErr.Param())
+		return fmt.Sprintf("is required when %s is %s", field, strings.Join(values, " or "))
+	case "sfe":
+		field, values := enumCondition(fieldErr.Param())
+		return fmt.Sprintf("must be empty when %s is %s", field, strings.Join(values, " or "))
+	case "allowed_without", "allowed_without_all":
+		return fmt.Sprintf("cannot be set together with %s", strings.Join(strings.Fields(fieldErr.Param()), " or "))
+	case "enum":
+		return fmt.Sprintf("has an invalid value %v", fieldErr.Value())
+	case "name":
+		return fmt.Sprintf("must be a non-blank name of at most %d characters without control characters", maxNameLength)
+	case "uuid4":
+		return "must be a valid UUID"
+	case "base64":
+		return "must be base64 encoded"
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", strings.Join(strings.Fields(fieldErr.Param()), ", "))
+	default:
+		if fieldErr.Param() != "" {
+			return fmt.Sprintf("failed the %s=%s validation", fieldErr.Tag(), fieldErr.Param())
+		}
+		return fmt.Sprintf("failed the %s validation", fieldErr.Tag())
+	}
+}
+
+// enumCondition parses the Field:v1;v2 parameter of the rfe and sfe rules.
+func enumCondition(param string) (string, []string) {
+	field, values, _ := strings.Cut(param, ":")
+	return field, strings.Split(values, ";")
+}
+
+// enumConditionHolds reports whether the field named in the parameter of the rfe and sfe rules has one of the values.
+// An unknown field never has them, so that a typo in a tag disables the rule instead of failing the request;
+// TestValidateTags catches such typos.
+func enumConditionHolds(fl validator.FieldLevel) bool {
+	field, values := enumCondition(fl.Param())
+
+	value := reflect.Indirect(reflect.Indirect(fl.Parent()).FieldByName(field))
+	if !value.IsValid() {
+		return false
+	}
+
+	actual := fmt.Sprint(value.Interface())
+	for _, v := range values {
+		if actual == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isZeroField(field reflect.Value) bool {
+	return !field.IsValid() || field.IsZero()
+}
+
+func validateRequiredForEnum(fl validator.FieldLevel) bool {
+	return !enumConditionHolds(fl) || !isZeroField(fl.Field())
+}
+
+func validateSkippedForEnum(fl validator.FieldLevel) bool {
+	return !enumConditionHolds(fl) || isZeroField(fl.Field())
+}
+
+func validateAllowedWithout(fl validator.FieldLevel) bool {
+	if isZeroField(fl.Field()) {
+		return true
+	}
+
+	parent := reflect.Indirect(fl.Parent())
+	for _, name := range strings.Fields(fl.Param()) {
+		if isZeroField(parent.FieldByName(name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateAllowedWithoutAll(fl validator.FieldLevel) bool {
+	if isZeroField(fl.Field()) {
+		return true
+	}
+
+	parent := reflect.Indirect(fl.Parent())
+	for _, name := range strings.Fields(fl.Param()) {
+		if !isZeroField(parent.FieldByName(name)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateEnum checks the value with its IsValid method. Types without it accept any value.
+func validateEnum(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if !field.IsValid() || !field.CanInterface() {
+		return true
+	}
+
+	enum, ok := field.Interface().(interface{ IsValid() error })
+	if !ok {
+		return true
+	}
+
+	return enum.IsValid() == nil
+}
+
+func validateName(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	name := field.String()
+	if strings.TrimSpace(name) == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return false
+	}
+
+	return strings.IndexFunc(name, unicode.IsControl) < 0
+}
@@ -0,0 +1,221 @@
+package edgecloud
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"net/http"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validInstanceCreateRequest() *InstanceCreateRequest {
+	return &InstanceCreateRequest{
+		Names:      []string{"test-instance"},
+		Flavor:     "g1-standard-1-2",
+		Interfaces: []InstanceInterface{{Type: InterfaceTypeExternal}},
+		Volumes:    []InstanceVolumeCreate{{TypeName: VolumeTypeStandard, Size: 5, Source: VolumeSourceNewVolume}},
+	}
+}
+
+func TestValidateRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(req *InstanceCreateRequest)
+		fields []FieldError
+	}{
+		{
+			name:   "valid",
+			modify: func(_ *InstanceCreateRequest) {},
+		},
+		{
+			name: "required for enum",
+			modify: func(req *InstanceCreateRequest) {
+				req.Interfaces[0].Type = InterfaceTypeSubnet
+			},
+			fields: []FieldError{
+				{Field: "interfaces[0].network_id", Message: "is required when Type is subnet or any_subnet"},
+				{Field: "interfaces[0].subnet_id", Message: "is required when Type is subnet"},
+			},
+		},
+		{
+			name: "skipped for enum",
+			modify: func(req *InstanceCreateRequest) {
+				req.Volumes[0].ImageID = testResourceID
+			},
+			fields: []FieldError{
+				{Field: "volumes[0].image_id", Message: "must be empty when Source is snapshot or apptemplate or existing-volume or new-volume"},
+			},
+		},
+		{
+			name: "allowed without all",
+			modify: func(req *InstanceCreateRequest) {
+				req.Interfaces[0] = InstanceInterface{
+					Type:      InterfaceTypeReservedFixedIP,
+					PortID:    testResourceID,
+					NetworkID: testResourceID,
+				}
+			},
+			fields: []FieldError{
+				{Field: "interfaces[0].port_id", Message: "cannot be set together with NetworkID or SubnetID"},
+			},
+		},
+		{
+			name: "floating ip",
+			modify: func(req *InstanceCreateRequest) {
+				req.Interfaces[0].FloatingIP = &InterfaceFloatingIP{Source: ExistingFloatingIP}
+			},
+			fields: []FieldError{
+				{Field: "interfaces[0].floating_ip.existing_floating_id", Message: "is required when Source is existing"},
+			},
+		},
+		{
+			name: "enum",
+			modify: func(req *InstanceCreateRequest) {
+				req.Volumes[0].Source = "unknown"
+			},
+			fields: []FieldError{
+				{Field: "volumes[0].source", Message: "has an invalid value unknown"},
+			},
+		},
+		{
+			name: "uuid",
+			modify: func(req *InstanceCreateRequest) {
+				req.Interfaces[0] = InstanceInterface{Type: InterfaceTypeSubnet, NetworkID: "network", SubnetID: testResourceID}
+			},
+			fields: []FieldError{
+				{Field: "interfaces[0].network_id", Message: "must be a valid UUID"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validInstanceCreateRequest()
+			tt.modify(req)
+
+			err := validateRequest(req)
+			if tt.fields == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrInvalidRequest)
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.fields, validationErr.FieldErrors)
+		})
+	}
+}
+
+func TestValidateRequest_Name(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"test-instance":          true,
+		"":                       false,
+		"   ":                    false,
+		"test\ninstance":         false,
+		strings.Repeat("a", 255): true,
+		strings.Repeat("a", 256): false,
+		strings.Repeat("я", 255): true,
+	} {
+		req := &struct {
+			Name string `json:"name" validate:"name"`
+		}{Name: name}
+		assert.Equal(t, valid, validateRequest(req) == nil, "name %q", name)
+	}
+}
+
+func TestValidateRequest_NotSent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests int
+	URL := path.Join(instancesBasePathV2, strconv.Itoa(projectID), strconv.Itoa(regionID))
+	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	req := validInstanceCreateRequest()
+	req.Interfaces[0].Type = InterfaceTypeSubnet
+
+	respActual, resp, err := client.Instances.Create(ctx, req)
+	assert.Nil(t, respActual)
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+	assert.EqualError(t, err, "invalid request: interfaces[0].network_id: is required when Type is subnet or any_subnet; "+
+		"interfaces[0].subnet_id: is required when Type is subnet")
+	assert.Zero(t, requests)
+}
+
+func TestValidateRequest_UnknownConditionField(t *testing.T) {
+	type request struct {
+		Type string `validate:"omitempty"`
+		Name string `validate:"rfe=Typo:subnet"`
+	}
+
+	assert.NoError(t, validateRequest(&request{Type: "subnet"}))
+}
+
+// TestValidateTags checks that the fields named in the parameters of the rfe, sfe, allowed_without
+// and allowed_without_all rules exist in the structs of the package.
+func TestValidateTags(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	for _, file := range pkgs["edgecloud"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			fields := make(map[string]bool)
+			for _, field := range st.Fields.List {
+				for _, name := range field.Names {
+					fields[name.Name] = true
+				}
+				if len(field.Names) == 0 {
+					// embedded fields are promoted, so their names cannot be checked here
+					return true
+				}
+			}
+
+			for _, field := range st.Fields.List {
+				if field.Tag == nil {
+					continue
+				}
+				tag, _ := strconv.Unquote(field.Tag.Value)
+				for _, rule := range strings.Split(reflect.StructTag(tag).Get("validate"), ",") {
+					name, param, _ := strings.Cut(rule, "=")
+					var referenced []string
+					switch name {
+					case "rfe", "sfe":
+						condition, _ := enumCondition(param)
+						referenced = []string{condition}
+					case "allowed_without", "allowed_without_all":
+						referenced = strings.Fields(param)
+					}
+					for _, ref := range referenced {
+						assert.True(t, fields[ref], "%s.%s: unknown field %s in %s", spec.Name.Name, field.Names[0].Name, ref, rule)
+					}
+				}
+			}
+
+			return true
+		})
+	}
+}
@@ -2,6 +2,7 @@ package edgecloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -64,14 +65,73 @@ type VolumesServiceOp struct {
 
 var _ VolumesService = &VolumesServiceOp{}
 
+// VolumeStatus is the status of a Volume.
+type VolumeStatus string
+
+const (
+	VolumeStatusAttaching        VolumeStatus = "attaching"
+	VolumeStatusAvailable        VolumeStatus = "available"
+	VolumeStatusAwaitingTransfer VolumeStatus = "awaiting-transfer"
+	VolumeStatusBackingUp        VolumeStatus = "backing-up"
+	VolumeStatusCreating         VolumeStatus = "creating"
+	VolumeStatusDeleting         VolumeStatus = "deleting"
+	VolumeStatusDetaching        VolumeStatus = "detaching"
+	VolumeStatusDownloading      VolumeStatus = "downloading"
+	VolumeStatusError            VolumeStatus = "error"
+	VolumeStatusErrorBackingUp   VolumeStatus = "error_backing-up"
+	VolumeStatusErrorDeleting    VolumeStatus = "error_deleting"
+	VolumeStatusErrorExtending   VolumeStatus = "error_extending"
+	VolumeStatusErrorRestoring   VolumeStatus = "error_restoring"
+	VolumeStatusExtending        VolumeStatus = "extending"
+	VolumeStatusInUse            VolumeStatus = "in-use"
+	VolumeStatusMaintenance      VolumeStatus = "maintenance"
+	VolumeStatusReserved         VolumeStatus = "reserved"
+	VolumeStatusRestoringBackup  VolumeStatus = "restoring-backup"
+	VolumeStatusRetyping         VolumeStatus = "retyping"
+	VolumeStatusUploading        VolumeStatus = "uploading"
+)
+
+// IsError reports whether the volume is in one of the error statuses.
+func (s VolumeStatus) IsError() bool {
+	switch s {
+	case VolumeStatusError, VolumeStatusErrorBackingUp, VolumeStatusErrorDeleting, VolumeStatusErrorExtending,
+		VolumeStatusErrorRestoring:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTransitional reports whether the volume is changing, e.g. being created or attached.
+func (s VolumeStatus) IsTransitional() bool {
+	switch s {
+	case VolumeStatusAttaching, VolumeStatusBackingUp, VolumeStatusCreating, VolumeStatusDeleting,
+		VolumeStatusDetaching, VolumeStatusDownloading, VolumeStatusExtending, VolumeStatusMaintenance,
+		VolumeStatusReserved, VolumeStatusRestoringBackup, VolumeStatusRetyping, VolumeStatusUploading:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTerminal reports whether the volume keeps the status until it is acted on, including in error.
+func (s VolumeStatus) IsTerminal() bool {
+	switch s {
+	case VolumeStatusAvailable, VolumeStatusAwaitingTransfer, VolumeStatusInUse:
+		return true
+	default:
+		return s.IsError()
+	}
+}
+
 // Volume represents an EdgecenterCloud Volume.
 type Volume struct {
 	ID                  string              `json:"id"`
 	Name                string              `json:"name"`
-	Status              string              `json:"status"` // todo: need to implement volume status type
+	Status              VolumeStatus        `json:"status"`
 	Size                int                 `json:"size"`
-	CreatedAt           string              `json:"created_at"`
-	UpdatedAt           string              `json:"updated_at"`
+	CreatedAt           Timestamp           `json:"created_at"`
+	UpdatedAt           Timestamp           `json:"updated_at"`
 	VolumeType          VolumeType          `json:"volume_type"`
 	Device              string              `json:"device"`
 	InstanceID          string              `json:"instance_id"`
@@ -100,12 +160,12 @@ type LimiterStats struct {
 
 // Attachment represents an attachment structure.
 type Attachment struct {
-	ServerID     string `json:"server_id"`
-	InstanceName string `json:"instance_name"`
-	AttachmentID string `json:"attachment_id"`
-	VolumeID     string `json:"volume_id"`
-	Device       string `json:"device"`
-	AttachedAt   string `json:"attached_at"`
+	ServerID     string    `json:"server_id"`
+	InstanceName string    `json:"instance_name"`
+	AttachmentID string    `json:"attachment_id"`
+	VolumeID     string    `json:"volume_id"`
+	Device       string    `json:"device"`
+	AttachedAt   Timestamp `json:"attached_at"`
 }
 
 type VolumeType string
@@ -131,6 +191,17 @@ const (
 	VolumeSourceAppTemplate    VolumeSource = "apptemplate"
 )
 
+var ErrInvalidVolumeSource = errors.New("invalid VolumeSource value")
+
+// IsValid reports whether the volume source is one of the known values.
+func (vs VolumeSource) IsValid() error {
+	switch vs {
+	case VolumeSourceNewVolume, VolumeSourceImage, VolumeSourceSnapshot, VolumeSourceExistingVolume, VolumeSourceAppTemplate:
+		return nil
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidVolumeSource, vs)
+}
+
 // VolumeCreateRequest represents a request to create a Volume.
 type VolumeCreateRequest struct {
 	AttachmentTag        string       `json:"attachment_tag,omitempty" validate:"omitempty,required_with=InstanceIDToAttachTo"`
@@ -188,11 +259,13 @@ type volumesRoot struct {
 
 // List get volumes.
 func (s *VolumesServiceOp) List(ctx context.Context, opts *VolumeListOptions) ([]Volume, *Response, error) {
-	if resp, err := s.client.Validate(); err != nil {
+	ctx = withOperation(ctx, "Volumes.List")
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(volumesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, volumesBasePathV1)
 	path, err := addOptions(path, opts)
 	if err != nil {
 		return nil, nil, err
@@ -209,20 +282,24 @@ func (s *VolumesServiceOp) List(ctx context.Context, opts *VolumeListOptions) ([
 		return nil, resp, err
 	}
 
+	resp.Meta = &Meta{Count: root.Count}
+
 	return root.Volume, resp, err
 }
 
 // Get individual Volume.
 func (s *VolumesServiceOp) Get(ctx context.Context, volumeID string) (*Volume, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Get")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(volumesBasePathV1), volumeID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, volumesBasePathV1), volumeID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -266,15 +343,21 @@ func (vt VolumeType) ValidOrNil() (*VolumeType, error) {
 
 // Create a Volume.
 func (s *VolumesServiceOp) Create(ctx context.Context, reqBody *VolumeCreateRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Create")
+
 	if reqBody == nil {
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if err := validateRequest(reqBody); err != nil {
+		return nil, nil, err
+	}
+
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := s.client.addProjectRegionPath(volumesBasePathV1)
+	path := s.client.addProjectRegionPath(ctx, volumesBasePathV1)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -292,15 +375,17 @@ func (s *VolumesServiceOp) Create(ctx context.Context, reqBody *VolumeCreateRequ
 
 // Delete the Volume.
 func (s *VolumesServiceOp) Delete(ctx context.Context, volumeID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Delete")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(volumesBasePathV1), volumeID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, volumesBasePathV1), volumeID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
@@ -318,6 +403,8 @@ func (s *VolumesServiceOp) Delete(ctx context.Context, volumeID string) (*TaskRe
 
 // ChangeType of the volume.
 func (s *VolumesServiceOp) ChangeType(ctx context.Context, volumeID string, reqBody *VolumeChangeTypeRequest) (*Volume, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.ChangeType")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
@@ -326,11 +413,11 @@ func (s *VolumesServiceOp) ChangeType(ctx context.Context, volumeID string, reqB
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(volumesBasePathV1), volumeID, volumesRetype)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, volumesBasePathV1), volumeID, volumesRetype)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -348,6 +435,8 @@ func (s *VolumesServiceOp) ChangeType(ctx context.Context, volumeID string, reqB
 
 // Extend the volume size.
 func (s *VolumesServiceOp) Extend(ctx context.Context, volumeID string, reqBody *VolumeExtendSizeRequest) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Extend")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
@@ -356,11 +445,11 @@ func (s *VolumesServiceOp) Extend(ctx context.Context, volumeID string, reqBody
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(volumesBasePathV1), volumeID, volumesExtend)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, volumesBasePathV1), volumeID, volumesExtend)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -378,6 +467,8 @@ func (s *VolumesServiceOp) Extend(ctx context.Context, volumeID string, reqBody
 
 // Rename the volume.
 func (s *VolumesServiceOp) Rename(ctx context.Context, volumeID string, reqBody *Name) (*Volume, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Rename")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
@@ -386,11 +477,11 @@ func (s *VolumesServiceOp) Rename(ctx context.Context, volumeID string, reqBody
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(volumesBasePathV1), volumeID)
+	path := fmt.Sprintf("%s/%s", s.client.addProjectRegionPath(ctx, volumesBasePathV1), volumeID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPatch, path, reqBody)
 	if err != nil {
@@ -408,6 +499,8 @@ func (s *VolumesServiceOp) Rename(ctx context.Context, volumeID string, reqBody
 
 // Attach the volume.
 func (s *VolumesServiceOp) Attach(ctx context.Context, volumeID string, reqBody *VolumeAttachRequest) (*Volume, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Attach")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
@@ -416,11 +509,11 @@ func (s *VolumesServiceOp) Attach(ctx context.Context, volumeID string, reqBody
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(volumesBasePathV1), volumeID, volumesAttach)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, volumesBasePathV1), volumeID, volumesAttach)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -438,6 +531,8 @@ func (s *VolumesServiceOp) Attach(ctx context.Context, volumeID string, reqBody
 
 // Detach the volume.
 func (s *VolumesServiceOp) Detach(ctx context.Context, volumeID string, reqBody *VolumeDetachRequest) (*Volume, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Detach")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
@@ -446,11 +541,11 @@ func (s *VolumesServiceOp) Detach(ctx context.Context, volumeID string, reqBody
 		return nil, nil, NewArgError("reqBody", "cannot be nil")
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(volumesBasePathV1), volumeID, volumesDetach)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, volumesBasePathV1), volumeID, volumesDetach)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, reqBody)
 	if err != nil {
@@ -468,15 +563,17 @@ func (s *VolumesServiceOp) Detach(ctx context.Context, volumeID string, reqBody
 
 // Revert a volume to its last snapshot.
 func (s *VolumesServiceOp) Revert(ctx context.Context, volumeID string) (*TaskResponse, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.Revert")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
-	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(volumesBasePathV1), volumeID, volumesRevert)
+	path := fmt.Sprintf("%s/%s/%s", s.client.addProjectRegionPath(ctx, volumesBasePathV1), volumeID, volumesRevert)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, path, nil)
 	if err != nil {
@@ -494,11 +591,13 @@ func (s *VolumesServiceOp) Revert(ctx context.Context, volumeID string) (*TaskRe
 
 // MetadataList volume detailed metadata items.
 func (s *VolumesServiceOp) MetadataList(ctx context.Context, volumeID string) ([]MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.MetadataList")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -507,11 +606,13 @@ func (s *VolumesServiceOp) MetadataList(ctx context.Context, volumeID string) ([
 
 // MetadataCreate or update volume metadata.
 func (s *VolumesServiceOp) MetadataCreate(ctx context.Context, volumeID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Volumes.MetadataCreate")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -520,11 +621,13 @@ func (s *VolumesServiceOp) MetadataCreate(ctx context.Context, volumeID string,
 
 // MetadataUpdate volume metadata.
 func (s *VolumesServiceOp) MetadataUpdate(ctx context.Context, volumeID string, reqBody *Metadata) (*Response, error) {
+	ctx = withOperation(ctx, "Volumes.MetadataUpdate")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -533,11 +636,13 @@ func (s *VolumesServiceOp) MetadataUpdate(ctx context.Context, volumeID string,
 
 // MetadataDeleteItem a volume metadata item by key.
 func (s *VolumesServiceOp) MetadataDeleteItem(ctx context.Context, volumeID string, opts *MetadataItemOptions) (*Response, error) {
+	ctx = withOperation(ctx, "Volumes.MetadataDeleteItem")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return resp, err
 	}
 
@@ -546,11 +651,13 @@ func (s *VolumesServiceOp) MetadataDeleteItem(ctx context.Context, volumeID stri
 
 // MetadataGetItem volume detailed metadata.
 func (s *VolumesServiceOp) MetadataGetItem(ctx context.Context, volumeID string, opts *MetadataItemOptions) (*MetadataDetailed, *Response, error) {
+	ctx = withOperation(ctx, "Volumes.MetadataGetItem")
+
 	if resp, err := isValidUUID(volumeID, "volumeID"); err != nil {
 		return nil, resp, err
 	}
 
-	if resp, err := s.client.Validate(); err != nil {
+	if resp, err := s.client.ValidateContext(ctx); err != nil {
 		return nil, resp, err
 	}
 
@@ -129,7 +129,12 @@ func TestVolumes_Create_ResponseError(t *testing.T) {
 	setup()
 	defer teardown()
 
-	request := &VolumeCreateRequest{}
+	request := &VolumeCreateRequest{
+		Name:     "test-volume",
+		Size:     20,
+		TypeName: VolumeTypeStandard,
+		Source:   VolumeSourceNewVolume,
+	}
 	URL := path.Join(volumesBasePathV1, strconv.Itoa(projectID), strconv.Itoa(regionID))
 
 	mux.HandleFunc(URL, func(w http.ResponseWriter, r *http.Request) {
@@ -766,3 +771,13 @@ func TestVolumes_MetadataGetItem_isValidUUID_Error(t *testing.T) {
 	require.Equal(t, 400, resp.StatusCode)
 	require.EqualError(t, err, NewArgError("volumeID", NotCorrectUUID).Error())
 }
+
+func TestVolumeStatus(t *testing.T) {
+	assert.True(t, VolumeStatusAttaching.IsTransitional())
+	assert.False(t, VolumeStatusAttaching.IsTerminal())
+	assert.True(t, VolumeStatusInUse.IsTerminal())
+	assert.True(t, VolumeStatusErrorExtending.IsError())
+	assert.True(t, VolumeStatusErrorExtending.IsTerminal())
+	assert.False(t, VolumeStatusErrorExtending.IsTransitional())
+	assert.False(t, VolumeStatus("unexpected").IsTerminal())
+}